@@ -0,0 +1,5 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "status" character varying(16) NOT NULL DEFAULT 'active', ADD COLUMN "winning_bid_id" uuid NULL, ADD COLUMN "settled_at" timestamptz NULL, ADD
+ CONSTRAINT "fk_auction_items_winning_bid" FOREIGN KEY ("winning_bid_id") REFERENCES "bids" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "idx_auction_items_status_end_time" to table: "auction_items"
+CREATE INDEX "idx_auction_items_status_end_time" ON "auction_items" ("status", "end_time");
@@ -0,0 +1,2 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "soft_close_window" integer NOT NULL DEFAULT 0, ADD COLUMN "soft_close_extension" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+-- Create "proxy_bids" table
+CREATE TABLE "proxy_bids" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "auction_item_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "max_amount" integer NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_proxy_bids_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_proxy_bids_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_proxy_bids_auction_item_user" to table: "proxy_bids"
+CREATE UNIQUE INDEX "idx_proxy_bids_auction_item_user" ON "proxy_bids" ("auction_item_id", "user_id");
+-- Create index "idx_proxy_bids_deleted_at" to table: "proxy_bids"
+CREATE INDEX "idx_proxy_bids_deleted_at" ON "proxy_bids" ("deleted_at");
@@ -0,0 +1,2 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "bid_increment" jsonb NOT NULL DEFAULT '{}';
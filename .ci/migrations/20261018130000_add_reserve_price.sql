@@ -0,0 +1,2 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "reserve_price" integer NULL;
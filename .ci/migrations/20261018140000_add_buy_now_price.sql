@@ -0,0 +1,2 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "buy_now_price" integer NULL;
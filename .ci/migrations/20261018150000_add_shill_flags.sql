@@ -0,0 +1,20 @@
+-- Create "shill_flags" table
+CREATE TABLE "shill_flags" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  "score" double precision NOT NULL,
+  "auctions" integer NOT NULL,
+  "wins" integer NOT NULL,
+  "same_seller_ratio" double precision NOT NULL,
+  "push_ratio" double precision NOT NULL,
+  "reviewed_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_shill_flags_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_shill_flags_deleted_at" to table: "shill_flags"
+CREATE INDEX "idx_shill_flags_deleted_at" ON "shill_flags" ("deleted_at");
+-- Create index "idx_shill_flags_user_id" to table: "shill_flags"
+CREATE UNIQUE INDEX "idx_shill_flags_user_id" ON "shill_flags" ("user_id");
@@ -0,0 +1,2 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "type" character varying(16) NOT NULL DEFAULT 'english', ADD COLUMN "dutch_decrement" integer NOT NULL DEFAULT 0, ADD COLUMN "dutch_interval" integer NOT NULL DEFAULT 0, ADD COLUMN "dutch_floor_price" integer NOT NULL DEFAULT 0;
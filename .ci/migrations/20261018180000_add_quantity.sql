@@ -0,0 +1,4 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "quantity" integer NOT NULL DEFAULT 1;
+-- Modify "bids" table
+ALTER TABLE "bids" ADD COLUMN "quantity" integer NOT NULL DEFAULT 1;
@@ -0,0 +1,22 @@
+-- Create "categories" table
+CREATE TABLE "categories" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "parent_id" uuid NULL,
+  "name" character varying(255) NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_categories_parent" FOREIGN KEY ("parent_id") REFERENCES "categories" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_categories_deleted_at" to table: "categories"
+CREATE INDEX "idx_categories_deleted_at" ON "categories" ("deleted_at");
+-- Create index "idx_categories_parent_id" to table: "categories"
+CREATE INDEX "idx_categories_parent_id" ON "categories" ("parent_id");
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "category_id" uuid NULL, ADD COLUMN "tags" text[] NOT NULL DEFAULT '{}', ADD
+ CONSTRAINT "fk_auction_items_category" FOREIGN KEY ("category_id") REFERENCES "categories" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION;
+-- Create index "idx_auction_items_category_id" to table: "auction_items"
+CREATE INDEX "idx_auction_items_category_id" ON "auction_items" ("category_id");
+-- Create index "idx_auction_items_tags" to table: "auction_items"
+CREATE INDEX "idx_auction_items_tags" ON "auction_items" USING GIN ("tags");
@@ -0,0 +1,6 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "description_text" text NOT NULL DEFAULT '', ADD COLUMN "search_vector" tsvector NULL GENERATED ALWAYS AS (setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', description_text), 'B')) STORED;
+-- Backfill "description_text" of existing auction items
+UPDATE "auction_items" SET "description_text" = btrim(regexp_replace("description", '<[^>]*>', ' ', 'g'));
+-- Create index "idx_auction_items_search_vector" to table: "auction_items"
+CREATE INDEX "idx_auction_items_search_vector" ON "auction_items" USING GIN ("search_vector");
@@ -0,0 +1,16 @@
+-- Create "watchlist_items" table
+CREATE TABLE "watchlist_items" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  "auction_item_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_watchlist_items_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_watchlist_items_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_watchlist_items_deleted_at" to table: "watchlist_items"
+CREATE INDEX "idx_watchlist_items_deleted_at" ON "watchlist_items" ("deleted_at");
+-- Create index "idx_watchlist_items_user_auction_item" to table: "watchlist_items"
+CREATE UNIQUE INDEX "idx_watchlist_items_user_auction_item" ON "watchlist_items" ("user_id", "auction_item_id");
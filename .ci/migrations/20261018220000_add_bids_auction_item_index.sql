@@ -0,0 +1,2 @@
+-- Create index "idx_bids_auction_item_id" to table: "bids"
+CREATE INDEX "idx_bids_auction_item_id" ON "bids" ("auction_item_id");
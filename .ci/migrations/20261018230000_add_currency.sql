@@ -0,0 +1,14 @@
+-- Modify "auction_items" table
+-- NOTE: 金額改為以貨幣最小單位儲存，既有的金額皆為TWD的元，轉換為分
+--       Redis中的競價狀態以版本區分(auction:v2:)，部署時不需要清除，新版本會從資料庫重新載入
+ALTER TABLE "auction_items" ADD COLUMN "currency" character(3) NOT NULL DEFAULT 'TWD', ALTER COLUMN "starting_price" TYPE bigint USING "starting_price" * 100, ALTER COLUMN "final_price" TYPE bigint USING "final_price" * 100, ALTER COLUMN "reserve_price" TYPE bigint USING "reserve_price" * 100, ALTER COLUMN "buy_now_price" TYPE bigint USING "buy_now_price" * 100, ALTER COLUMN "dutch_decrement" TYPE bigint USING "dutch_decrement" * 100, ALTER COLUMN "dutch_floor_price" TYPE bigint USING "dutch_floor_price" * 100;
+-- Convert the amounts in "bid_increment" to minor units
+UPDATE "auction_items" SET "bid_increment" = "bid_increment" || jsonb_build_object('step', ("bid_increment"->>'step')::bigint * 100) WHERE "bid_increment" ? 'step';
+UPDATE "auction_items" SET "bid_increment" = "bid_increment" || jsonb_build_object('tiers', (
+  SELECT jsonb_agg(jsonb_build_object('from', (tier->>'from')::bigint * 100, 'step', (tier->>'step')::bigint * 100) ORDER BY ordinality)
+  FROM jsonb_array_elements("bid_increment"->'tiers') WITH ORDINALITY AS tiers(tier, ordinality)
+)) WHERE jsonb_typeof("bid_increment"->'tiers') = 'array';
+-- Modify "bids" table
+ALTER TABLE "bids" ALTER COLUMN "amount" TYPE bigint USING "amount" * 100;
+-- Modify "proxy_bids" table
+ALTER TABLE "proxy_bids" ALTER COLUMN "max_amount" TYPE bigint USING "max_amount" * 100;
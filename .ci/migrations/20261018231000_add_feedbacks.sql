@@ -0,0 +1,25 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "rating_count" integer NOT NULL DEFAULT 0, ADD COLUMN "rating_sum" integer NOT NULL DEFAULT 0;
+-- Create "feedbacks" table
+CREATE TABLE "feedbacks" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "auction_item_id" uuid NOT NULL,
+  "from_user_id" uuid NOT NULL,
+  "to_user_id" uuid NOT NULL,
+  "role" character varying(16) NOT NULL,
+  "rating" smallint NOT NULL,
+  "comment" text NOT NULL DEFAULT '',
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_feedbacks_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_feedbacks_from_user" FOREIGN KEY ("from_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_feedbacks_to_user" FOREIGN KEY ("to_user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_feedbacks_auction_item_from_to" to table: "feedbacks"
+CREATE UNIQUE INDEX "idx_feedbacks_auction_item_from_to" ON "feedbacks" ("auction_item_id", "from_user_id", "to_user_id");
+-- Create index "idx_feedbacks_deleted_at" to table: "feedbacks"
+CREATE INDEX "idx_feedbacks_deleted_at" ON "feedbacks" ("deleted_at");
+-- Create index "idx_feedbacks_to_user_id" to table: "feedbacks"
+CREATE INDEX "idx_feedbacks_to_user_id" ON "feedbacks" ("to_user_id");
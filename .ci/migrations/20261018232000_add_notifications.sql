@@ -0,0 +1,37 @@
+-- Create "notifications" table
+CREATE TABLE "notifications" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  "kind" character varying(32) NOT NULL,
+  "auction_item_id" uuid NULL,
+  "title" character varying(255) NOT NULL,
+  "body" text NOT NULL DEFAULT '',
+  "read_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_notifications_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_notifications_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_notifications_deleted_at" to table: "notifications"
+CREATE INDEX "idx_notifications_deleted_at" ON "notifications" ("deleted_at");
+-- Create index "idx_notifications_user_id" to table: "notifications"
+CREATE INDEX "idx_notifications_user_id" ON "notifications" ("user_id");
+-- Create "notification_preferences" table
+CREATE TABLE "notification_preferences" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  "email" character varying(255) NOT NULL DEFAULT '',
+  "webhook_url" character varying(2048) NOT NULL DEFAULT '',
+  "channels" jsonb NOT NULL DEFAULT '{}',
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_notification_preferences_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_notification_preferences_deleted_at" to table: "notification_preferences"
+CREATE INDEX "idx_notification_preferences_deleted_at" ON "notification_preferences" ("deleted_at");
+-- Create index "idx_notification_preferences_user_id" to table: "notification_preferences"
+CREATE UNIQUE INDEX "idx_notification_preferences_user_id" ON "notification_preferences" ("user_id");
@@ -0,0 +1,47 @@
+-- Create "webhook_endpoints" table
+CREATE TABLE "webhook_endpoints" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "user_id" uuid NOT NULL,
+  "url" character varying(2048) NOT NULL,
+  "description" character varying(255) NOT NULL DEFAULT '',
+  "secret" character varying(64) NOT NULL,
+  "events" jsonb NOT NULL DEFAULT '[]',
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_webhook_endpoints_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_webhook_endpoints_deleted_at" to table: "webhook_endpoints"
+CREATE INDEX "idx_webhook_endpoints_deleted_at" ON "webhook_endpoints" ("deleted_at");
+-- Create index "idx_webhook_endpoints_user_id" to table: "webhook_endpoints"
+CREATE INDEX "idx_webhook_endpoints_user_id" ON "webhook_endpoints" ("user_id");
+-- Create "webhook_deliveries" table
+CREATE TABLE "webhook_deliveries" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "webhook_endpoint_id" uuid NOT NULL,
+  "event_id" uuid NOT NULL,
+  "event_type" character varying(32) NOT NULL,
+  "auction_item_id" uuid NOT NULL,
+  "payload" text NOT NULL,
+  "status" character varying(16) NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NULL,
+  "last_attempt_at" timestamptz NULL,
+  "response_status" integer NULL,
+  "last_error" text NOT NULL DEFAULT '',
+  "redelivery_of_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_webhook_deliveries_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_webhook_deliveries_redelivery_of" FOREIGN KEY ("redelivery_of_id") REFERENCES "webhook_deliveries" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_webhook_deliveries_webhook_endpoint" FOREIGN KEY ("webhook_endpoint_id") REFERENCES "webhook_endpoints" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_webhook_deliveries_deleted_at" to table: "webhook_deliveries"
+CREATE INDEX "idx_webhook_deliveries_deleted_at" ON "webhook_deliveries" ("deleted_at");
+-- Create index "idx_webhook_deliveries_status_next_attempt_at" to table: "webhook_deliveries"
+CREATE INDEX "idx_webhook_deliveries_status_next_attempt_at" ON "webhook_deliveries" ("status", "next_attempt_at");
+-- Create index "idx_webhook_deliveries_webhook_endpoint_id" to table: "webhook_deliveries"
+CREATE INDEX "idx_webhook_deliveries_webhook_endpoint_id" ON "webhook_deliveries" ("webhook_endpoint_id");
@@ -0,0 +1,19 @@
+-- Create "questions" table
+CREATE TABLE "questions" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "auction_item_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "content" text NOT NULL,
+  "answer" text NOT NULL DEFAULT '',
+  "answered_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_questions_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_questions_user" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_questions_auction_item_id" to table: "questions"
+CREATE INDEX "idx_questions_auction_item_id" ON "questions" ("auction_item_id");
+-- Create index "idx_questions_deleted_at" to table: "questions"
+CREATE INDEX "idx_questions_deleted_at" ON "questions" ("deleted_at");
@@ -0,0 +1,49 @@
+-- Modify "auction_items" table
+ALTER TABLE "auction_items" ADD COLUMN "hidden_at" timestamptz NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "banned_at" timestamptz NULL, ADD COLUMN "ban_reason" text NOT NULL DEFAULT '';
+-- Create "moderation_cases" table
+CREATE TABLE "moderation_cases" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "auction_item_id" uuid NOT NULL,
+  "status" character varying(16) NOT NULL DEFAULT 'open',
+  "report_count" integer NOT NULL DEFAULT 0,
+  "last_reported_at" timestamptz NOT NULL,
+  "resolved_by_id" uuid NULL,
+  "resolved_at" timestamptz NULL,
+  "note" text NOT NULL DEFAULT '',
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_moderation_cases_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_moderation_cases_resolved_by" FOREIGN KEY ("resolved_by_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_moderation_cases_auction_item_id" to table: "moderation_cases"
+CREATE UNIQUE INDEX "idx_moderation_cases_auction_item_id" ON "moderation_cases" ("auction_item_id");
+-- Create index "idx_moderation_cases_deleted_at" to table: "moderation_cases"
+CREATE INDEX "idx_moderation_cases_deleted_at" ON "moderation_cases" ("deleted_at");
+-- Create index "idx_moderation_cases_status" to table: "moderation_cases"
+CREATE INDEX "idx_moderation_cases_status" ON "moderation_cases" ("status");
+-- Create "reports" table
+CREATE TABLE "reports" (
+  "id" uuid NOT NULL DEFAULT public.uuid_generate_v7(),
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  "deleted_at" timestamptz NULL,
+  "moderation_case_id" uuid NOT NULL,
+  "auction_item_id" uuid NOT NULL,
+  "reporter_id" uuid NOT NULL,
+  "reason" character varying(16) NOT NULL,
+  "details" text NOT NULL DEFAULT '',
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_moderation_cases_reports" FOREIGN KEY ("moderation_case_id") REFERENCES "moderation_cases" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_reports_auction_item" FOREIGN KEY ("auction_item_id") REFERENCES "auction_items" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "fk_reports_reporter" FOREIGN KEY ("reporter_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "idx_reports_auction_item_reporter" to table: "reports"
+CREATE UNIQUE INDEX "idx_reports_auction_item_reporter" ON "reports" ("auction_item_id", "reporter_id");
+-- Create index "idx_reports_deleted_at" to table: "reports"
+CREATE INDEX "idx_reports_deleted_at" ON "reports" ("deleted_at");
+-- Create index "idx_reports_moderation_case_id" to table: "reports"
+CREATE INDEX "idx_reports_moderation_case_id" ON "reports" ("moderation_case_id");
@@ -11,6 +11,9 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// ErrSkipMessage 由解析函數返回，代表該訊息不需要發送到下游
+var ErrSkipMessage = errors.New("skip message")
+
 type consumerOptions[T any] struct {
 	logger       *slog.Logger
 	bufferSize   int
@@ -126,6 +129,9 @@ func (s *Consumer[T]) Start() {
 
 				// 解析消息
 				data, err := s.options.parseFunc(message.Values)
+				if errors.Is(err, ErrSkipMessage) {
+					continue
+				}
 				if err != nil {
 					s.logger.Error("failed to parse message",
 						slog.String("messageId", message.ID),
@@ -279,6 +279,50 @@ func TestConsumer_MessageConsumption(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("skipped message", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		client, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		mock.ExpectXRead(&redis.XReadArgs{
+			Streams: []string{"test-stream", "$"},
+			Count:   1,
+			Block:   time.Second,
+		}).SetVal([]redis.XStream{
+			{
+				Stream: "test-stream",
+				Messages: []redis.XMessage{
+					{
+						ID:     "1234-0",
+						Values: map[string]interface{}{"id": "1"},
+					},
+				},
+			},
+		})
+
+		consumer, err := NewConsumer[TestMessage](
+			client,
+			"test-stream",
+			WithConsumerBlockTimeout[TestMessage](time.Second),
+			WithConsumerParseFunc[TestMessage](func(m map[string]any) (TestMessage, error) {
+				return TestMessage{}, ErrSkipMessage
+			}),
+		)
+		require.NoError(t, err)
+
+		consumer.Start()
+		defer consumer.Close()
+
+		select {
+		case <-consumer.Subscribe():
+			t.Fatal("should not receive skipped message")
+		case <-time.After(300 * time.Millisecond):
+			// Expected timeout
+		}
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("empty stream response", func(t *testing.T) {
 		defer goleak.VerifyNone(t)
 		client, mock, cleanup := setupTest(t)
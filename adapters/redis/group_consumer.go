@@ -25,6 +25,8 @@ type Message[T any] struct {
 	messageID string
 	stream    string
 	group     string
+	// deadLetterStream 處理失敗的消息寫入的stream，未設置時為<stream>:dead-letter
+	deadLetterStream string
 
 	raw map[string]any
 }
@@ -50,9 +52,13 @@ func (m *Message[T]) Fail(ctx context.Context, failErr error) error {
 		return nil
 	}
 
+	deadLetterStream := m.deadLetterStream
+	if deadLetterStream == "" {
+		deadLetterStream = m.stream + ":dead-letter"
+	}
 	m.raw["error"] = failErr.Error()
 	err := m.client.XAdd(ctx, &redis.XAddArgs{
-		Stream: m.stream + ":dead-letter",
+		Stream: deadLetterStream,
 		Values: m.raw,
 	}).Err()
 	if err != nil {
@@ -72,6 +78,7 @@ type GroupConsumer[T any] struct {
 	stream        string
 	group         string
 	consumer      string
+	deadLetter    string
 	downStream    chan *Message[T]
 	cancelFunc    context.CancelFunc
 	wg            sync.WaitGroup
@@ -89,6 +96,7 @@ type groupConsumerOptions[T any] struct {
 	blockTimeout   time.Duration
 	mutex          IAutoRenewMutex
 	strictOrdering bool // 嚴格順序模式
+	deadLetter     string
 }
 
 type GroupConsumerOption[T any] func(*groupConsumerOptions[T])
@@ -128,6 +136,14 @@ func WithGroupConsumerMutex[T any](mutex IAutoRenewMutex) GroupConsumerOption[T]
 	}
 }
 
+// WithGroupConsumerDeadLetterStream 設置處理失敗的消息寫入的stream，預設為<stream>:dead-letter
+// 同一個stream有多個group時，應為每個group設置不同的stream，避免無法分辨失敗的消息屬於哪個group
+func WithGroupConsumerDeadLetterStream[T any](stream string) GroupConsumerOption[T] {
+	return func(o *groupConsumerOptions[T]) {
+		o.deadLetter = stream
+	}
+}
+
 // WithGroupConsumerStrictOrdering 設置是否使用嚴格順序模式
 func WithGroupConsumerStrictOrdering[T any](strict bool) GroupConsumerOption[T] {
 	return func(o *groupConsumerOptions[T]) {
@@ -162,13 +178,17 @@ func NewGroupConsumer[T any](
 	}
 
 	gc := &GroupConsumer[T]{
-		logger:   options.logger.With(slog.String("caller", "GroupConsumer"), slog.String("stream", stream), slog.String("group", group), slog.String("consumer", consumer)),
-		client:   client,
-		stream:   stream,
-		group:    group,
-		consumer: consumer,
-		closed:   true,
-		options:  options,
+		logger:     options.logger.With(slog.String("caller", "GroupConsumer"), slog.String("stream", stream), slog.String("group", group), slog.String("consumer", consumer)),
+		client:     client,
+		stream:     stream,
+		group:      group,
+		consumer:   consumer,
+		deadLetter: stream + ":dead-letter",
+		closed:     true,
+		options:    options,
+	}
+	if options.deadLetter != "" {
+		gc.deadLetter = options.deadLetter
 	}
 
 	// 只在嚴格順序模式下設置mutex
@@ -306,6 +326,8 @@ func (s *GroupConsumer[T]) messagesWorkflow(ctx context.Context) error {
 			group:     s.group,
 			client:    s.client,
 			raw:       message.Values,
+
+			deadLetterStream: s.deadLetter,
 		}
 		if err := s.moveToDownStream(ctx, msg); err != nil {
 			s.logger.Error("error moving message to downstream",
@@ -395,10 +417,8 @@ func (s *GroupConsumer[T]) fetchNextMessage(ctx context.Context) (redis.XMessage
 
 // 添加死信處理
 func (s *GroupConsumer[T]) moveToDeadLetter(ctx context.Context, message redis.XMessage) error {
-	deadLetterStream := s.stream + ":dead-letter"
-
 	err := s.client.XAdd(ctx, &redis.XAddArgs{
-		Stream: deadLetterStream,
+		Stream: s.deadLetter,
 		Values: message.Values,
 	}).Err()
 
@@ -978,6 +978,34 @@ func TestMessage_Fail(t *testing.T) {
 		assert.True(t, msg.done)
 	})
 
+	t.Run("custom dead letter stream", func(t *testing.T) {
+		client, mock, cleanup := setupTest(t)
+		defer cleanup()
+
+		msg := &Message[TestMessage]{
+			Data:             TestMessage{ID: "1", Data: "test"},
+			messageID:        "1234-0",
+			stream:           "test-stream",
+			group:            "test-group",
+			client:           client,
+			raw:              map[string]any{},
+			deadLetterStream: "test-stream:test-group:dead-letter",
+		}
+
+		// 期望消息被移動到group專屬的死信隊列
+		mock.ExpectXAdd(&redis.XAddArgs{
+			Stream: "test-stream:test-group:dead-letter",
+			Values: map[string]any{
+				"error": "test error",
+			},
+		}).SetVal("dlq-1234-0")
+		mock.ExpectXAck("test-stream", "test-group", "1234-0").SetVal(1)
+
+		err := msg.Fail(context.Background(), errors.New("test error"))
+		assert.NoError(t, err)
+		assert.True(t, msg.done)
+	})
+
 	t.Run("multiple fail calls", func(t *testing.T) {
 		client, mock, cleanup := setupTest(t)
 		defer cleanup()
@@ -0,0 +1,41 @@
+package sse
+
+import (
+	"sync"
+)
+
+// MergedSubscriber 將多個上游 Subscriber 的訊息合併到同一個通道
+// 用於讓 ConnectionManager 同時接收來自不同來源(例如不同的 stream)的訊息
+type MergedSubscriber[T any] struct {
+	subscribers []Subscriber[T]
+}
+
+// NewMergedSubscriber 建立一個合併多個上游的訂閱者
+// subscribers: 要合併的上游訂閱者，須在呼叫 Subscribe 前完成啟動
+func NewMergedSubscriber[T any](subscribers ...Subscriber[T]) Subscriber[T] {
+	return &MergedSubscriber[T]{
+		subscribers: subscribers,
+	}
+}
+
+// Subscribe 訂閱所有上游並將訊息轉送到同一個唯讀通道
+// 當所有上游的通道都關閉後，返回的通道也會被關閉
+func (m *MergedSubscriber[T]) Subscribe() <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, sub := range m.subscribers {
+		upstream := sub.Subscribe()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for msg := range upstream {
+				out <- msg
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
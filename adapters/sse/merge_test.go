@@ -0,0 +1,64 @@
+package sse_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/goleak"
+
+	"q4/adapters/sse"
+)
+
+// chanSubscriber 以固定的通道實作 Subscriber
+type chanSubscriber[T any] chan T
+
+func (c chanSubscriber[T]) Subscribe() <-chan T {
+	return c
+}
+
+func TestMergedSubscriber(t *testing.T) {
+	t.Run("receive messages from all upstreams", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		first, second := make(chanSubscriber[Message]), make(chanSubscriber[Message])
+		merged := sse.NewMergedSubscriber[Message](first, second)
+		ch := merged.Subscribe()
+
+		go func() {
+			first <- Message{Data: "first"}
+			second <- Message{Data: "second"}
+			close(first)
+			close(second)
+		}()
+
+		var received []string
+		for msg := range ch {
+			received = append(received, msg.Data)
+		}
+		assert.ElementsMatch(t, []string{"first", "second"}, received)
+	})
+
+	t.Run("close after all upstreams closed", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		first, second := make(chanSubscriber[Message]), make(chanSubscriber[Message])
+		merged := sse.NewMergedSubscriber[Message](first, second)
+		ch := merged.Subscribe()
+
+		close(first)
+		select {
+		case _, ok := <-ch:
+			assert.Fail(t, "channel should stay open while an upstream is alive", "ok=%v", ok)
+		default:
+		}
+
+		close(second)
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("no upstream", func(t *testing.T) {
+		defer goleak.VerifyNone(t)
+		ch := sse.NewMergedSubscriber[Message]().Subscribe()
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+}
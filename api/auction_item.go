@@ -0,0 +1,521 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// Edit an auction item
+// (PATCH /auction/item/{itemID})
+func (impl *ServerImpl) PatchAuctionItemItemID(ctx context.Context, request openapi.PatchAuctionItemItemIDRequestObject) (openapi.PatchAuctionItemItemIDResponseObject, error) {
+	const op = "PatchAuctionItemItemID"
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.Preload("CurrentBid").First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PatchAuctionItemItemID404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 檢查使用者是否為賣家
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PatchAuctionItemItemID401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PatchAuctionItemItemID401Response{}, nil
+	}
+	if auction.UserID != uuid.MustParse(token.Subject) || !impl.hasRole(token, RoleSeller) {
+		return openapi.PatchAuctionItemItemID403Response{}, nil
+	}
+	//  - 停權的使用者不能管理拍賣物品
+	banned, err := impl.userBanned(ctx, auction.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PatchAuctionItemItemID403Response{}, nil
+	}
+	// 檢查拍賣是否已經結束
+	if auction.Status != models.AuctionStatusActive || time.Now().After(auction.EndTime) {
+		return openapi.PatchAuctionItemItemID410Response{}, nil
+	}
+
+	// 可以隨時修改的欄位
+	title := auction.Title
+	columns := []string{}
+	if request.Body.Title != nil {
+		auction.Title = *request.Body.Title
+		columns = append(columns, "Title")
+	}
+	if request.Body.Description != nil {
+		auction.Description = impl.htmlChecker.Sanitize(*request.Body.Description)
+		auction.DescriptionText = impl.descriptionText(auction.Description)
+		columns = append(columns, "Description", "DescriptionText")
+	}
+	if request.Body.Carousels != nil {
+		auction.Carousels = *request.Body.Carousels
+		columns = append(columns, "Carousels")
+	}
+	//  - 分類設定為nil UUID時代表移除
+	if request.Body.CategoryId != nil {
+		auction.CategoryID = lo.Ternary(*request.Body.CategoryId == uuid.Nil, nil, request.Body.CategoryId)
+		columns = append(columns, "CategoryID")
+	}
+	if request.Body.Tags != nil {
+		tags, ok := normalizeTags(*request.Body.Tags)
+		if !ok {
+			return openapi.PatchAuctionItemItemID400JSONResponse{
+				Message: lo.ToPtr("Invalid tags"),
+			}, nil
+		}
+		auction.Tags = tags
+		columns = append(columns, "Tags")
+	}
+
+	// 只能在第一次出價前修改的欄位
+	startingPrice := auction.StartingPrice
+	restricted := []string{}
+	if request.Body.StartingPrice != nil {
+		auction.StartingPrice = *request.Body.StartingPrice
+		restricted = append(restricted, "StartingPrice")
+	}
+	if request.Body.StartTime != nil {
+		auction.StartTime = *request.Body.StartTime
+		restricted = append(restricted, "StartTime")
+	}
+	if request.Body.EndTime != nil {
+		auction.EndTime = *request.Body.EndTime
+		restricted = append(restricted, "EndTime")
+	}
+	if request.Body.SoftClose != nil {
+		auction.SoftCloseWindow = request.Body.SoftClose.Window
+		auction.SoftCloseExtension = request.Body.SoftClose.Extension
+		restricted = append(restricted, "SoftCloseWindow", "SoftCloseExtension")
+	}
+	if request.Body.BidIncrement != nil {
+		bidIncrement, ok := bidIncrementFromAPI(request.Body.BidIncrement)
+		if !ok {
+			return openapi.PatchAuctionItemItemID400JSONResponse{
+				Message: lo.ToPtr("Invalid bid increment settings"),
+			}, nil
+		}
+		auction.BidIncrement = bidIncrement
+		restricted = append(restricted, "BidIncrement")
+	}
+	//  - 設定為0時代表移除
+	if request.Body.ReservePrice != nil {
+		auction.ReservePrice = lo.Ternary(*request.Body.ReservePrice == 0, nil, request.Body.ReservePrice)
+		restricted = append(restricted, "ReservePrice")
+	}
+	if request.Body.BuyNowPrice != nil {
+		auction.BuyNowPrice = lo.Ternary(*request.Body.BuyNowPrice == 0, nil, request.Body.BuyNowPrice)
+		restricted = append(restricted, "BuyNowPrice")
+	}
+	if message := validateAuctionItem(&auction); message != nil {
+		return openapi.PatchAuctionItemItemID400JSONResponse{
+			Message: message,
+		}, nil
+	}
+	if auction.CategoryID != nil && lo.Contains(columns, "CategoryID") {
+		exists, err := impl.categoryExists(ctx, *auction.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] Fail to find category, err=%w", op, err)
+		}
+		if !exists {
+			return openapi.PatchAuctionItemItemID400JSONResponse{
+				Message: lo.ToPtr("Category not found"),
+			}, nil
+		}
+	}
+
+	// 不涉及價格和時間時直接更新
+	if len(restricted) == 0 {
+		if len(columns) > 0 {
+			if result := impl.db.Select(columns).Omit(clause.Associations).Updates(&auction); result.Error != nil {
+				return nil, fmt.Errorf("[%s] Fail to update auction item, err=%w", op, result.Error)
+			}
+		}
+		impl.reindexSuggestTitle(ctx, title, &auction)
+		return openapi.PatchAuctionItemItemID200Response{}, nil
+	}
+
+	// 取得Redis上商品的出價鎖，確保檢查和更新的過程中沒有新的出價
+	lockKey := fmt.Sprintf("%sauction:%s:lock", impl.config.Redis.KeyPrefix, request.ItemID)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to acquire bid lock, err=%w", op, err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			slog.Warn("Fail to release bid lock", slog.String("op", op), slog.Any("error", err))
+		}
+	}()
+	hasBid, err := impl.hasBid(lockCtx, &auction, startingPrice)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check bids, err=%w", op, err)
+	}
+	if hasBid {
+		return openapi.PatchAuctionItemItemID409JSONResponse{
+			Message: lo.ToPtr("Auction already has bids"),
+		}, nil
+	}
+	if result := impl.db.WithContext(lockCtx).Select(append(columns, restricted...)).Omit(clause.Associations).Updates(&auction); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to update auction item, err=%w", op, result.Error)
+	}
+	// 移除Redis中的拍賣資訊，下一次出價時會從資料庫重新載入
+	if err := impl.redisClient.Del(lockCtx, impl.auctionRedisKeys(request.ItemID)...).Err(); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to invalidate auction in Redis, err=%w", op, err)
+	}
+	impl.reindexSuggestTitle(ctx, title, &auction)
+	return openapi.PatchAuctionItemItemID200Response{}, nil
+}
+
+// Cancel an auction item
+// (DELETE /auction/item/{itemID})
+func (impl *ServerImpl) DeleteAuctionItemItemID(ctx context.Context, request openapi.DeleteAuctionItemItemIDRequestObject) (openapi.DeleteAuctionItemItemIDResponseObject, error) {
+	const op = "DeleteAuctionItemItemID"
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.DeleteAuctionItemItemID404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 檢查使用者是否為賣家
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.DeleteAuctionItemItemID401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.DeleteAuctionItemItemID401Response{}, nil
+	}
+	if auction.UserID != uuid.MustParse(token.Subject) || !impl.hasRole(token, RoleSeller) {
+		return openapi.DeleteAuctionItemItemID403Response{}, nil
+	}
+	//  - 停權的使用者不能管理拍賣物品
+	banned, err := impl.userBanned(ctx, auction.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.DeleteAuctionItemItemID403Response{}, nil
+	}
+	// 檢查拍賣是否已經結標
+	if auction.Status != models.AuctionStatusActive {
+		return openapi.DeleteAuctionItemItemID410Response{}, nil
+	}
+
+	cancelled, err := impl.cancelAuction(ctx, request.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to cancel auction, err=%w", op, err)
+	}
+	if !cancelled {
+		return openapi.DeleteAuctionItemItemID410Response{}, nil
+	}
+	slog.Info("Auction cancelled", slog.String("user", token.Subject), slog.String("auctionID", request.ItemID.String()))
+	if err := impl.unindexSuggestTitle(ctx, auction.Title); err != nil {
+		slog.Error("Fail to unindex auction title", slog.String("auctionID", request.ItemID.String()), slog.Any("error", err))
+	}
+
+	// 通知SSE訂閱者
+	event := openapi.AuctionEndedEvent{
+		Status: openapi.AuctionStatus(models.AuctionStatusCancelled),
+		Time:   time.Now(),
+	}
+	if err := impl.publishAuctionEvent(request.ItemID, AuctionEventEnded, event); err != nil {
+		slog.Error("Fail to publish ended event", slog.String("auctionID", request.ItemID.String()), slog.Any("error", err))
+	}
+	return openapi.DeleteAuctionItemItemID204Response{}, nil
+}
+
+// Relist an unsold auction item
+// (POST /auction/item/{itemID}/relist)
+func (impl *ServerImpl) PostAuctionItemItemIDRelist(ctx context.Context, request openapi.PostAuctionItemItemIDRelistRequestObject) (openapi.PostAuctionItemItemIDRelistResponseObject, error) {
+	const op = "PostAuctionItemItemIDRelist"
+	// 檢查拍賣物品是否存在
+	origin := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.First(&origin); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDRelist404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 檢查使用者是否為賣家
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDRelist401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDRelist401Response{}, nil
+	}
+	//  - 重新上架等同刊登新的拍賣物品，需要賣家角色
+	if origin.UserID != uuid.MustParse(token.Subject) || !impl.hasRole(token, RoleSeller) {
+		return openapi.PostAuctionItemItemIDRelist403Response{}, nil
+	}
+	//  - 停權的使用者不能管理拍賣物品
+	banned, err := impl.userBanned(ctx, origin.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PostAuctionItemItemIDRelist403Response{}, nil
+	}
+	// 只有流標的拍賣可以重新上架
+	if origin.Status != models.AuctionStatusUnsold {
+		return openapi.PostAuctionItemItemIDRelist409Response{}, nil
+	}
+
+	// 複製拍賣設定到新的拍賣
+	auction := relistAuctionItem(origin, request.Body)
+	if message := validateAuctionItem(&auction); message != nil {
+		return openapi.PostAuctionItemItemIDRelist400JSONResponse{
+			Message: message,
+		}, nil
+	}
+	if result := impl.db.Create(&auction); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to create auction item, err=%w", op, result.Error)
+	}
+	if err := impl.indexSuggestTitle(ctx, &auction); err != nil {
+		slog.Error("Fail to index auction title", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+	}
+	return openapi.PostAuctionItemItemIDRelist201Response{
+		Headers: openapi.PostAuctionItemItemIDRelist201ResponseHeaders{
+			Location: auction.ID.String(),
+		},
+	}, nil
+}
+
+// relistAuctionItem 依原本的拍賣設定建立重新上架的拍賣物品
+func relistAuctionItem(origin models.AuctionItem, body *openapi.PostAuctionItemItemIDRelistJSONRequestBody) models.AuctionItem {
+	return models.AuctionItem{
+		UserID:        origin.UserID,
+		Title:         origin.Title,
+		Description:   origin.Description,
+		StartingPrice: origin.StartingPrice,
+		Currency:      origin.Currency,
+		StartTime:     lo.FromPtrOr(body.StartTime, time.Now()),
+		EndTime:       body.EndTime,
+		Carousels:     origin.Carousels,
+
+		SoftCloseWindow:    origin.SoftCloseWindow,
+		SoftCloseExtension: origin.SoftCloseExtension,
+		BidIncrement:       origin.BidIncrement,
+		ReservePrice:       origin.ReservePrice,
+		BuyNowPrice:        origin.BuyNowPrice,
+		Type:               origin.Type,
+		DutchDecrement:     origin.DutchDecrement,
+		DutchInterval:      origin.DutchInterval,
+		DutchFloorPrice:    origin.DutchFloorPrice,
+		Quantity:           origin.Quantity,
+		CategoryID:         origin.CategoryID,
+		Tags:               origin.Tags,
+		DescriptionText:    origin.DescriptionText,
+	}
+}
+
+// validateAuctionItem 檢查拍賣設定是否合法，不合法時返回錯誤訊息
+func validateAuctionItem(auction *models.AuctionItem) *string {
+	// 檢查拍賣物品的拍賣時間和結束時間是否合法
+	if auction.StartTime.After(auction.EndTime) || auction.EndTime.Before(time.Now()) {
+		return lo.ToPtr("Invalid auction time")
+	}
+	// 檢查貨幣和金額是否合法
+	if !auction.Currency.Valid() {
+		return lo.ToPtr("Invalid currency")
+	}
+	if !auction.StartingPrice.Valid() || !auction.DutchDecrement.Valid() || !auction.DutchFloorPrice.Valid() ||
+		auction.ReservePrice != nil && !auction.ReservePrice.Valid() || auction.BuyNowPrice != nil && !auction.BuyNowPrice.Valid() {
+		return lo.ToPtr("Invalid price")
+	}
+	// 檢查軟結標設定是否合法(區間和延長時間必須同時設定)
+	if (auction.SoftCloseWindow == 0) != (auction.SoftCloseExtension == 0) {
+		return lo.ToPtr("Invalid soft close settings")
+	}
+	// 檢查底價是否高於起標價
+	if auction.ReservePrice != nil && *auction.ReservePrice <= auction.StartingPrice {
+		return lo.ToPtr("Invalid reserve price")
+	}
+	// 檢查直購價是否高於起標價且不低於底價
+	if auction.BuyNowPrice != nil && (*auction.BuyNowPrice <= auction.StartingPrice ||
+		auction.ReservePrice != nil && *auction.BuyNowPrice < *auction.ReservePrice) {
+		return lo.ToPtr("Invalid buy now price")
+	}
+	// 檢查荷式拍賣設定是否合法
+	//  - 降價金額和間隔必須大於0，且最低價要低於起標價
+	//  - 荷式拍賣的價格只會下降，不支援軟結標、最小加價、底價和直購價
+	//  - 密封出價拍賣的出價不公開，不支援軟結標、最小加價和直購價
+	if auction.Type != models.AuctionTypeDutch && (auction.DutchDecrement != 0 || auction.DutchInterval != 0 || auction.DutchFloorPrice != 0) {
+		return lo.ToPtr("Invalid dutch auction settings")
+	}
+	switch auction.Type {
+	case models.AuctionTypeEnglish:
+	case models.AuctionTypeDutch:
+		if auction.DutchDecrement == 0 || auction.DutchInterval == 0 || auction.DutchFloorPrice >= auction.StartingPrice ||
+			auction.SoftCloseWindow != 0 || auction.BidIncrement.Type != "" || auction.ReservePrice != nil || auction.BuyNowPrice != nil {
+			return lo.ToPtr("Invalid dutch auction settings")
+		}
+	case models.AuctionTypeSealedFirst, models.AuctionTypeSealedSecond:
+		if auction.SoftCloseWindow != 0 || auction.BidIncrement.Type != "" || auction.BuyNowPrice != nil {
+			return lo.ToPtr("Invalid sealed bid auction settings")
+		}
+	default:
+		return lo.ToPtr("Invalid auction type")
+	}
+	// 檢查多數量拍賣設定是否合法
+	//  - 多數量拍賣只支援英式拍賣，不支援軟結標、最小加價、底價和直購價
+	if auction.Quantity == 0 {
+		return lo.ToPtr("Invalid quantity")
+	}
+	if auction.IsMultiUnit() && (auction.Type != models.AuctionTypeEnglish || auction.SoftCloseWindow != 0 ||
+		auction.BidIncrement.Type != "" || auction.ReservePrice != nil || auction.BuyNowPrice != nil) {
+		return lo.ToPtr("Invalid multi-quantity auction settings")
+	}
+	return nil
+}
+
+// auctionCacheVersion 是Redis中拍賣競價資訊的版本，競價資訊的格式或單位改變時需要更新
+// 不同版本使用不同的鍵，新版本不會讀到舊版本寫入的資料，舊的資料過期後會自動刪除
+//   - v2: 金額改為以貨幣最小單位儲存
+const auctionCacheVersion = "v2"
+
+// auctionKey 返回拍賣在Redis中的競價資訊鍵，沒有suffix時返回最高出價的鍵
+// NOTE: 鎖不是競價資訊，不需要加上版本
+func (impl *ServerImpl) auctionKey(itemID uuid.UUID, suffix ...string) string {
+	return strings.Join(append([]string{impl.config.Redis.KeyPrefix + "auction", auctionCacheVersion, itemID.String()}, suffix...), ":")
+}
+
+// auctionRedisKeys 返回拍賣在Redis中的競價資訊鍵(最高出價、狀態、代理出價和多數量拍賣的得標出價)
+func (impl *ServerImpl) auctionRedisKeys(itemID uuid.UUID) []string {
+	return []string{
+		impl.auctionKey(itemID),
+		impl.auctionKey(itemID, "state"),
+		impl.auctionKey(itemID, "proxy"),
+		impl.auctionKey(itemID, "ranking"),
+	}
+}
+
+// hasBid 檢查拍賣是否已經有人出價
+// 出價會先寫入Redis再同步回資料庫，因此同時檢查兩邊，呼叫前需要先取得出價鎖
+func (impl *ServerImpl) hasBid(ctx context.Context, auction *models.AuctionItem, startingPrice models.Money) (bool, error) {
+	if auction.CurrentBidID != nil {
+		return true, nil
+	}
+	// 密封出價和多數量拍賣的出價不會反映在最高出價上，改為檢查出價紀錄
+	if auction.Type.IsSealed() || auction.IsMultiUnit() {
+		return impl.hasBidRecord(ctx, auction)
+	}
+	auctionKey := impl.auctionKey(auction.ID)
+	price, err := impl.redisClient.Get(ctx, auctionKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return price != strconv.FormatUint(uint64(startingPrice), 10), nil
+}
+
+// hasBidRecord 檢查拍賣是否已經有出價紀錄
+// 出價會先寫入Redis再同步回資料庫，因此同時檢查資料庫和Redis中SealedBidScript、MultiUnitBidScript的出價計數
+func (impl *ServerImpl) hasBidRecord(ctx context.Context, auction *models.AuctionItem) (bool, error) {
+	var count int64
+	if result := impl.db.WithContext(ctx).Model(&models.Bid{}).Where("auction_item_id = ?", auction.ID).Count(&count); result.Error != nil {
+		return false, result.Error
+	}
+	if count > 0 {
+		return true, nil
+	}
+	stateKey := impl.auctionKey(auction.ID, "state")
+	counters, err := impl.redisClient.HMGet(ctx, stateKey, "sealed_bids", "bid_seq").Result()
+	if err != nil {
+		return false, err
+	}
+	for _, counter := range counters {
+		if raw, ok := counter.(string); ok && raw != "0" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// cancelAuction 取消拍賣，已經結標的拍賣不會被取消
+//   - 將狀態改為已取消，並透過soft delete刪除
+//   - 資料庫更新成功後在Redis中標記為已結標，之後的出價都會被拒絕
+//
+// NOTE: 持有出價鎖期間不會有新的出價寫入Redis，因此先更新資料庫再關閉Redis中的拍賣，避免資料庫更新失敗時拍賣在Redis中被關閉
+func (impl *ServerImpl) cancelAuction(ctx context.Context, itemID uuid.UUID) (bool, error) {
+	lockKey := fmt.Sprintf("%sauction:%s:lock", impl.config.Redis.KeyPrefix, itemID)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return false, fmt.Errorf("fail to acquire bid lock, err=%w", err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			slog.Warn("Fail to release bid lock", slog.String("auctionID", itemID.String()), slog.Any("error", err))
+		}
+	}()
+
+	cancelled := false
+	err = impl.db.WithContext(lockCtx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.AuctionItem{}).
+			Where("id = ? AND status = ?", itemID, models.AuctionStatusActive).
+			Update("status", models.AuctionStatusCancelled)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		cancelled = true
+		return tx.Delete(&models.AuctionItem{ID: itemID}).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf("fail to cancel auction item, err=%w", err)
+	}
+	if cancelled {
+		if err := impl.closeAuctionInRedis(lockCtx, itemID); err != nil {
+			return false, err
+		}
+	}
+	return cancelled, nil
+}
+
+// closeAuctionInRedis 在Redis中將拍賣標記為已結標，呼叫前需要持有出價鎖
+func (impl *ServerImpl) closeAuctionInRedis(ctx context.Context, itemID uuid.UUID) error {
+	stateKey := impl.auctionKey(itemID, "state")
+	if _, err := impl.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, stateKey, "closed", "1")
+		pipe.Expire(ctx, stateKey, impl.config.Redis.ExpireTime)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("fail to close auction in Redis, err=%w", err)
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+func TestValidateAuctionItem(t *testing.T) {
+	now := time.Now()
+	valid := func() models.AuctionItem {
+		return models.AuctionItem{
+			StartingPrice: 100,
+			StartTime:     now,
+			EndTime:       now.Add(time.Hour),
+			Type:          models.AuctionTypeEnglish,
+			Quantity:      1,
+			Currency:      models.DefaultCurrency,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(auction *models.AuctionItem)
+		want   *string
+	}{
+		{
+			name:   "合法的拍賣設定",
+			modify: func(auction *models.AuctionItem) {},
+			want:   nil,
+		},
+		{
+			name: "結束時間早於開始時間",
+			modify: func(auction *models.AuctionItem) {
+				auction.EndTime = now.Add(-time.Minute)
+			},
+			want: lo.ToPtr("Invalid auction time"),
+		},
+		{
+			name: "軟結標只設定區間",
+			modify: func(auction *models.AuctionItem) {
+				auction.SoftCloseWindow = 60
+			},
+			want: lo.ToPtr("Invalid soft close settings"),
+		},
+		{
+			name: "底價不高於起標價",
+			modify: func(auction *models.AuctionItem) {
+				auction.ReservePrice = lo.ToPtr(models.Money(100))
+			},
+			want: lo.ToPtr("Invalid reserve price"),
+		},
+		{
+			name: "直購價低於底價",
+			modify: func(auction *models.AuctionItem) {
+				auction.ReservePrice = lo.ToPtr(models.Money(500))
+				auction.BuyNowPrice = lo.ToPtr(models.Money(400))
+			},
+			want: lo.ToPtr("Invalid buy now price"),
+		},
+		{
+			name: "直購價不低於底價",
+			modify: func(auction *models.AuctionItem) {
+				auction.ReservePrice = lo.ToPtr(models.Money(500))
+				auction.BuyNowPrice = lo.ToPtr(models.Money(500))
+			},
+			want: nil,
+		},
+		{
+			name: "合法的荷式拍賣設定",
+			modify: func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeDutch
+				auction.DutchDecrement = 10
+				auction.DutchInterval = 60
+				auction.DutchFloorPrice = 50
+			},
+			want: nil,
+		},
+		{
+			name: "荷式拍賣最低價不低於起標價",
+			modify: func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeDutch
+				auction.DutchDecrement = 10
+				auction.DutchInterval = 60
+				auction.DutchFloorPrice = 100
+			},
+			want: lo.ToPtr("Invalid dutch auction settings"),
+		},
+		{
+			name: "荷式拍賣不支援直購價",
+			modify: func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeDutch
+				auction.DutchDecrement = 10
+				auction.DutchInterval = 60
+				auction.BuyNowPrice = lo.ToPtr(models.Money(500))
+			},
+			want: lo.ToPtr("Invalid dutch auction settings"),
+		},
+		{
+			name: "英式拍賣不能設定降價",
+			modify: func(auction *models.AuctionItem) {
+				auction.DutchDecrement = 10
+			},
+			want: lo.ToPtr("Invalid dutch auction settings"),
+		},
+		{
+			name: "合法的密封出價拍賣設定",
+			modify: func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeSealedSecond
+				auction.ReservePrice = lo.ToPtr(models.Money(500))
+			},
+			want: nil,
+		},
+		{
+			name: "密封出價拍賣不支援軟結標",
+			modify: func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeSealedFirst
+				auction.SoftCloseWindow = 60
+				auction.SoftCloseExtension = 60
+			},
+			want: lo.ToPtr("Invalid sealed bid auction settings"),
+		},
+		{
+			name: "合法的多數量拍賣設定",
+			modify: func(auction *models.AuctionItem) {
+				auction.Quantity = 10
+			},
+			want: nil,
+		},
+		{
+			name: "數量不能為0",
+			modify: func(auction *models.AuctionItem) {
+				auction.Quantity = 0
+			},
+			want: lo.ToPtr("Invalid quantity"),
+		},
+		{
+			name: "多數量拍賣不支援底價",
+			modify: func(auction *models.AuctionItem) {
+				auction.Quantity = 10
+				auction.ReservePrice = lo.ToPtr(models.Money(500))
+			},
+			want: lo.ToPtr("Invalid multi-quantity auction settings"),
+		},
+		{
+			name: "不支援的拍賣類型",
+			modify: func(auction *models.AuctionItem) {
+				auction.Type = "vickrey"
+			},
+			want: lo.ToPtr("Invalid auction type"),
+		},
+		{
+			name: "不支援的貨幣",
+			modify: func(auction *models.AuctionItem) {
+				auction.Currency = "BTC"
+			},
+			want: lo.ToPtr("Invalid currency"),
+		},
+		{
+			name: "金額超過上限",
+			modify: func(auction *models.AuctionItem) {
+				auction.BuyNowPrice = lo.ToPtr(models.MaxMoney + 1)
+			},
+			want: lo.ToPtr("Invalid price"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auction := valid()
+			tt.modify(&auction)
+			assert.Equal(t, tt.want, validateAuctionItem(&auction))
+		})
+	}
+}
+
+func TestRelistAuctionItem(t *testing.T) {
+	now := time.Now()
+	origin := func(currency models.Currency, auctionType models.AuctionType) models.AuctionItem {
+		return models.AuctionItem{
+			ID:            uuid.New(),
+			UserID:        uuid.New(),
+			Title:         "camera",
+			StartingPrice: 1000,
+			Currency:      currency,
+			StartTime:     now.Add(-2 * time.Hour),
+			EndTime:       now.Add(-time.Hour),
+			Type:          auctionType,
+			Quantity:      1,
+			Status:        models.AuctionStatusUnsold,
+			ReservePrice:  lo.ToPtr(models.Money(5000)),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		origin models.AuctionItem
+		body   openapi.PostAuctionItemItemIDRelistJSONRequestBody
+	}{
+		{
+			name:   "預設貨幣",
+			origin: origin(models.DefaultCurrency, models.AuctionTypeEnglish),
+			body:   openapi.PostAuctionItemItemIDRelistJSONRequestBody{EndTime: now.Add(time.Hour)},
+		},
+		{
+			name:   "非預設貨幣",
+			origin: origin(models.CurrencyJPY, models.AuctionTypeEnglish),
+			body:   openapi.PostAuctionItemItemIDRelistJSONRequestBody{EndTime: now.Add(time.Hour)},
+		},
+		{
+			name:   "指定開始時間",
+			origin: origin(models.CurrencyUSD, models.AuctionTypeSealedSecond),
+			body: openapi.PostAuctionItemItemIDRelistJSONRequestBody{
+				StartTime: lo.ToPtr(now.Add(time.Minute)),
+				EndTime:   now.Add(time.Hour),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auction := relistAuctionItem(tt.origin, &tt.body)
+			// 重新上架的設定必須通過檢查才會返回201
+			assert.Nil(t, validateAuctionItem(&auction))
+			assert.Equal(t, tt.origin.Currency, auction.Currency)
+			assert.Equal(t, tt.origin.UserID, auction.UserID)
+			assert.Equal(t, tt.origin.StartingPrice, auction.StartingPrice)
+			assert.Equal(t, tt.origin.ReservePrice, auction.ReservePrice)
+			assert.Equal(t, tt.body.EndTime, auction.EndTime)
+			assert.Equal(t, uuid.Nil, auction.ID)
+			assert.Empty(t, auction.Status)
+		})
+	}
+}
+
+func TestAuctionKey(t *testing.T) {
+	impl := &ServerImpl{config: ServerConfig{Redis: RedisConfig{KeyPrefix: "q4:"}}}
+	itemID := uuid.MustParse("0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e")
+
+	// 競價資訊的鍵需要包含版本，避免讀到舊版本寫入的資料
+	assert.Equal(t, "q4:auction:v2:0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e", impl.auctionKey(itemID))
+	assert.Equal(t, "q4:auction:v2:0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e:state", impl.auctionKey(itemID, "state"))
+	assert.Equal(t, []string{
+		"q4:auction:v2:0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e",
+		"q4:auction:v2:0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e:state",
+		"q4:auction:v2:0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e:proxy",
+		"q4:auction:v2:0192a0b4-6f4e-7c1a-9d1e-2f3a4b5c6d7e:ranking",
+	}, impl.auctionRedisKeys(itemID))
+}
@@ -0,0 +1,200 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// 目前價格的排序欄位，沒有人出價時使用起標價
+const currentPriceColumn = `COALESCE("CurrentBid".amount, auction_items.starting_price)`
+
+// auctionListQuery 依拍賣物品列表的篩選、排序和分頁參數建立查詢
+// 參數不合法時返回需要回覆給使用者的錯誤訊息
+func (impl *ServerImpl) auctionListQuery(ctx context.Context, params *openapi.GetAuctionItemsParams, now time.Time) (*gorm.DB, string, error) {
+	query := impl.db.WithContext(ctx).Joins("CurrentBid").Model(&models.AuctionItem{})
+	//  - title
+	if params.Title != nil {
+		query = query.Where("title LIKE ?", "%"+*params.Title+"%")
+	}
+	//  - search
+	// 全文檢索標題和描述
+	search := strings.TrimSpace(lo.FromPtr(params.Search))
+	if search != "" {
+		query = query.Where(searchMatch, search)
+	}
+	//  - start_price
+	if params.StartPrice != nil {
+		if params.StartPrice.From != nil {
+			query = query.Where("starting_price >= ?", *params.StartPrice.From)
+		}
+		if params.StartPrice.To != nil {
+			query = query.Where("starting_price <= ?", *params.StartPrice.To)
+		}
+	}
+	//  - start_time
+	if params.StartTime != nil {
+		if params.StartTime.From != nil {
+			query = query.Where("start_time >= ?", *params.StartTime.From)
+		}
+		if params.StartTime.To != nil {
+			query = query.Where("start_time <= ?", *params.StartTime.To)
+		}
+	}
+	//  - end_time
+	if params.EndTime != nil {
+		if params.EndTime.From != nil {
+			query = query.Where("end_time >= ?", *params.EndTime.From)
+		}
+		if params.EndTime.To != nil {
+			query = query.Where("end_time <= ?", *params.EndTime.To)
+		}
+	}
+	//  - current_bid
+	// 目前實際價格是記錄在另外一張表(bids)中，所以需要透過join來查詢
+	// 且如果目前沒有人出價，則需要使用起標價格來進行篩選
+	if params.CurrentBid != nil {
+		if params.CurrentBid.From != nil {
+			query = query.Where(currentPriceColumn+" >= ?", *params.CurrentBid.From)
+		}
+		if params.CurrentBid.To != nil {
+			query = query.Where(currentPriceColumn+" <= ?", *params.CurrentBid.To)
+		}
+	}
+	//  - category
+	// 包含所有子分類中的拍賣物品
+	if params.Category != nil {
+		query = query.Where("category_id IN (?)", categorySubtree(impl.db, *params.Category))
+	}
+	//  - tags
+	if params.Tags != nil {
+		tags, ok := normalizeTags(*params.Tags)
+		if !ok {
+			return nil, "Invalid tags", nil
+		}
+		if len(tags) > 0 {
+			switch lo.FromPtrOr(params.TagMatch, openapi.Any) {
+			case openapi.Any:
+				query = query.Where("tags && ?", pq.StringArray(tags))
+			case openapi.All:
+				query = query.Where("tags @> ?", pq.StringArray(tags))
+			default:
+				return nil, "Invalid tag match", nil
+			}
+		}
+	}
+	//  - excludeEnded
+	if params.ExcludeEnded != nil && *params.ExcludeEnded {
+		query = query.Where("end_time > ?", now)
+	}
+	//  - sort
+	sortKey, desc := "title", false
+	if params.Sort != nil {
+		if params.Sort.Key != nil {
+			switch *params.Sort.Key {
+			case openapi.Title:
+				sortKey = "title"
+			case openapi.StartTime:
+				sortKey = "start_time"
+			case openapi.EndTime:
+				sortKey = "end_time"
+			case openapi.CurrentBid:
+				sortKey = currentPriceColumn
+			case openapi.StartPrice:
+				sortKey = "starting_price"
+			case openapi.Relevance:
+				if search == "" {
+					return nil, "Relevance sort requires a search query", nil
+				}
+				sortKey = "relevance"
+			default:
+				return nil, "Invalid sort key", nil
+			}
+		}
+		if params.Sort.Order != nil {
+			desc = *params.Sort.Order == openapi.Desc
+		}
+	}
+	// 相關程度一律由高到低排序
+	if sortKey == "relevance" {
+		query = query.Order(clause.OrderBy{Expression: clause.Expr{SQL: searchRank + " DESC, auction_items.id", Vars: []any{search}, WithoutParentheses: true}})
+	} else {
+		query = query.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
+			{Column: clause.Column{Name: sortKey, Raw: true}, Desc: desc},
+			{Column: clause.Column{Name: "auction_items.id", Raw: true}, Desc: false},
+		}})
+	}
+	//  - cursor
+	// 從上一頁最後一筆的排序值之後繼續查詢，排序值相同時依ID排序
+	if params.LastItemID != nil {
+		column, op := sortKey, lo.Ternary(desc, "<", ">")
+		var vars []any
+		if sortKey == "relevance" {
+			column, op, vars = searchRank, "<", []any{search}
+		}
+		var cursor string
+		if result := impl.db.WithContext(ctx).Joins("CurrentBid").Model(&models.AuctionItem{}).
+			Select(column, vars...).
+			Where("auction_items.id = ?", *params.LastItemID).
+			First(&cursor); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return nil, "Last item not found", nil
+			}
+			return nil, "", fmt.Errorf("fail to find last item, err=%w", result.Error)
+		}
+		args := slices.Concat(vars, []any{cursor}, vars, []any{cursor, *params.LastItemID})
+		query = query.Where("("+column+" "+op+" ? OR "+column+" = ? AND auction_items.id > ?)", args...)
+	}
+	//  - size
+	query = query.Limit(int(lo.FromPtrOr(params.Size, 1)))
+	return query, "", nil
+}
+
+// auctionListItems 轉換列表中的拍賣物品，全文檢索時附上描述中符合的片段
+// NOTE: auctions需要預先載入CurrentBid
+func (impl *ServerImpl) auctionListItems(ctx context.Context, auctions []models.AuctionItem, search string, now time.Time) ([]openapi.AuctionListItem, error) {
+	var snippets map[uuid.UUID]string
+	if search = strings.TrimSpace(search); search != "" {
+		var err error
+		snippets, err = impl.searchSnippets(ctx, search, lo.Map(auctions, func(auction models.AuctionItem, _ int) uuid.UUID {
+			return auction.ID
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("fail to get search snippets, err=%w", err)
+		}
+	}
+	output := make([]openapi.AuctionListItem, len(auctions))
+	for i, auction := range auctions {
+		if price := dutchCurrentPrice(&auction, now); price != nil {
+			output[i].CurrentBid = *price
+		} else if auction.CurrentBid != nil {
+			output[i].CurrentBid = auction.CurrentBid.Amount
+		} else {
+			output[i].CurrentBid = auction.StartingPrice
+		}
+		output[i].Id = auction.ID
+		output[i].Title = auction.Title
+		output[i].Currency = openapi.Currency(auction.Currency)
+		output[i].EndTime = auction.EndTime
+		output[i].StartTime = auction.StartTime
+		output[i].IsEnded = now.After(auction.EndTime)
+		output[i].Status = openapi.AuctionStatus(auction.Status)
+		output[i].ReserveMet = reserveMet(&auction)
+		if snippet, ok := snippets[auction.ID]; ok {
+			output[i].Snippet = &snippet
+		}
+	}
+	return output, nil
+}
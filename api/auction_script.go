@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// runAuctionScript 在出價鎖中執行處理出價的Lua script(BuyNowScript、DutchAcceptScript、SealedBidScript)，返回{狀態, 金額}
+//   - KEYS為{競價商品鍵, bid stream, 狀態鍵}
+//   - ARGV為{出價資訊, 過期時間, 出價時間, extraArgs...}
+//   - Redis中沒有拍賣資訊時會從資料庫載入後再試一次
+func (impl *ServerImpl) runAuctionScript(ctx context.Context, auction *models.AuctionItem, bidInfo *BidInfo, script *redis.Script, extraArgs ...any) (int, models.Money, error) {
+	lockCtx, unlock, err := impl.lockBid(ctx, auction.ID)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer unlock()
+
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	if err != nil {
+		return 0, 0, fmt.Errorf("fail to marshal bid info, err=%w", err)
+	}
+	auctionKey := impl.auctionKey(auction.ID)
+	stateKey := impl.auctionKey(auction.ID, "state")
+	keys := []string{auctionKey, impl.config.Redis.StreamKeys.BidStream, stateKey}
+	args := append([]any{base64.StdEncoding.EncodeToString(bidInfoBytes), impl.config.Redis.ExpireTime.Seconds(), bidInfo.CreatedAt.UnixMilli()}, extraArgs...)
+	status, price, err := runStatusScript(lockCtx, impl.redisClient, script, keys, args...)
+	if err != nil || status != -1 {
+		return status, price, err
+	}
+
+	// Redis中沒有拍賣資訊時，從資料庫載入後再試一次
+	if err := impl.loadAuctionToRedis(lockCtx, auction); err != nil {
+		return 0, 0, fmt.Errorf("fail to load auction into Redis, err=%w", err)
+	}
+	return runStatusScript(lockCtx, impl.redisClient, script, keys, args...)
+}
+
+// lockBid 取得Redis上商品的出價鎖，所有會改變競價狀態的操作都共用同一把鎖
+func (impl *ServerImpl) lockBid(ctx context.Context, itemID uuid.UUID) (context.Context, func(), error) {
+	lockKey := fmt.Sprintf("%sauction:%s:lock", impl.config.Redis.KeyPrefix, itemID)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fail to acquire bid lock, err=%w", err)
+	}
+	return lockCtx, func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			slog.Warn("Fail to release bid lock", slog.String("auctionID", itemID.String()), slog.Any("error", err))
+		}
+	}, nil
+}
+
+// endAuctionEarly 在直購或荷式拍賣成交後將拍賣提前結標
+func (impl *ServerImpl) endAuctionEarly(ctx context.Context, auctionID uuid.UUID, at time.Time) error {
+	// 將資料庫中的結束時間提前，即使立即結標失敗，結標worker也會接手處理
+	if result := impl.db.Model(&models.AuctionItem{}).
+		Where("id = ? AND status = ?", auctionID, models.AuctionStatusActive).
+		Update("end_time", at); result.Error != nil {
+		return fmt.Errorf("fail to update auction end time, err=%w", result.Error)
+	}
+	if err := impl.settleAuction(context.WithoutCancel(ctx), auctionID); err != nil {
+		slog.Error("Fail to settle auction early, leave it to settlement worker", slog.String("auctionID", auctionID.String()), slog.Any("error", err))
+	}
+	return nil
+}
+
+// bidInfoUser 返回出價者的資訊，附上出價事件顯示的評價統計
+// 評價只用於顯示，查詢失敗時仍然可以出價
+func (impl *ServerImpl) bidInfoUser(ctx context.Context, token *openapi.JWT) BidInfoUser {
+	user := models.User{ID: uuid.MustParse(token.Subject)}
+	if result := impl.db.WithContext(ctx).Select("id", "rating_count", "rating_sum").First(&user); result.Error != nil {
+		slog.Warn("Fail to find bidder reputation", slog.String("user", token.Subject), slog.Any("error", result.Error))
+	}
+	return BidInfoUser{
+		ID:         user.ID,
+		Name:       token.Username,
+		Reputation: user.Reputation,
+	}
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+const (
+	// 拍賣物品詳細資訊中附帶的最新出價數量
+	latestBidRecords = 10
+	// 出價紀錄每頁預設返回的數量
+	defaultBidHistorySize = 20
+	// 出價紀錄每頁最多返回的數量
+	maxBidHistorySize = 100
+)
+
+// List bids of an auction item
+// (GET /auction/item/{itemID}/bids)
+func (impl *ServerImpl) GetAuctionItemItemIDBids(ctx context.Context, request openapi.GetAuctionItemItemIDBidsRequestObject) (openapi.GetAuctionItemItemIDBidsResponseObject, error) {
+	const op = "GetAuctionItemItemIDBids"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultBidHistorySize))
+	if size == 0 || size > maxBidHistorySize {
+		return openapi.GetAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.GetAuctionItemItemIDBids404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 由新到舊查詢出價紀錄，時間相同時依ID排序
+	query := impl.bidQuery(ctx, &auction, impl.viewerID(request.Params.AccessToken)).
+		Preload("User").
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(size)
+	//  - cursor
+	if request.Params.LastBidID != nil {
+		last := models.Bid{ID: *request.Params.LastBidID}
+		if result := impl.db.WithContext(ctx).Where("auction_item_id = ?", auction.ID).First(&last); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return openapi.GetAuctionItemItemIDBids400JSONResponse{
+					Message: lo.ToPtr("Last bid not found"),
+				}, nil
+			}
+			return nil, fmt.Errorf("[%s] Fail to find last bid, err=%w", op, result.Error)
+		}
+		query = query.Where("(created_at < ? OR created_at = ? AND id < ?)", last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	var bids []models.Bid
+	if result := query.Find(&bids); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list bids, err=%w", op, result.Error)
+	}
+	return openapi.GetAuctionItemItemIDBids200JSONResponse{
+		Count: len(bids),
+		Items: bidRecordsToAPI(&auction, bids),
+	}, nil
+}
+
+// viewerID 從access token中取得目前使用者的ID，未登入或token不合法時返回uuid.Nil
+func (impl *ServerImpl) viewerID(accessToken *string) uuid.UUID {
+	if accessToken == nil {
+		return uuid.Nil
+	}
+	token, err := openapi.ParseAndValidateJWT(*accessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		return uuid.Nil
+	}
+	return uuid.MustParse(token.Subject)
+}
+
+// bidQuery 返回拍賣物品中使用者可以看到的出價的查詢
+// 密封出價拍賣在結標前只能看到自己的出價，未登入時看不到任何出價
+func (impl *ServerImpl) bidQuery(ctx context.Context, auction *models.AuctionItem, viewerID uuid.UUID) *gorm.DB {
+	query := impl.db.WithContext(ctx).Model(&models.Bid{}).Where("auction_item_id = ?", auction.ID)
+	if sealedBidsHidden(auction) {
+		query = query.Where("user_id = ?", viewerID)
+	}
+	return query
+}
+
+// bidCounts 返回拍賣物品中使用者可以看到的出價數量和出價者數量
+func (impl *ServerImpl) bidCounts(ctx context.Context, auction *models.AuctionItem, viewerID uuid.UUID) (int, int, error) {
+	var counts struct {
+		Bids    int
+		Bidders int
+	}
+	if result := impl.bidQuery(ctx, auction, viewerID).
+		Select("COUNT(*) AS bids, COUNT(DISTINCT user_id) AS bidders").
+		Scan(&counts); result.Error != nil {
+		return 0, 0, result.Error
+	}
+	return counts.Bids, counts.Bidders, nil
+}
+
+// latestBidPerBidder 返回多數量拍賣中每位出價者最新的一筆出價，用於計算數量的分配，單一數量的拍賣返回nil
+func (impl *ServerImpl) latestBidPerBidder(ctx context.Context, auction *models.AuctionItem) ([]models.Bid, error) {
+	if !auction.IsMultiUnit() {
+		return nil, nil
+	}
+	var bids []models.Bid
+	if result := impl.db.WithContext(ctx).Preload("User").
+		Select("DISTINCT ON (user_id) *").
+		Where("auction_item_id = ?", auction.ID).
+		Order("user_id").
+		Order("created_at DESC").
+		Find(&bids); result.Error != nil {
+		return nil, result.Error
+	}
+	return bids, nil
+}
+
+// bidRecordsToAPI 轉換出價紀錄，多數量拍賣會附上想購買的數量
+// NOTE: bids需要預先載入User
+func bidRecordsToAPI(auction *models.AuctionItem, bids []models.Bid) []openapi.BidRecord {
+	return lo.Map(bids, func(bid models.Bid, _ int) openapi.BidRecord {
+		record := openapi.BidRecord{
+			Id:             bid.ID,
+			Bid:            bid.Amount,
+			User:           bid.User.Username,
+			UserReputation: lo.ToPtr(reputationToAPI(bid.User.Reputation)),
+			Time:           bid.CreatedAt,
+		}
+		if auction.IsMultiUnit() {
+			record.Quantity = lo.ToPtr(max(bid.Quantity, 1))
+		}
+		return record
+	})
+}
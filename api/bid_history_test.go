@@ -0,0 +1,47 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+)
+
+func TestBidRecordsToAPI(t *testing.T) {
+	bid := models.Bid{ID: uuid.New(), Amount: 150, Quantity: 2, User: models.User{Username: "alice"}}
+	bid.CreatedAt = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		quantity     uint32
+		wantQuantity *uint32
+	}{
+		{
+			name:         "單一數量的拍賣不顯示數量",
+			quantity:     1,
+			wantQuantity: nil,
+		},
+		{
+			name:         "多數量拍賣顯示想購買的數量",
+			quantity:     5,
+			wantQuantity: lo.ToPtr(uint32(2)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := bidRecordsToAPI(&models.AuctionItem{Quantity: tt.quantity}, []models.Bid{bid})
+			if assert.Len(t, records, 1) {
+				assert.Equal(t, bid.ID, records[0].Id)
+				assert.Equal(t, models.Money(150), records[0].Bid)
+				assert.Equal(t, "alice", records[0].User)
+				assert.Equal(t, bid.CreatedAt, records[0].Time)
+				assert.Equal(t, tt.wantQuantity, records[0].Quantity)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// bidIncrementFromAPI 驗證並轉換最小加價規則，規則不合法時返回false
+func bidIncrementFromAPI(input *openapi.BidIncrement) (models.BidIncrementRule, bool) {
+	if input == nil {
+		return models.BidIncrementRule{}, true
+	}
+	switch input.Type {
+	case openapi.Fixed:
+		if input.Step == nil || *input.Step == 0 || !input.Step.Valid() {
+			return models.BidIncrementRule{}, false
+		}
+		return models.BidIncrementRule{Type: models.BidIncrementFixed, Step: *input.Step}, true
+	case openapi.Percent:
+		if input.Percent == nil || *input.Percent == 0 || *input.Percent > 100 {
+			return models.BidIncrementRule{}, false
+		}
+		return models.BidIncrementRule{Type: models.BidIncrementPercent, Percent: *input.Percent}, true
+	case openapi.Tiered:
+		// 區間必須從0開始，且依價格遞增排序
+		if input.Tiers == nil || len(*input.Tiers) == 0 || (*input.Tiers)[0].From != 0 {
+			return models.BidIncrementRule{}, false
+		}
+		tiers := make([]models.BidIncrementTier, len(*input.Tiers))
+		for i, tier := range *input.Tiers {
+			if tier.Step == 0 || !tier.Step.Valid() || !tier.From.Valid() || (i > 0 && tier.From <= tiers[i-1].From) {
+				return models.BidIncrementRule{}, false
+			}
+			tiers[i] = models.BidIncrementTier{From: tier.From, Step: tier.Step}
+		}
+		return models.BidIncrementRule{Type: models.BidIncrementTiered, Tiers: tiers}, true
+	}
+	return models.BidIncrementRule{}, false
+}
+
+// bidIncrementToAPI 轉換最小加價規則，未設定時返回nil
+func bidIncrementToAPI(rule models.BidIncrementRule) *openapi.BidIncrement {
+	switch rule.Type {
+	case models.BidIncrementFixed:
+		return &openapi.BidIncrement{Type: openapi.Fixed, Step: lo.ToPtr(rule.Step)}
+	case models.BidIncrementPercent:
+		return &openapi.BidIncrement{Type: openapi.Percent, Percent: lo.ToPtr(rule.Percent)}
+	case models.BidIncrementTiered:
+		tiers := lo.Map(rule.Tiers, func(tier models.BidIncrementTier, _ int) openapi.BidIncrementTier {
+			return openapi.BidIncrementTier{From: tier.From, Step: tier.Step}
+		})
+		return &openapi.BidIncrement{Type: openapi.Tiered, Tiers: &tiers}
+	}
+	return nil
+}
+
+// bidIncrementState 將最小加價規則轉換為BidScript使用的狀態欄位
+//   - 固定金額視為只有一個從0開始的區間
+func bidIncrementState(rule models.BidIncrementRule) []any {
+	switch rule.Type {
+	case models.BidIncrementFixed:
+		return []any{"increment_tiers", fmt.Sprintf("0:%d", rule.Step)}
+	case models.BidIncrementPercent:
+		return []any{"increment_percent", rule.Percent}
+	case models.BidIncrementTiered:
+		tiers := lo.Map(rule.Tiers, func(tier models.BidIncrementTier, _ int) string {
+			return fmt.Sprintf("%d:%d", tier.From, tier.Step)
+		})
+		return []any{"increment_tiers", strings.Join(tiers, ",")}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+func TestBidIncrementFromAPI(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  *openapi.BidIncrement
+		want   models.BidIncrementRule
+		wantOk bool
+	}{
+		{
+			name:   "未設定時應使用預設規則",
+			input:  nil,
+			want:   models.BidIncrementRule{},
+			wantOk: true,
+		},
+		{
+			name:   "固定加價金額不可為0",
+			input:  &openapi.BidIncrement{Type: openapi.Fixed, Step: lo.ToPtr(openapi.Money(0))},
+			wantOk: false,
+		},
+		{
+			name:   "百分比不可超過100",
+			input:  &openapi.BidIncrement{Type: openapi.Percent, Percent: lo.ToPtr(uint32(101))},
+			wantOk: false,
+		},
+		{
+			name: "區間必須從0開始",
+			input: &openapi.BidIncrement{Type: openapi.Tiered, Tiers: &[]openapi.BidIncrementTier{
+				{From: 100, Step: 10},
+			}},
+			wantOk: false,
+		},
+		{
+			name: "區間必須遞增排序",
+			input: &openapi.BidIncrement{Type: openapi.Tiered, Tiers: &[]openapi.BidIncrementTier{
+				{From: 0, Step: 10}, {From: 1000, Step: 50}, {From: 1000, Step: 100},
+			}},
+			wantOk: false,
+		},
+		{
+			name: "合法的區間加價",
+			input: &openapi.BidIncrement{Type: openapi.Tiered, Tiers: &[]openapi.BidIncrementTier{
+				{From: 0, Step: 10}, {From: 1000, Step: 50},
+			}},
+			want: models.BidIncrementRule{Type: models.BidIncrementTiered, Tiers: []models.BidIncrementTier{
+				{From: 0, Step: 10}, {From: 1000, Step: 50},
+			}},
+			wantOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := bidIncrementFromAPI(tt.input)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBidIncrementState(t *testing.T) {
+	assert.Nil(t, bidIncrementState(models.BidIncrementRule{}))
+	assert.Equal(t, []any{"increment_tiers", "0:50"}, bidIncrementState(models.BidIncrementRule{Type: models.BidIncrementFixed, Step: 50}))
+	assert.Equal(t, []any{"increment_percent", uint32(5)}, bidIncrementState(models.BidIncrementRule{Type: models.BidIncrementPercent, Percent: 5}))
+	assert.Equal(t, []any{"increment_tiers", "0:10,1000:50"}, bidIncrementState(models.BidIncrementRule{Type: models.BidIncrementTiered, Tiers: []models.BidIncrementTier{
+		{From: 0, Step: 10}, {From: 1000, Step: 50},
+	}}))
+}
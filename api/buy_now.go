@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// Buy an auction item at its buy-now price
+// (POST /auction/item/{itemID}/buy-now)
+func (impl *ServerImpl) PostAuctionItemItemIDBuyNow(ctx context.Context, request openapi.PostAuctionItemItemIDBuyNowRequestObject) (openapi.PostAuctionItemItemIDBuyNowResponseObject, error) {
+	const op = "PostAuctionItemItemIDBuyNow"
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.Preload("CurrentBid.User").First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDBuyNow404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 檢查拍賣物品是否已經開始
+	if time.Now().Before(auction.StartTime) {
+		return openapi.PostAuctionItemItemIDBuyNow403JSONResponse{
+			Message: lo.ToPtr("Auction has not started"),
+		}, nil
+	}
+	// 檢查拍賣物品是否已經結標
+	if auction.Status != models.AuctionStatusActive {
+		return openapi.PostAuctionItemItemIDBuyNow410Response{}, nil
+	}
+	// 檢查使用者是否可以購買
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDBuyNow401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDBuyNow401Response{}, nil
+	}
+	//  - 賣家不能購買自己的拍賣
+	if auction.UserID == uuid.MustParse(token.Subject) {
+		return openapi.PostAuctionItemItemIDBuyNow403JSONResponse{
+			Message: lo.ToPtr("Seller cannot buy own auction"),
+		}, nil
+	}
+	//  - 只有買家可以購買
+	if !impl.hasRole(token, RoleBidder) {
+		return openapi.PostAuctionItemItemIDBuyNow403JSONResponse{
+			Message: lo.ToPtr("Bidder role required"),
+		}, nil
+	}
+	//  - 停權的使用者不能購買
+	banned, err := impl.userBanned(ctx, uuid.MustParse(token.Subject))
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PostAuctionItemItemIDBuyNow403JSONResponse{
+			Message: lo.ToPtr("User is banned"),
+		}, nil
+	}
+	// 檢查是否有直購價
+	if auction.BuyNowPrice == nil {
+		return openapi.PostAuctionItemItemIDBuyNow409JSONResponse{
+			Message: lo.ToPtr("Buy now is not available"),
+		}, nil
+	}
+
+	// 透過Lua script來處理直購
+	bidInfo := BidInfo{
+		ItemID:    request.ItemID,
+		User:      impl.bidInfoUser(ctx, token),
+		Amount:    *auction.BuyNowPrice,
+		Currency:  auction.Currency,
+		CreatedAt: time.Now(),
+	}
+	status, _, err := impl.runAuctionScript(ctx, &auction, &bidInfo, BuyNowScript)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to buy now, err=%w", op, err)
+	}
+	if status == -2 {
+		return openapi.PostAuctionItemItemIDBuyNow410Response{}, nil
+	} else if status == 0 {
+		return openapi.PostAuctionItemItemIDBuyNow409JSONResponse{
+			Message: lo.ToPtr("Buy now is not available"),
+		}, nil
+	} else if status != 1 {
+		return nil, fmt.Errorf("[%s] Invalid script return value: %d", op, status)
+	}
+	slog.Info("Auction bought", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()))
+
+	if err := impl.endAuctionEarly(ctx, auction.ID, bidInfo.CreatedAt); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to end auction, err=%w", op, err)
+	}
+	return openapi.PostAuctionItemItemIDBuyNow200Response{}, nil
+}
+
+// buyNowThreshold 返回撤下直購價的最高出價門檻，最高出價超過門檻後直購價就會被撤下
+func (impl *ServerImpl) buyNowThreshold(buyNowPrice models.Money) models.Money {
+	return models.Money(math.Floor(float64(buyNowPrice) * impl.config.BuyNow.WithdrawRatio))
+}
+
+// buyNowPrice 返回目前仍有效的直購價，已被撤下或沒有直購價時返回nil
+// NOTE: auction需要預先載入CurrentBid
+func (impl *ServerImpl) buyNowPrice(auction *models.AuctionItem) *models.Money {
+	if auction.BuyNowPrice == nil || auction.Status != models.AuctionStatusActive {
+		return nil
+	}
+	if auction.CurrentBid != nil && auction.CurrentBid.Amount > impl.buyNowThreshold(*auction.BuyNowPrice) {
+		return nil
+	}
+	return auction.BuyNowPrice
+}
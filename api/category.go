@@ -0,0 +1,253 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+const (
+	// 每個拍賣物品最多的標籤數量
+	maxTags = 20
+	// 每個標籤最多的字元數
+	maxTagLength = 32
+	// 分類名稱最多的字元數
+	maxCategoryNameLength = 255
+)
+
+// List categories
+// (GET /auction/categories)
+func (impl *ServerImpl) GetAuctionCategories(ctx context.Context, request openapi.GetAuctionCategoriesRequestObject) (openapi.GetAuctionCategoriesResponseObject, error) {
+	const op = "GetAuctionCategories"
+	var categories []models.Category
+	if result := impl.db.WithContext(ctx).Order("name").Order("id").Find(&categories); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list categories, err=%w", op, result.Error)
+	}
+	return openapi.GetAuctionCategories200JSONResponse(lo.Map(categories, func(category models.Category, _ int) openapi.Category {
+		return *categoryToAPI(&category)
+	})), nil
+}
+
+// Add a new category
+// (POST /auction/category)
+func (impl *ServerImpl) PostAuctionCategory(ctx context.Context, request openapi.PostAuctionCategoryRequestObject) (openapi.PostAuctionCategoryResponseObject, error) {
+	const op = "PostAuctionCategory"
+	// 檢查使用者是否為管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleAdmin)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostAuctionCategory401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostAuctionCategory403Response{}, nil
+	}
+	// 檢查分類設定是否合法
+	category := models.Category{
+		ParentID: request.Body.ParentId,
+		Name:     strings.TrimSpace(request.Body.Name),
+	}
+	if !validCategoryName(category.Name) {
+		return openapi.PostAuctionCategory400JSONResponse{
+			Message: lo.ToPtr("Invalid category name"),
+		}, nil
+	}
+	if category.ParentID != nil {
+		exists, err := impl.categoryExists(ctx, *category.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] Fail to find parent category, err=%w", op, err)
+		}
+		if !exists {
+			return openapi.PostAuctionCategory400JSONResponse{
+				Message: lo.ToPtr("Parent category not found"),
+			}, nil
+		}
+	}
+	if result := impl.db.WithContext(ctx).Create(&category); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to create category, err=%w", op, result.Error)
+	}
+	slog.Info("Category created", slog.String("user", token.Subject), slog.String("categoryID", category.ID.String()))
+	return openapi.PostAuctionCategory201Response{
+		Headers: openapi.PostAuctionCategory201ResponseHeaders{
+			Location: category.ID.String(),
+		},
+	}, nil
+}
+
+// Edit a category
+// (PATCH /auction/category/{categoryID})
+func (impl *ServerImpl) PatchAuctionCategoryCategoryID(ctx context.Context, request openapi.PatchAuctionCategoryCategoryIDRequestObject) (openapi.PatchAuctionCategoryCategoryIDResponseObject, error) {
+	const op = "PatchAuctionCategoryCategoryID"
+	// 檢查使用者是否為管理員
+	_, status := impl.authorize(op, request.Params.AccessToken, RoleAdmin)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PatchAuctionCategoryCategoryID401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PatchAuctionCategoryCategoryID403Response{}, nil
+	}
+	// 檢查分類是否存在
+	category := models.Category{ID: request.CategoryID}
+	if result := impl.db.WithContext(ctx).First(&category); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PatchAuctionCategoryCategoryID404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find category, err=%w", op, result.Error)
+	}
+
+	columns := []string{}
+	if request.Body.Name != nil {
+		category.Name = strings.TrimSpace(*request.Body.Name)
+		if !validCategoryName(category.Name) {
+			return openapi.PatchAuctionCategoryCategoryID400JSONResponse{
+				Message: lo.ToPtr("Invalid category name"),
+			}, nil
+		}
+		columns = append(columns, "Name")
+	}
+	//  - 設定為nil UUID時移動到最上層
+	if request.Body.ParentId != nil {
+		category.ParentID = lo.Ternary(*request.Body.ParentId == uuid.Nil, nil, request.Body.ParentId)
+		columns = append(columns, "ParentID")
+	}
+	if category.ParentID != nil && lo.Contains(columns, "ParentID") {
+		exists, err := impl.categoryExists(ctx, *category.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] Fail to find parent category, err=%w", op, err)
+		}
+		if !exists {
+			return openapi.PatchAuctionCategoryCategoryID400JSONResponse{
+				Message: lo.ToPtr("Parent category not found"),
+			}, nil
+		}
+		// 不能移動到自己或子分類底下，避免形成循環
+		var count int64
+		if result := impl.db.WithContext(ctx).Table("(?) AS subtree", categorySubtree(impl.db, category.ID)).
+			Where("id = ?", *category.ParentID).
+			Count(&count); result.Error != nil {
+			return nil, fmt.Errorf("[%s] Fail to check category subtree, err=%w", op, result.Error)
+		}
+		if count > 0 {
+			return openapi.PatchAuctionCategoryCategoryID400JSONResponse{
+				Message: lo.ToPtr("Invalid parent category"),
+			}, nil
+		}
+	}
+	if len(columns) > 0 {
+		if result := impl.db.WithContext(ctx).Select(columns).Updates(&category); result.Error != nil {
+			return nil, fmt.Errorf("[%s] Fail to update category, err=%w", op, result.Error)
+		}
+	}
+	return openapi.PatchAuctionCategoryCategoryID200Response{}, nil
+}
+
+// Delete a category
+// (DELETE /auction/category/{categoryID})
+func (impl *ServerImpl) DeleteAuctionCategoryCategoryID(ctx context.Context, request openapi.DeleteAuctionCategoryCategoryIDRequestObject) (openapi.DeleteAuctionCategoryCategoryIDResponseObject, error) {
+	const op = "DeleteAuctionCategoryCategoryID"
+	// 檢查使用者是否為管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleAdmin)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.DeleteAuctionCategoryCategoryID401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.DeleteAuctionCategoryCategoryID403Response{}, nil
+	}
+	// 檢查分類是否存在
+	exists, err := impl.categoryExists(ctx, request.CategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to find category, err=%w", op, err)
+	}
+	if !exists {
+		return openapi.DeleteAuctionCategoryCategoryID404Response{}, nil
+	}
+	// 只能刪除沒有子分類和拍賣物品的分類
+	var children int64
+	if result := impl.db.WithContext(ctx).Model(&models.Category{}).Where("parent_id = ?", request.CategoryID).Count(&children); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to count subcategories, err=%w", op, result.Error)
+	}
+	if children > 0 {
+		return openapi.DeleteAuctionCategoryCategoryID409JSONResponse{
+			Message: lo.ToPtr("Category has subcategories"),
+		}, nil
+	}
+	var items int64
+	if result := impl.db.WithContext(ctx).Model(&models.AuctionItem{}).Where("category_id = ?", request.CategoryID).Count(&items); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to count auction items, err=%w", op, result.Error)
+	}
+	if items > 0 {
+		return openapi.DeleteAuctionCategoryCategoryID409JSONResponse{
+			Message: lo.ToPtr("Category has auction items"),
+		}, nil
+	}
+	if result := impl.db.WithContext(ctx).Delete(&models.Category{ID: request.CategoryID}); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to delete category, err=%w", op, result.Error)
+	}
+	slog.Info("Category deleted", slog.String("user", token.Subject), slog.String("categoryID", request.CategoryID.String()))
+	return openapi.DeleteAuctionCategoryCategoryID204Response{}, nil
+}
+
+// categoryExists 檢查分類是否存在
+func (impl *ServerImpl) categoryExists(ctx context.Context, categoryID uuid.UUID) (bool, error) {
+	var count int64
+	if result := impl.db.WithContext(ctx).Model(&models.Category{}).Where("id = ?", categoryID).Count(&count); result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// categorySubtree 返回分類本身和所有子分類ID的子查詢
+func categorySubtree(db *gorm.DB, categoryID uuid.UUID) *gorm.DB {
+	return db.Raw(`WITH RECURSIVE subtree AS (
+		SELECT id FROM categories WHERE id = ? AND deleted_at IS NULL
+		UNION ALL
+		SELECT categories.id FROM categories JOIN subtree ON categories.parent_id = subtree.id WHERE categories.deleted_at IS NULL
+	) SELECT id FROM subtree`, categoryID)
+}
+
+// categoryToAPI 轉換分類，沒有分類時返回nil
+func categoryToAPI(category *models.Category) *openapi.Category {
+	if category == nil {
+		return nil
+	}
+	return &openapi.Category{
+		Id:       category.ID,
+		Name:     category.Name,
+		ParentId: category.ParentID,
+	}
+}
+
+// validCategoryName 檢查分類名稱是否合法
+func validCategoryName(name string) bool {
+	return name != "" && utf8.RuneCountInString(name) <= maxCategoryNameLength
+}
+
+// normalizeTags 整理使用者輸入的標籤：去除前後空白、轉為小寫，並移除空白和重複的標籤
+// 標籤數量或長度超過上限時返回false
+func normalizeTags(tags []string) ([]string, bool) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || slices.Contains(normalized, tag) {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, false
+		}
+		normalized = append(normalized, tag)
+	}
+	if len(normalized) > maxTags {
+		return nil, false
+	}
+	return normalized, true
+}
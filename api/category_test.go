@@ -0,0 +1,73 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tooMany := make([]string, maxTags+1)
+	for i := range tooMany {
+		tooMany[i] = strings.Repeat("a", i+1)
+	}
+
+	tests := []struct {
+		name   string
+		input  []string
+		want   []string
+		wantOk bool
+	}{
+		{
+			name:   "未設定時沒有標籤",
+			input:  nil,
+			want:   []string{},
+			wantOk: true,
+		},
+		{
+			name:   "去除空白並轉為小寫",
+			input:  []string{"  Vintage ", "CAMERA"},
+			want:   []string{"vintage", "camera"},
+			wantOk: true,
+		},
+		{
+			name:   "移除空白和重複的標籤",
+			input:  []string{"film", " ", "Film", "lens", ""},
+			want:   []string{"film", "lens"},
+			wantOk: true,
+		},
+		{
+			name:   "以字元計算標籤長度",
+			input:  []string{strings.Repeat("相", maxTagLength)},
+			want:   []string{strings.Repeat("相", maxTagLength)},
+			wantOk: true,
+		},
+		{
+			name:   "標籤長度不能超過上限",
+			input:  []string{strings.Repeat("a", maxTagLength+1)},
+			wantOk: false,
+		},
+		{
+			name:   "標籤數量不能超過上限",
+			input:  tooMany,
+			wantOk: false,
+		},
+		{
+			name:   "重複的標籤不計入數量",
+			input:  append(tooMany[:maxTags:maxTags], tooMany[0]),
+			want:   tooMany[:maxTags],
+			wantOk: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := normalizeTags(tt.input)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -10,10 +10,18 @@ type ServerConfig struct {
 	ID string
 
 	Auth  AuthConfig
+	RBAC  RBACConfig
 	OIDC  OIDCConfig
 	S3    S3Config
 	DB    DBConfig
 	Redis RedisConfig
+
+	Settlement     SettlementConfig
+	BuyNow         BuyNowConfig
+	ShillDetection ShillDetectionConfig
+	Idempotency    IdempotencyConfig
+	Notification   NotificationConfig
+	Webhook        WebhookConfig
 }
 
 type AuthConfig struct {
@@ -23,6 +31,13 @@ type AuthConfig struct {
 	ExpireDuration time.Duration
 }
 
+type RBACConfig struct {
+	// 每個角色對應的IdP群組，使用者屬於其中任一群組時取得該角色
+	Groups map[Role][]string
+	// 所有登入的使用者都有的角色
+	DefaultRoles []Role
+}
+
 type OIDCConfig struct {
 	IssuerURL    string
 	ClientID     string
@@ -61,5 +76,84 @@ type RedisConfig struct {
 }
 
 type RedisStreamKeys struct {
-	BidStream string
+	BidStream   string
+	EventStream string
+}
+
+type SettlementConfig struct {
+	// 檢查已到期拍賣的間隔
+	Interval time.Duration
+	// 每次檢查最多處理的拍賣數量
+	BatchSize int
+	// 單一拍賣結標流程(包含等待出價同步完成)的最長時間
+	Timeout time.Duration
+}
+
+type BuyNowConfig struct {
+	// 最高出價超過直購價的此比例後，直購價會被撤下
+	WithdrawRatio float64
+}
+
+type ShillDetectionConfig struct {
+	// 執行偵測的間隔
+	Interval time.Duration
+	// 只分析在此期間內結標的拍賣
+	Lookback time.Duration
+	// 參與的拍賣數量低於此值時不進行判定
+	MinAuctions int
+	// 分數(0到1)達到此值時標記為需要審核
+	Threshold float64
+}
+
+type IdempotencyConfig struct {
+	// 帶有Idempotency-Key的請求的回應保留的時間，超過後相同的Idempotency-Key會被視為新的請求
+	TTL time.Duration
+}
+
+type NotificationConfig struct {
+	// 讀取bid stream發送出價被超過通知的consumer group，需要與Redis.ConsumerGroup不同
+	ConsumerGroup string
+	// 相同的通知在此期間內不會重複送出
+	DedupTTL time.Duration
+	// 拍賣在此時間內結束時通知關注者和出價者
+	EndingSoonWindow time.Duration
+	// 檢查即將結束拍賣的間隔
+	EndingSoonInterval time.Duration
+	// webhook單次請求的最長時間
+	WebhookTimeout time.Duration
+
+	SMTP SMTPConfig
+}
+
+type SMTPConfig struct {
+	// SMTP伺服器的位址(host:port)，空字串代表不送出電子郵件
+	Addr string
+	// 空字串代表不進行驗證
+	Username string
+	Password string
+	// 寄件者的電子郵件地址
+	From string
+}
+
+type WebhookConfig struct {
+	// 讀取bid stream建立webhook事件的consumer group，需要與其他consumer group不同
+	ConsumerGroup string
+	// 檢查待送出webhook的間隔
+	Interval time.Duration
+	// 每次檢查最多送出的數量
+	BatchSize int
+	// 同時送出webhook的worker數量
+	Workers int
+	// 每次檢查時每個webhook最多同時送出的數量
+	MaxInFlightPerEndpoint int
+	// 單次請求的最長時間
+	Timeout time.Duration
+	// 最多嘗試送出的次數，超過後標記為失敗
+	MaxAttempts uint32
+	// 第一次重試前的等待時間，之後每次加倍
+	InitialBackoff time.Duration
+	// 重試前最長的等待時間
+	MaxBackoff time.Duration
+	// 是否允許webhook和通知的webhook連線到loopback、私有網路等位址，只應該在本機開發時開啟
+	AllowPrivateAddress bool
 }
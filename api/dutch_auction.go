@@ -0,0 +1,178 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// Accept the current price of a dutch auction
+// (POST /auction/item/{itemID}/accept)
+func (impl *ServerImpl) PostAuctionItemItemIDAccept(ctx context.Context, request openapi.PostAuctionItemItemIDAcceptRequestObject) (openapi.PostAuctionItemItemIDAcceptResponseObject, error) {
+	const op = "PostAuctionItemItemIDAccept"
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.Preload("CurrentBid.User").First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDAccept404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 檢查是否為荷式拍賣
+	if auction.Type != models.AuctionTypeDutch {
+		return openapi.PostAuctionItemItemIDAccept409JSONResponse{
+			Message: lo.ToPtr("Auction is not a dutch auction"),
+		}, nil
+	}
+	// 檢查拍賣物品是否已經開始
+	if time.Now().Before(auction.StartTime) {
+		return openapi.PostAuctionItemItemIDAccept403JSONResponse{
+			Message: lo.ToPtr("Auction has not started"),
+		}, nil
+	}
+	// 檢查拍賣物品是否已經結標
+	// NOTE: 結束時間的檢查交由DutchAcceptScript處理，確保所有實例都以Redis中的狀態為準
+	if auction.Status != models.AuctionStatusActive {
+		return openapi.PostAuctionItemItemIDAccept410Response{}, nil
+	}
+	// 檢查使用者是否可以購買
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDAccept401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDAccept401Response{}, nil
+	}
+	//  - 賣家不能購買自己的拍賣
+	if auction.UserID == uuid.MustParse(token.Subject) {
+		return openapi.PostAuctionItemItemIDAccept403JSONResponse{
+			Message: lo.ToPtr("Seller cannot buy own auction"),
+		}, nil
+	}
+	//  - 只有買家可以購買
+	if !impl.hasRole(token, RoleBidder) {
+		return openapi.PostAuctionItemItemIDAccept403JSONResponse{
+			Message: lo.ToPtr("Bidder role required"),
+		}, nil
+	}
+	//  - 停權的使用者不能購買
+	banned, err := impl.userBanned(ctx, uuid.MustParse(token.Subject))
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PostAuctionItemItemIDAccept403JSONResponse{
+			Message: lo.ToPtr("User is banned"),
+		}, nil
+	}
+
+	// 透過Lua script以目前價格成交，成交價格由Redis依接受時間計算，確保所有實例的結果一致
+	bidInfo := BidInfo{
+		ItemID:    request.ItemID,
+		User:      impl.bidInfoUser(ctx, token),
+		CreatedAt: time.Now(),
+		Currency:  auction.Currency,
+	}
+	bidInfo.Amount = dutchPrice(&auction, bidInfo.CreatedAt)
+	status, price, err := impl.runAuctionScript(ctx, &auction, &bidInfo, DutchAcceptScript)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to accept dutch auction price, err=%w", op, err)
+	}
+	if status == -2 {
+		return openapi.PostAuctionItemItemIDAccept410Response{}, nil
+	} else if status == 0 {
+		return openapi.PostAuctionItemItemIDAccept409JSONResponse{
+			Message: lo.ToPtr("Auction is not a dutch auction"),
+		}, nil
+	} else if status != 1 {
+		return nil, fmt.Errorf("[%s] Invalid script return value: %d", op, status)
+	}
+	slog.Info("Dutch auction price accepted", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()), slog.Uint64("price", uint64(price)))
+
+	if err := impl.endAuctionEarly(ctx, auction.ID, bidInfo.CreatedAt); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to end auction, err=%w", op, err)
+	}
+	return openapi.PostAuctionItemItemIDAccept200JSONResponse{Price: price}, nil
+}
+
+// dutchPrice 返回荷式拍賣在指定時間的價格，計算方式和DutchAcceptScript相同
+//   - 開始前為起標價
+//   - 每經過DutchInterval秒降價DutchDecrement，最低降到DutchFloorPrice
+func dutchPrice(auction *models.AuctionItem, t time.Time) models.Money {
+	if auction.DutchInterval == 0 {
+		return auction.StartingPrice
+	}
+	elapsed := t.UnixMilli() - auction.StartTime.UnixMilli()
+	if elapsed < 0 {
+		return auction.StartingPrice
+	}
+	steps := uint64(elapsed) / (uint64(auction.DutchInterval) * 1000)
+	drop := steps * uint64(auction.DutchDecrement)
+	if auction.StartingPrice <= auction.DutchFloorPrice || drop >= uint64(auction.StartingPrice-auction.DutchFloorPrice) {
+		return auction.DutchFloorPrice
+	}
+	return auction.StartingPrice - models.Money(drop)
+}
+
+// nextDutchPriceDrop 返回荷式拍賣在指定時間之後下一次降價的時間，已降到最低價時返回false
+func nextDutchPriceDrop(auction *models.AuctionItem, t time.Time) (time.Time, bool) {
+	if auction.DutchInterval == 0 || auction.DutchDecrement == 0 || dutchPrice(auction, t) <= auction.DutchFloorPrice {
+		return time.Time{}, false
+	}
+	interval := time.Duration(auction.DutchInterval) * time.Second
+	elapsed := max(t.Sub(auction.StartTime), 0)
+	return auction.StartTime.Add((elapsed/interval + 1) * interval), true
+}
+
+// dutchCurrentPrice 返回荷式拍賣目前的價格，成交後為成交價格，英式拍賣返回nil
+// NOTE: auction需要預先載入CurrentBid
+func dutchCurrentPrice(auction *models.AuctionItem, now time.Time) *models.Money {
+	if auction.Type != models.AuctionTypeDutch {
+		return nil
+	}
+	if auction.CurrentBid != nil {
+		return lo.ToPtr(auction.CurrentBid.Amount)
+	}
+	if now.After(auction.EndTime) {
+		now = auction.EndTime
+	}
+	return lo.ToPtr(dutchPrice(auction, now))
+}
+
+// dutchScheduleToAPI 轉換荷式拍賣的降價設定，英式拍賣返回nil
+func dutchScheduleToAPI(auction *models.AuctionItem) *openapi.DutchSchedule {
+	if auction.Type != models.AuctionTypeDutch {
+		return nil
+	}
+	return &openapi.DutchSchedule{
+		Decrement:  auction.DutchDecrement,
+		Interval:   auction.DutchInterval,
+		FloorPrice: auction.DutchFloorPrice,
+	}
+}
+
+// dutchState 將荷式拍賣的降價設定轉換為DutchAcceptScript使用的狀態欄位，英式拍賣返回nil
+func dutchState(auction *models.AuctionItem) []any {
+	if auction.Type != models.AuctionTypeDutch {
+		return nil
+	}
+	return []any{
+		"dutch_start_price", int64(auction.StartingPrice),
+		"dutch_start_time", auction.StartTime.UnixMilli(),
+		"dutch_decrement", int64(auction.DutchDecrement),
+		"dutch_interval", int64(auction.DutchInterval) * 1000,
+		"dutch_floor", int64(auction.DutchFloorPrice),
+	}
+}
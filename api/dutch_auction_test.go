@@ -0,0 +1,82 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+)
+
+func TestDutchPrice(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	auction := &models.AuctionItem{
+		StartingPrice:   1000,
+		StartTime:       start,
+		Type:            models.AuctionTypeDutch,
+		DutchDecrement:  150,
+		DutchInterval:   60,
+		DutchFloorPrice: 400,
+	}
+
+	tests := []struct {
+		name     string
+		t        time.Time
+		want     models.Money
+		wantNext time.Time
+		wantOK   bool
+	}{
+		{
+			name:     "開始前為起標價",
+			t:        start.Add(-time.Minute),
+			want:     1000,
+			wantNext: start.Add(time.Minute),
+			wantOK:   true,
+		},
+		{
+			name:     "第一個間隔內為起標價",
+			t:        start.Add(59 * time.Second),
+			want:     1000,
+			wantNext: start.Add(time.Minute),
+			wantOK:   true,
+		},
+		{
+			name:     "剛好經過一個間隔",
+			t:        start.Add(time.Minute),
+			want:     850,
+			wantNext: start.Add(2 * time.Minute),
+			wantOK:   true,
+		},
+		{
+			name:     "經過多個間隔",
+			t:        start.Add(3*time.Minute + 30*time.Second),
+			want:     550,
+			wantNext: start.Add(4 * time.Minute),
+			wantOK:   true,
+		},
+		{
+			name:   "降到最低價後不再降價",
+			t:      start.Add(4 * time.Minute),
+			want:   400,
+			wantOK: false,
+		},
+		{
+			name:   "很久之後仍為最低價",
+			t:      start.Add(24 * time.Hour),
+			want:   400,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, dutchPrice(auction, tt.t))
+			next, ok := nextDutchPriceDrop(auction, tt.t)
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantNext, next)
+			}
+		})
+	}
+}
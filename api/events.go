@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/adapters/sse"
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// SSE事件名稱
+const (
+	// AuctionEventBid 有新的最高出價，內容為openapi.BidEvent
+	AuctionEventBid = "bid"
+	// AuctionEventEnded 拍賣已結標，內容為openapi.AuctionEndedEvent
+	AuctionEventEnded = "ended"
+	// AuctionEventExtended 軟結標延後了結束時間，內容為openapi.AuctionExtendedEvent
+	AuctionEventExtended = "extended"
+	// AuctionEventReserve 最高出價達到底價，內容為openapi.AuctionReserveEvent
+	AuctionEventReserve = "reserve"
+	// AuctionEventBuyNow 直購價被撤下，內容為openapi.AuctionBuyNowEvent
+	AuctionEventBuyNow = "buynow"
+	// AuctionEventPrice 荷式拍賣降價，內容為openapi.AuctionPriceEvent
+	// NOTE: 價格事件由各實例依降價設定自行推送，不會經過SSE manager
+	AuctionEventPrice = "price"
+	// AuctionEventClearing 多數量拍賣的統一成交價或被覆蓋的數量改變，內容為openapi.AuctionClearingEvent
+	AuctionEventClearing = "clearing"
+	// AuctionEventAnswer 賣家回答了問題，內容為openapi.Question
+	AuctionEventAnswer = "answer"
+)
+
+// AuctionEvent 代表推送給SSE訂閱者的拍賣事件
+// Data為事件內容序列化後的JSON，跨實例傳遞時不需要知道內容的實際型別
+type AuctionEvent struct {
+	Event string
+	Data  json.RawMessage
+}
+
+// NewAuctionEvent 建立拍賣事件，data會被序列化為JSON
+func NewAuctionEvent(event string, data any) (AuctionEvent, error) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		return AuctionEvent{}, fmt.Errorf("fail to marshal %s event, err=%w", event, err)
+	}
+	return AuctionEvent{Event: event, Data: bytes}, nil
+}
+
+// parseBidInfo 解析bid stream中的出價資訊，包含BidScript額外寫入的欄位(代理出價覆寫的金額和時間、延後的結束時間)
+func parseBidInfo(m map[string]any) (BidInfo, error) {
+	bidInfo, err := redisAdapter.DefaultParseFromMessage[BidInfo](m)
+	if err != nil {
+		return BidInfo{}, err
+	}
+	if raw, ok := m["amount"].(string); ok {
+		amount, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return BidInfo{}, fmt.Errorf("invalid amount field, err=%w", err)
+		}
+		bidInfo.Amount = models.Money(amount)
+	}
+	if raw, ok := m["created_at"].(string); ok {
+		createdAt, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return BidInfo{}, fmt.Errorf("invalid created_at field, err=%w", err)
+		}
+		bidInfo.CreatedAt = time.UnixMilli(createdAt)
+	}
+	if raw, ok := m["end_time"].(string); ok {
+		endTime, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return BidInfo{}, fmt.Errorf("invalid end_time field, err=%w", err)
+		}
+		bidInfo.ExtendedEndTime = lo.ToPtr(time.UnixMilli(endTime))
+	}
+	if raw, ok := m["sealed"].(string); ok {
+		bidInfo.Sealed = raw == "1"
+	}
+	if raw, ok := m["outbid"].(string); ok {
+		userID, err := uuid.Parse(raw)
+		if err != nil {
+			return BidInfo{}, fmt.Errorf("invalid outbid field, err=%w", err)
+		}
+		bidInfo.OutbidUserID = &userID
+	}
+	// 加入多貨幣前的出價金額以元為單位，轉換為預設貨幣的最小單位
+	if bidInfo.Currency == "" {
+		bidInfo.Currency = models.DefaultCurrency
+		bidInfo.Amount = models.DefaultCurrency.FromMajor(int64(bidInfo.Amount))
+	}
+	return bidInfo, nil
+}
+
+// parseBidEvent 將bid stream中的出價資訊轉換為SSE的出價事件，密封出價不會被推送
+func parseBidEvent(m map[string]any) (sse.PublishRequest[AuctionEvent], error) {
+	bidInfo, err := parseBidInfo(m)
+	if err != nil {
+		return sse.PublishRequest[AuctionEvent]{}, fmt.Errorf("fail to parse message to sse.PublishRequest[AuctionEvent], err=%w", err)
+	}
+	if bidInfo.Sealed {
+		return sse.PublishRequest[AuctionEvent]{}, redisAdapter.ErrSkipMessage
+	}
+	bidEvent := openapi.BidEvent{
+		Bid:            bidInfo.Amount,
+		User:           bidInfo.User.Name,
+		UserReputation: lo.ToPtr(reputationToAPI(bidInfo.User.Reputation)),
+		Time:           bidInfo.CreatedAt,
+	}
+	if bidInfo.Quantity > 0 {
+		bidEvent.Quantity = lo.ToPtr(bidInfo.Quantity)
+	}
+	event, err := NewAuctionEvent(AuctionEventBid, bidEvent)
+	if err != nil {
+		return sse.PublishRequest[AuctionEvent]{}, err
+	}
+	return sse.PublishRequest[AuctionEvent]{
+		Channel: bidInfo.ItemID.String(),
+		Message: event,
+	}, nil
+}
+
+// createConsumerGroup 在bid stream建立consumer group，group已存在時不做任何事
+// 新建立的group只會讀取建立之後寫入的訊息
+func createConsumerGroup(ctx context.Context, client *redis.Client, stream, group string) error {
+	if err := client.XGroupCreateMkStream(ctx, stream, group, "$").Err(); err != nil && !strings.Contains(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// publishAuctionEvent 將事件發送給所有實例上訂閱該拍賣商品的SSE連線
+func (impl *ServerImpl) publishAuctionEvent(itemID uuid.UUID, event string, data any) error {
+	auctionEvent, err := NewAuctionEvent(event, data)
+	if err != nil {
+		return err
+	}
+	if err := impl.sseManager.Publish(itemID.String(), auctionEvent); err != nil {
+		return fmt.Errorf("fail to publish %s event, err=%w", event, err)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"q4/models"
+)
+
+func TestParseBidInfo(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	encode := func(bidInfo BidInfo) string {
+		bytes, err := msgpack.Marshal(bidInfo)
+		assert.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(bytes)
+	}
+
+	tests := []struct {
+		name         string
+		bidInfo      BidInfo
+		fields       map[string]any
+		wantAmount   models.Money
+		wantCurrency models.Currency
+	}{
+		{
+			name:         "帶有貨幣的出價不轉換金額",
+			bidInfo:      BidInfo{Amount: 12345, Currency: models.CurrencyUSD, CreatedAt: now},
+			wantAmount:   12345,
+			wantCurrency: models.CurrencyUSD,
+		},
+		{
+			name:         "加入多貨幣前的出價轉換為預設貨幣的最小單位",
+			bidInfo:      BidInfo{Amount: 150, CreatedAt: now},
+			wantAmount:   15000,
+			wantCurrency: models.DefaultCurrency,
+		},
+		{
+			name:         "加入多貨幣前的出價覆寫金額也需要轉換",
+			bidInfo:      BidInfo{Amount: 150, CreatedAt: now},
+			fields:       map[string]any{"amount": "160"},
+			wantAmount:   16000,
+			wantCurrency: models.DefaultCurrency,
+		},
+		{
+			name:         "BidScript覆寫的金額",
+			bidInfo:      BidInfo{Amount: 100, Currency: models.CurrencyJPY, CreatedAt: now},
+			fields:       map[string]any{"amount": "120"},
+			wantAmount:   120,
+			wantCurrency: models.CurrencyJPY,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.bidInfo.ItemID = uuid.New()
+			m := map[string]any{"data": encode(tt.bidInfo)}
+			for key, value := range tt.fields {
+				m[key] = value
+			}
+			parsed, err := parseBidInfo(m)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.bidInfo.ItemID, parsed.ItemID)
+			assert.Equal(t, tt.wantAmount, parsed.Amount)
+			assert.Equal(t, tt.wantCurrency, parsed.Currency)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+const (
+	// 評價留言的最大長度(字元數)
+	maxFeedbackCommentLength = 1000
+	// 使用者收到的評價每頁預設返回的數量
+	defaultFeedbackPageSize = 20
+	// 使用者收到的評價每頁最多返回的數量
+	maxFeedbackPageSize = 100
+)
+
+// List feedback of an auction item
+// (GET /auction/item/{itemID}/feedback)
+func (impl *ServerImpl) GetAuctionItemItemIDFeedback(ctx context.Context, request openapi.GetAuctionItemItemIDFeedbackRequestObject) (openapi.GetAuctionItemItemIDFeedbackResponseObject, error) {
+	const op = "GetAuctionItemItemIDFeedback"
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.GetAuctionItemItemIDFeedback404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	var feedbacks []models.Feedback
+	if result := impl.db.WithContext(ctx).
+		Preload("FromUser").
+		Preload("ToUser").
+		Where("auction_item_id = ?", auction.ID).
+		Order("created_at").
+		Order("id").
+		Find(&feedbacks); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list feedback, err=%w", op, result.Error)
+	}
+	return openapi.GetAuctionItemItemIDFeedback200JSONResponse(lo.Map(feedbacks, feedbackToAPI)), nil
+}
+
+// Leave feedback on an auction item
+// (POST /auction/item/{itemID}/feedback)
+func (impl *ServerImpl) PostAuctionItemItemIDFeedback(ctx context.Context, request openapi.PostAuctionItemItemIDFeedbackRequestObject) (openapi.PostAuctionItemItemIDFeedbackResponseObject, error) {
+	const op = "PostAuctionItemItemIDFeedback"
+	// 檢查評價內容是否合法
+	if request.Body.Rating < models.MinFeedbackRating || request.Body.Rating > models.MaxFeedbackRating {
+		return openapi.PostAuctionItemItemIDFeedback400JSONResponse{
+			Message: lo.ToPtr("Invalid rating"),
+		}, nil
+	}
+	if utf8.RuneCountInString(lo.FromPtr(request.Body.Comment)) > maxFeedbackCommentLength {
+		return openapi.PostAuctionItemItemIDFeedback400JSONResponse{
+			Message: lo.ToPtr("Comment too long"),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDFeedback401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDFeedback401Response{}, nil
+	}
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).Preload("User").Preload("WinningBid.User").First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDFeedback404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 只有成交的拍賣可以評價
+	if auction.Status != models.AuctionStatusSold {
+		return openapi.PostAuctionItemItemIDFeedback409JSONResponse{
+			Message: lo.ToPtr("Auction is not sold"),
+		}, nil
+	}
+	// 決定評價的對象
+	//  - 賣家評價得標者，多數量拍賣有多位得標者時需要指定得標者
+	//  - 得標者評價賣家
+	userID := uuid.MustParse(token.Subject)
+	buyers, err := impl.auctionBuyers(ctx, &auction)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to find buyers, err=%w", op, err)
+	}
+	var target models.User
+	var role models.FeedbackRole
+	if auction.UserID == userID {
+		role = models.FeedbackRoleBuyer
+		switch {
+		case request.Body.Buyer != nil:
+			buyer, ok := lo.Find(buyers, func(user models.User) bool {
+				return user.Username == *request.Body.Buyer
+			})
+			if !ok {
+				return openapi.PostAuctionItemItemIDFeedback400JSONResponse{
+					Message: lo.ToPtr("Buyer is not a winner of the auction"),
+				}, nil
+			}
+			target = buyer
+		case len(buyers) == 1:
+			target = buyers[0]
+		default:
+			return openapi.PostAuctionItemItemIDFeedback400JSONResponse{
+				Message: lo.ToPtr("Buyer is required"),
+			}, nil
+		}
+	} else if lo.ContainsBy(buyers, func(user models.User) bool { return user.ID == userID }) {
+		role = models.FeedbackRoleSeller
+		target = auction.User
+	} else {
+		return openapi.PostAuctionItemItemIDFeedback403Response{}, nil
+	}
+	// 儲存評價並累加對象的評價統計，已經評價過時不做任何事
+	feedback := models.Feedback{
+		AuctionItemID: auction.ID,
+		FromUserID:    userID,
+		ToUserID:      target.ID,
+		Role:          role,
+		Rating:        request.Body.Rating,
+		Comment:       impl.htmlChecker.Sanitize(lo.FromPtr(request.Body.Comment)),
+	}
+	created := false
+	if err := impl.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&feedback)
+		if result.Error != nil {
+			return fmt.Errorf("fail to save feedback, err=%w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		created = true
+		if result := tx.Model(&models.User{}).Where("id = ?", target.ID).Updates(map[string]any{
+			"rating_count": gorm.Expr("rating_count + 1"),
+			"rating_sum":   gorm.Expr("rating_sum + ?", feedback.Rating),
+		}); result.Error != nil {
+			return fmt.Errorf("fail to update reputation, err=%w", result.Error)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("[%s] %w", op, err)
+	}
+	if !created {
+		return openapi.PostAuctionItemItemIDFeedback409JSONResponse{
+			Message: lo.ToPtr("Feedback already left"),
+		}, nil
+	}
+	slog.Info("Feedback left", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()), slog.String("role", string(role)))
+	feedback.FromUser = models.User{ID: userID, Username: token.Username}
+	feedback.ToUser = target
+	return openapi.PostAuctionItemItemIDFeedback201JSONResponse(feedbackToAPI(feedback, 0)), nil
+}
+
+// List feedback received by a user
+// (GET /users/{username}/feedback)
+func (impl *ServerImpl) GetUsersUsernameFeedback(ctx context.Context, request openapi.GetUsersUsernameFeedbackRequestObject) (openapi.GetUsersUsernameFeedbackResponseObject, error) {
+	const op = "GetUsersUsernameFeedback"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultFeedbackPageSize))
+	if size == 0 || size > maxFeedbackPageSize {
+		return openapi.GetUsersUsernameFeedback400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	if role := request.Params.Role; role != nil && *role != openapi.Seller && *role != openapi.Buyer {
+		return openapi.GetUsersUsernameFeedback400JSONResponse{
+			Message: lo.ToPtr("Invalid role"),
+		}, nil
+	}
+	// 檢查使用者是否存在
+	var user models.User
+	if result := impl.db.WithContext(ctx).Where("username = ?", request.Username).First(&user); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.GetUsersUsernameFeedback404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find user, err=%w", op, result.Error)
+	}
+	// 由新到舊查詢收到的評價，時間相同時依ID排序
+	query := impl.db.WithContext(ctx).
+		Preload("FromUser").
+		Where("to_user_id = ?", user.ID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(size)
+	if request.Params.Role != nil {
+		query = query.Where("role = ?", string(*request.Params.Role))
+	}
+	//  - cursor
+	if request.Params.LastFeedbackID != nil {
+		last := models.Feedback{ID: *request.Params.LastFeedbackID}
+		if result := impl.db.WithContext(ctx).Where("to_user_id = ?", user.ID).First(&last); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return openapi.GetUsersUsernameFeedback400JSONResponse{
+					Message: lo.ToPtr("Last feedback not found"),
+				}, nil
+			}
+			return nil, fmt.Errorf("[%s] Fail to find last feedback, err=%w", op, result.Error)
+		}
+		query = query.Where("(created_at < ? OR created_at = ? AND id < ?)", last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	var feedbacks []models.Feedback
+	if result := query.Find(&feedbacks); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list feedback, err=%w", op, result.Error)
+	}
+	for i := range feedbacks {
+		feedbacks[i].ToUser = user
+	}
+	return openapi.GetUsersUsernameFeedback200JSONResponse{
+		Reputation: reputationToAPI(user.Reputation),
+		Count:      len(feedbacks),
+		Items:      lo.Map(feedbacks, feedbackToAPI),
+	}, nil
+}
+
+// auctionBuyers 返回成交拍賣的得標者，多數量拍賣為分配到數量的出價者
+// NOTE: auction需要預先載入WinningBid.User
+func (impl *ServerImpl) auctionBuyers(ctx context.Context, auction *models.AuctionItem) ([]models.User, error) {
+	if !auction.IsMultiUnit() {
+		if auction.WinningBid == nil {
+			return nil, nil
+		}
+		return []models.User{auction.WinningBid.User}, nil
+	}
+	bids, err := impl.latestBidPerBidder(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(allocateUnits(auction.Quantity, bids), func(allocation UnitAllocation, _ int) models.User {
+		return allocation.Bid.User
+	}), nil
+}
+
+// feedbackToAPI 轉換評價
+// NOTE: feedback需要預先載入FromUser和ToUser
+func feedbackToAPI(feedback models.Feedback, _ int) openapi.Feedback {
+	return openapi.Feedback{
+		Id:      feedback.ID,
+		ItemId:  feedback.AuctionItemID,
+		From:    feedback.FromUser.Username,
+		To:      feedback.ToUser.Username,
+		Role:    openapi.FeedbackRole(feedback.Role),
+		Rating:  feedback.Rating,
+		Comment: feedback.Comment,
+		Time:    feedback.CreatedAt,
+	}
+}
+
+// reputationToAPI 轉換使用者的評價統計
+func reputationToAPI(reputation models.Reputation) openapi.Reputation {
+	return openapi.Reputation{
+		Score: reputation.Score(),
+		Count: reputation.RatingCount,
+	}
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+func TestReputationToAPI(t *testing.T) {
+	tests := []struct {
+		name       string
+		reputation models.Reputation
+		want       openapi.Reputation
+	}{
+		{
+			name:       "沒有評價時分數為0",
+			reputation: models.Reputation{},
+			want:       openapi.Reputation{Score: 0, Count: 0},
+		},
+		{
+			name:       "分數為評價的平均",
+			reputation: models.Reputation{RatingCount: 4, RatingSum: 18},
+			want:       openapi.Reputation{Score: 4.5, Count: 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reputationToAPI(tt.reputation))
+		})
+	}
+}
+
+func TestAuctionBuyers(t *testing.T) {
+	impl := &ServerImpl{}
+	winner := models.User{ID: uuid.New(), Username: "winner"}
+
+	t.Run("單一數量的拍賣為得標出價的出價者", func(t *testing.T) {
+		auction := &models.AuctionItem{Quantity: 1, WinningBid: &models.Bid{UserID: winner.ID, User: winner}}
+		buyers, err := impl.auctionBuyers(context.Background(), auction)
+		assert.NoError(t, err)
+		assert.Equal(t, []models.User{winner}, buyers)
+	})
+	t.Run("沒有得標出價時沒有得標者", func(t *testing.T) {
+		buyers, err := impl.auctionBuyers(context.Background(), &models.AuctionItem{Quantity: 1})
+		assert.NoError(t, err)
+		assert.Empty(t, buyers)
+	})
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/vmihailenco/msgpack/v5"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+)
+
+// idempotencyRequest 描述一個帶有Idempotency-Key的請求
+type idempotencyRequest struct {
+	// 操作名稱，不同操作的Idempotency-Key互不影響
+	Op string
+	// 使用者提供的Idempotency-Key，沒有提供時不做任何處理
+	Key *string
+	// 使用者的access token，Idempotency-Key只在同一個使用者的請求之間有效
+	AccessToken *string
+	// 請求的內容，相同Idempotency-Key的請求內容必須相同
+	Payload any
+}
+
+// recordedResponse 記錄第一次執行請求時寫出的回應，重送請求時原樣寫出
+type recordedResponse struct {
+	Fingerprint string      `msgpack:"fingerprint"`
+	StatusCode  int         `msgpack:"status_code"`
+	Header      http.Header `msgpack:"header"`
+	Body        []byte      `msgpack:"body"`
+}
+
+func (r *recordedResponse) visit(w http.ResponseWriter) error {
+	for key, values := range r.Header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(r.StatusCode)
+	_, err := w.Write(r.Body)
+	return err
+}
+
+func (r *recordedResponse) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
+	return r.visit(w)
+}
+
+func (r *recordedResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	return r.visit(w)
+}
+
+// responseRecorder 實作http.ResponseWriter，用於記錄回應的內容
+type responseRecorder struct {
+	statusCode int
+	header     http.Header
+	body       bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.statusCode == 0 {
+		r.statusCode = http.StatusOK
+	}
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	if r.statusCode == 0 {
+		r.statusCode = statusCode
+	}
+}
+
+// withIdempotency 確保帶有相同Idempotency-Key的請求只會執行一次
+//   - 第一次執行的回應會記錄在Redis中，重送時直接返回記錄的回應
+//   - 同時送達的重複請求會等待第一個請求執行完成後返回相同的回應
+//   - 相同的Idempotency-Key搭配不同的請求內容時返回conflict
+//   - 沒有提供Idempotency-Key或access token不合法時直接執行，由handle處理驗證失敗的情況
+//   - handle返回錯誤或5xx的回應不會被記錄，讓使用者可以重試
+func withIdempotency[R any](ctx context.Context, impl *ServerImpl, request idempotencyRequest, conflict R, handle func(ctx context.Context) (R, error), visit func(R, http.ResponseWriter) error) (R, error) {
+	var zero R
+	if request.Key == nil || request.AccessToken == nil {
+		return handle(ctx)
+	}
+	token, err := openapi.ParseAndValidateJWT(*request.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		return handle(ctx)
+	}
+	fingerprint, err := requestFingerprint(request.Payload)
+	if err != nil {
+		return zero, fmt.Errorf("fail to fingerprint request, err=%w", err)
+	}
+	key := fmt.Sprintf("%sidempotency:%s:%s:%s", impl.config.Redis.KeyPrefix, token.Subject, request.Op, *request.Key)
+	logger := slog.Default().With(slog.String("op", request.Op), slog.String("idempotencyKey", *request.Key))
+
+	// replay 返回已記錄的回應，沒有記錄時返回false
+	replay := func(ctx context.Context) (R, bool, error) {
+		raw, err := impl.redisClient.Get(ctx, key).Bytes()
+		if errors.Is(err, redis.Nil) {
+			return zero, false, nil
+		} else if err != nil {
+			return zero, false, fmt.Errorf("fail to get recorded response, err=%w", err)
+		}
+		var recorded recordedResponse
+		if err := msgpack.Unmarshal(raw, &recorded); err != nil {
+			return zero, false, fmt.Errorf("fail to decode recorded response, err=%w", err)
+		}
+		if recorded.Fingerprint != fingerprint {
+			logger.Info("Idempotency key reused with a different request")
+			return conflict, true, nil
+		}
+		response, ok := any(&recorded).(R)
+		if !ok {
+			return zero, false, fmt.Errorf("recorded response can not be replayed as %T", zero)
+		}
+		logger.Info("Replay recorded response")
+		return response, true, nil
+	}
+	if response, ok, err := replay(ctx); err != nil || ok {
+		return response, err
+	}
+
+	// 取得鎖，讓同時送達的重複請求依序處理
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, key+":lock")
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return zero, fmt.Errorf("fail to acquire idempotency lock, err=%w", err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			logger.Warn("Fail to release idempotency lock", slog.Any("error", err))
+		}
+	}()
+	// 取得鎖之後再確認一次，前一個請求可能已經執行完成
+	if response, ok, err := replay(lockCtx); err != nil || ok {
+		return response, err
+	}
+
+	response, err := handle(lockCtx)
+	if err != nil {
+		return response, err
+	}
+	// 記錄回應，記錄失敗時仍然返回這次的回應
+	recorder := responseRecorder{header: http.Header{}}
+	if err := visit(response, &recorder); err != nil {
+		logger.Error("Fail to record response", slog.Any("error", err))
+		return response, nil
+	}
+	if recorder.statusCode >= http.StatusInternalServerError {
+		return response, nil
+	}
+	raw, err := msgpack.Marshal(recordedResponse{
+		Fingerprint: fingerprint,
+		StatusCode:  recorder.statusCode,
+		Header:      recorder.header,
+		Body:        recorder.body.Bytes(),
+	})
+	if err != nil {
+		logger.Error("Fail to encode response", slog.Any("error", err))
+		return response, nil
+	}
+	if err := impl.redisClient.Set(lockCtx, key, raw, impl.config.Idempotency.TTL).Err(); err != nil {
+		logger.Error("Fail to save response", slog.Any("error", err))
+	}
+	return response, nil
+}
+
+// requestFingerprint 返回請求內容的雜湊值，用於檢查重送的請求內容是否相同
+func requestFingerprint(payload any) (string, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
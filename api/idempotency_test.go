@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+)
+
+func TestWithIdempotency(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	impl := &ServerImpl{redisClient: client, config: ServerConfig{
+		Auth:        AuthConfig{PrivateKey: privateKey},
+		Redis:       RedisConfig{KeyPrefix: "q4:"},
+		Idempotency: IdempotencyConfig{TTL: time.Hour},
+	}}
+	accessToken, err := jwt.NewWithClaims(&jwt.SigningMethodEd25519{}, openapi.JWT{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: uuid.NewString()},
+	}).SignedString(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	var calls atomic.Int32
+	conflict := openapi.PostAuctionItemResponseObject(openapi.PostAuctionItem422JSONResponse{})
+	place := func(key *string, payload any, fail bool) (openapi.PostAuctionItemResponseObject, error) {
+		return withIdempotency(ctx, impl, idempotencyRequest{
+			Op:          "PostAuctionItem",
+			Key:         key,
+			AccessToken: &accessToken,
+			Payload:     payload,
+		}, conflict, func(ctx context.Context) (openapi.PostAuctionItemResponseObject, error) {
+			calls.Add(1)
+			if fail {
+				return nil, errors.New("boom")
+			}
+			return openapi.PostAuctionItem201Response{
+				Headers: openapi.PostAuctionItem201ResponseHeaders{Location: uuid.NewString()},
+			}, nil
+		}, openapi.PostAuctionItemResponseObject.VisitPostAuctionItemResponse)
+	}
+	location := func(response openapi.PostAuctionItemResponseObject) string {
+		w := httptest.NewRecorder()
+		assert.NoError(t, response.VisitPostAuctionItemResponse(w))
+		assert.Equal(t, 201, w.Code)
+		return w.Header().Get("Location")
+	}
+
+	t.Run("重送時返回第一次的回應", func(t *testing.T) {
+		calls.Store(0)
+		key := lo.ToPtr("retry")
+		first, err := place(key, map[string]any{"title": "camera"}, false)
+		assert.NoError(t, err)
+		second, err := place(key, map[string]any{"title": "camera"}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, location(first), location(second))
+	})
+
+	t.Run("相同的key搭配不同的請求內容", func(t *testing.T) {
+		key := lo.ToPtr("reused")
+		_, err := place(key, map[string]any{"title": "camera"}, false)
+		assert.NoError(t, err)
+		response, err := place(key, map[string]any{"title": "lens"}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, conflict, response)
+	})
+
+	t.Run("沒有提供key時每次都執行", func(t *testing.T) {
+		calls.Store(0)
+		_, err := place(nil, nil, false)
+		assert.NoError(t, err)
+		_, err = place(nil, nil, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("執行失敗時不記錄回應", func(t *testing.T) {
+		calls.Store(0)
+		key := lo.ToPtr("failed")
+		_, err := place(key, nil, true)
+		assert.Error(t, err)
+		_, err = place(key, nil, false)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
@@ -1,69 +1,675 @@
 package api
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+
+	"q4/models"
 )
 
 // BidInfoUser represents a user
 type BidInfoUser struct {
 	ID   uuid.UUID
 	Name string
+	// Reputation 出價時使用者的評價統計，隨出價事件推送
+	Reputation models.Reputation `msgpack:",omitempty"`
 }
 
 // BidInfo represents the bid information
 type BidInfo struct {
 	ItemID    uuid.UUID
 	User      BidInfoUser
-	Amount    uint32
+	Amount    models.Money
 	CreatedAt time.Time
+	// Currency 出價金額的貨幣，加入多貨幣前寫入stream的出價沒有此欄位，金額以元為單位
+	Currency models.Currency `msgpack:",omitempty"`
+	// Quantity 多數量拍賣中想購買的數量，單一數量的拍賣為0
+	Quantity uint32 `msgpack:",omitempty"`
+
+	// ExtendedEndTime 由BidScript在觸發軟結標時寫入stream的end_time欄位，不包含在msgpack的內容中
+	ExtendedEndTime *time.Time `msgpack:"-"`
+	// Sealed 由SealedBidScript寫入stream的sealed欄位，密封出價不會推送給SSE訂閱者，也不會更新最高出價
+	Sealed bool `msgpack:"-"`
+	// OutbidUserID 由BidScript在領先者改變時寫入stream的outbid欄位，為被超過的前一位領先者，不包含在msgpack的內容中
+	OutbidUserID *uuid.UUID `msgpack:"-"`
 }
 
+// BidScript 的返回值為正數時代表競價成功，並以位元標記額外發生的狀況
+const (
+	// BidFlagExtended 出價落在軟結標區間內，結束時間被延後
+	BidFlagExtended = 1 << 1
+	// BidFlagOutbid 出價被其他使用者的代理出價立即超過
+	BidFlagOutbid = 1 << 2
+	// BidFlagReserveMet 最高出價首次達到底價
+	BidFlagReserveMet = 1 << 3
+	// BidFlagBuyNowWithdrawn 最高出價超過門檻，直購價被撤下
+	BidFlagBuyNowWithdrawn = 1 << 4
+)
+
 // BidScript 用於執行競價腳本
 //
 //	KEYS[1] - 競價商品鍵
 //	KEYS[2] - 競價的 stream
+//	KEYS[3] - 競價商品的狀態鍵(hash，包含 end_time、closed、soft_close_window、soft_close_extension、increment_percent、increment_tiers、reserve_price、buy_now_price 和 buy_now_threshold 欄位)
+//	KEYS[4] - 競價商品的代理出價鍵(hash，包含目前領先者的 user、max 和 data 欄位)
 //	ARGV[1] - 競價金額
 //	ARGV[2] - 競價資訊(結構參考BidInfo，會進行msgpack和base64的處理)
 //	ARGV[3] - 過期時間(秒)
+//	ARGV[4] - 競價時間(unix毫秒)
+//	ARGV[5] - 代理出價的最高金額(未使用代理出價時與競價金額相同)
+//	ARGV[6] - 競價者的使用者ID
 //
-// 返回值:
+// 返回值為{狀態, 下一次出價的最低金額, 延後後的結束時間(unix毫秒，未延後時為0)}，狀態為:
 //
 //	1  - 競價成功
-//	0  - 競價失敗
+//	1 | BidFlagExtended - 競價成功且延後了結束時間
+//	1 | BidFlagOutbid   - 競價成功但立即被代理出價超過
+//	1 | BidFlagReserveMet - 競價成功且最高出價達到底價
+//	1 | BidFlagBuyNowWithdrawn - 競價成功且直購價被撤下
+//	0  - 競價金額低於最低出價金額
 //	-1 - 拍賣商品ID不存在
+//	-2 - 拍賣已結束
+//
+// 最小加價規則:
+//   - increment_percent 大於0時，加價金額為目前價格的百分比(無條件進位)
+//   - 否則使用 increment_tiers(格式為"from:step,from:step"，依from遞增排序)中價格所在區間的step
+//   - 加價金額最少為1
 //
 // 流程:
-//   - 1. 檢查商品是否存在
+//   - 1. 檢查商品和狀態是否存在
 //   - 2a. 如果不存在，返回-1
-//   - 2b. 如果存在，檢查競價金額是否高於當前最高競價金額
-//   - 3a. 如果不高於，返回0
-//   - 3b. 如果高於，更新最高競價金額
-//   - 4. 將出價資訊寫入stream
-//   - 5. 返回1
+//   - 2b. 如果存在，檢查拍賣是否已結標或已超過結束時間
+//   - 3a. 如果已結束，返回-2
+//   - 3b. 如果未結束，檢查競價金額是否達到當前最高競價金額加上最小加價金額
+//   - 4a. 如果未達到，返回0
+//   - 4b. 如果達到，和其他使用者的代理出價比較上限
+//   - 5a. 如果代理出價的上限較高，競價者以上限出價後由代理出價以最小增額超過；上限相同時先設定者優先，代理出價以相同金額出價
+//   - 5b. 如果競價者的上限較高，代理出價以上限出價後由競價者以最小增額超過，並將競價者設為代理出價的領先者
+//   - 6. 如果領先者的上限達到底價但最高出價仍低於底價，將最高出價提高到底價；如果最高出價超過直購門檻，撤下直購價
+//   - 7. 更新最高競價金額，如果出價落在軟結標區間內，將結束時間延後
+//   - 8. 依序將產生的出價寫入stream，最後一筆會附帶新的結束時間，領先者改變時也會附帶被超過的前一位領先者(outbid欄位)，並將最後一筆的ID記錄於狀態鍵的last_bid_id欄位
+//
+// 狀態鍵中的leader欄位記錄目前領先者的使用者ID，用於判斷領先者是否改變
+// 代理出價自動產生的出價會使用代理出價鍵中的data作為出價資訊，並在stream中以amount和created_at欄位覆寫金額和時間
 var BidScript = redis.NewScript(`
 -- 檢查商品是否存在
-local item = redis.call('EXISTS', KEYS[1])
-if item == 0 then
-    return -1
+if redis.call('EXISTS', KEYS[1]) == 0 or redis.call('EXISTS', KEYS[3]) == 0 then
+    return {-1, 0, 0}
+end
+
+-- 檢查拍賣是否已結束
+local state = redis.call('HMGET', KEYS[3], 'closed', 'end_time', 'soft_close_window', 'soft_close_extension', 'increment_percent', 'increment_tiers', 'reserve_price', 'buy_now_threshold', 'leader')
+local bid_time = tonumber(ARGV[4])
+if state[1] == '1' then
+    return {-2, 0, 0}
+end
+local end_time = tonumber(state[2])
+if end_time and bid_time >= end_time then
+    return {-2, 0, 0}
+end
+
+-- 最小加價單位
+local increment_percent = tonumber(state[5]) or 0
+local increment_tiers = state[6] or ''
+local function increment(price)
+    local step = 1
+    if increment_percent > 0 then
+        step = math.ceil(price * increment_percent / 100)
+    else
+        for from, tier_step in string.gmatch(increment_tiers, '(%d+):(%d+)') do
+            if price >= tonumber(from) then
+                step = tonumber(tier_step)
+            end
+        end
+    end
+    return math.max(step, 1)
 end
 
 -- 取得當前最高競價
 local current_bid = tonumber(redis.call('GET', KEYS[1])) or 0
 local new_bid = tonumber(ARGV[1])
+local max_bid = math.max(tonumber(ARGV[5]) or new_bid, new_bid)
 
--- 檢查新競價是否高於當前最高價
-if new_bid <= current_bid then
-    return 0
+-- 檢查新競價是否達到最低出價金額
+local min_bid = current_bid + increment(current_bid)
+if new_bid < min_bid then
+    return {0, min_bid, 0}
+end
+
+-- 和代理出價比較上限，依序產生要寫入的出價
+local result = 1
+local bids = {}
+local leader = nil
+local proxy = redis.call('HMGET', KEYS[4], 'user', 'max', 'data')
+local proxy_max = tonumber(proxy[2]) or 0
+if proxy[1] and proxy[1] ~= ARGV[6] and proxy_max > current_bid then
+    if max_bid < proxy_max then
+        table.insert(bids, {ARGV[2], max_bid})
+        table.insert(bids, {proxy[3], math.min(proxy_max, max_bid + increment(max_bid))})
+        result = result + 4
+    elseif max_bid == proxy_max then
+        table.insert(bids, {ARGV[2], max_bid})
+        table.insert(bids, {proxy[3], proxy_max})
+        result = result + 4
+    else
+        new_bid = math.max(new_bid, math.min(max_bid, proxy_max + increment(proxy_max)))
+        table.insert(bids, {proxy[3], proxy_max})
+        table.insert(bids, {ARGV[2], new_bid})
+        leader = ARGV[2]
+    end
+else
+    if proxy[1] == ARGV[6] then
+        max_bid = math.max(max_bid, proxy_max)
+    end
+    table.insert(bids, {ARGV[2], new_bid})
+    leader = ARGV[2]
+end
+
+-- 底價：領先者的上限達到底價時，直接以底價出價
+local reserve_price = tonumber(state[7]) or 0
+local leader_max = proxy_max
+if leader then
+    leader_max = max_bid
+end
+if reserve_price > 0 and bids[#bids][2] < reserve_price and leader_max >= reserve_price then
+    bids[#bids][2] = reserve_price
+    if leader then
+        new_bid = reserve_price
+    end
+end
+if reserve_price > 0 and current_bid < reserve_price and bids[#bids][2] >= reserve_price then
+    result = result + 8
+end
+
+-- 直購：最高出價超過門檻後撤下直購價
+local buy_now_threshold = tonumber(state[8])
+if buy_now_threshold and bids[#bids][2] > buy_now_threshold then
+    redis.call('HDEL', KEYS[3], 'buy_now_price', 'buy_now_threshold')
+    result = result + 16
 end
 
+-- 更新代理出價的領先者
+if leader then
+    if max_bid > new_bid then
+        redis.call('HSET', KEYS[4], 'user', ARGV[6], 'max', max_bid, 'data', leader)
+    else
+        redis.call('DEL', KEYS[4])
+    end
+end
+
+-- 更新領先者，領先者改變時記錄被超過的前一位領先者
+local final_leader = ARGV[6]
+if not leader then
+    final_leader = proxy[1]
+end
+local outbid = nil
+if state[9] and state[9] ~= final_leader then
+    outbid = state[9]
+end
+redis.call('HSET', KEYS[3], 'leader', final_leader)
+
 -- 更新最高競價
-redis.call('SET', KEYS[1], new_bid, 'EX', ARGV[3])
+redis.call('SET', KEYS[1], bids[#bids][2], 'EX', ARGV[3])
+redis.call('EXPIRE', KEYS[3], ARGV[3])
+if redis.call('EXISTS', KEYS[4]) == 1 then
+    redis.call('EXPIRE', KEYS[4], ARGV[3])
+end
+
+-- 軟結標：在結束前的區間內出價時延後結束時間
+local new_end_time = nil
+local window = tonumber(state[3]) or 0
+local extension = tonumber(state[4]) or 0
+if end_time and window > 0 and extension > 0 and bid_time >= end_time - window and bid_time + extension > end_time then
+    new_end_time = bid_time + extension
+    redis.call('HSET', KEYS[3], 'end_time', new_end_time)
+    result = result + 2
+end
 
 -- 將競價記錄寫入 stream
-redis.call('XADD', KEYS[2], '*', 'data', ARGV[2])
+local last_bid_id = nil
+for i, bid in ipairs(bids) do
+    local fields = {'data', bid[1]}
+    if bid[1] ~= ARGV[2] then
+        table.insert(fields, 'amount')
+        table.insert(fields, bid[2])
+        table.insert(fields, 'created_at')
+        table.insert(fields, bid_time)
+    elseif bid[2] ~= tonumber(ARGV[1]) then
+        table.insert(fields, 'amount')
+        table.insert(fields, bid[2])
+    end
+    if new_end_time and i == #bids then
+        table.insert(fields, 'end_time')
+        table.insert(fields, new_end_time)
+    end
+    if outbid and i == #bids then
+        table.insert(fields, 'outbid')
+        table.insert(fields, outbid)
+    end
+    last_bid_id = redis.call('XADD', KEYS[2], '*', unpack(fields))
+end
+redis.call('HSET', KEYS[3], 'last_bid_id', last_bid_id)
+
+local price = bids[#bids][2]
+return {result, price + increment(price), new_end_time or 0}
+`)
+
+// runBidScript 執行BidScript，返回狀態和下一次出價的最低金額
+func runBidScript(ctx context.Context, client redis.Scripter, keys []string, args ...any) (int, models.Money, *time.Time, error) {
+	result, err := BidScript.Run(ctx, client, keys, args...).Int64Slice()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if len(result) != 3 {
+		return 0, 0, nil, fmt.Errorf("invalid script result: %v", result)
+	}
+	var endTime *time.Time
+	if result[2] > 0 {
+		t := time.UnixMilli(result[2])
+		endTime = &t
+	}
+	return int(result[0]), models.Money(result[1]), endTime, nil
+}
+
+// runStatusScript 執行返回{狀態, 金額}的Lua script(BuyNowScript、DutchAcceptScript)
+func runStatusScript(ctx context.Context, client redis.Scripter, script *redis.Script, keys []string, args ...any) (int, models.Money, error) {
+	result, err := script.Run(ctx, client, keys, args...).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(result) != 2 {
+		return 0, 0, fmt.Errorf("invalid script result: %v", result)
+	}
+	return int(result[0]), models.Money(result[1]), nil
+}
+
+// LoadAuctionScript 用於將資料庫中的拍賣資訊載入Redis
+//
+//	KEYS[1] - 競價商品鍵
+//	KEYS[2] - 競價商品的狀態鍵
+//	ARGV[1] - 目前最高競價金額
+//	ARGV[2] - 過期時間(秒)
+//	ARGV[3...] - 狀態欄位與值(成對出現)
+//
+// 返回值:
+//
+//	1 - 載入完成
+//
+// 流程:
+//   - 1. 如果競價商品鍵不存在，寫入目前最高競價金額
+//   - 2. 如果狀態鍵不存在，寫入狀態欄位；已存在的狀態可能已被結標流程修改，因此不覆寫
+//   - 3. 更新過期時間
+var LoadAuctionScript = redis.NewScript(`
+redis.call('SET', KEYS[1], ARGV[1], 'EX', ARGV[2], 'NX')
+
+if redis.call('EXISTS', KEYS[2]) == 0 then
+    for i = 3, #ARGV, 2 do
+        redis.call('HSET', KEYS[2], ARGV[i], ARGV[i + 1])
+    end
+end
+redis.call('EXPIRE', KEYS[2], ARGV[2])
+
+return 1
+`)
+
+// LoadProxyBidScript 用於將資料庫中領先者的代理出價載入Redis
+//
+//	KEYS[1] - 競價商品的代理出價鍵
+//	ARGV[1] - 過期時間(秒)
+//	ARGV[2] - 代理出價的使用者ID
+//	ARGV[3] - 代理出價的最高金額
+//	ARGV[4] - 代理出價的出價資訊(結構參考BidInfo，會進行msgpack和base64的處理)
+//
+// 返回值:
+//
+//	1 - 已載入
+//	0 - 代理出價鍵已存在，不進行覆寫
+var LoadProxyBidScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+    return 0
+end
+
+redis.call('HSET', KEYS[1], 'user', ARGV[2], 'max', ARGV[3], 'data', ARGV[4])
+redis.call('EXPIRE', KEYS[1], ARGV[1])
+
+return 1
+`)
+
+// BuyNowScript 用於以直購價購買商品，和BidScript一樣在Redis中序列化處理，購買成功後拍賣會立即結標
+//
+//	KEYS[1] - 競價商品鍵
+//	KEYS[2] - 競價的 stream
+//	KEYS[3] - 競價商品的狀態鍵
+//	ARGV[1] - 購買資訊(結構參考BidInfo，會進行msgpack和base64的處理)
+//	ARGV[2] - 過期時間(秒)
+//	ARGV[3] - 購買時間(unix毫秒)
+//
+// 返回值為{狀態, 成交價格}，狀態為:
+//
+//	1  - 購買成功
+//	0  - 直購價不存在或已被撤下
+//	-1 - 拍賣商品ID不存在
+//	-2 - 拍賣已結束
+//
+// 購買成功時會以直購價作為最高出價寫入stream(以amount欄位覆寫金額)，並將拍賣標記為已結標
+var BuyNowScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 or redis.call('EXISTS', KEYS[3]) == 0 then
+    return {-1, 0}
+end
+
+-- 檢查拍賣是否已結束
+local state = redis.call('HMGET', KEYS[3], 'closed', 'end_time', 'buy_now_price')
+if state[1] == '1' then
+    return {-2, 0}
+end
+local end_time = tonumber(state[2])
+if end_time and tonumber(ARGV[3]) >= end_time then
+    return {-2, 0}
+end
+
+-- 檢查直購價是否還有效
+local buy_now_price = tonumber(state[3])
+local current_bid = tonumber(redis.call('GET', KEYS[1])) or 0
+if not buy_now_price or current_bid >= buy_now_price then
+    return {0, 0}
+end
+
+-- 以直購價得標並結標
+redis.call('SET', KEYS[1], buy_now_price, 'EX', ARGV[2])
+redis.call('HSET', KEYS[3], 'closed', '1')
+redis.call('HDEL', KEYS[3], 'buy_now_price', 'buy_now_threshold')
+redis.call('EXPIRE', KEYS[3], ARGV[2])
+redis.call('HSET', KEYS[3], 'last_bid_id', redis.call('XADD', KEYS[2], '*', 'data', ARGV[1], 'amount', buy_now_price))
+
+return {1, buy_now_price}
+`)
+
+// DutchAcceptScript 用於在荷式拍賣中以目前價格成交，第一個接受的買家得標，之後拍賣會立即結標
+//
+//	KEYS[1] - 競價商品鍵
+//	KEYS[2] - 競價的 stream
+//	KEYS[3] - 競價商品的狀態鍵(hash，包含 dutch_start_price、dutch_start_time、dutch_decrement、dutch_interval 和 dutch_floor 欄位)
+//	ARGV[1] - 購買資訊(結構參考BidInfo，會進行msgpack和base64的處理)
+//	ARGV[2] - 過期時間(秒)
+//	ARGV[3] - 接受時間(unix毫秒)
+//
+// 返回值為{狀態, 成交價格}，狀態為:
+//
+//	1  - 成交
+//	0  - 不是荷式拍賣
+//	-1 - 拍賣商品ID不存在
+//	-2 - 拍賣已結束
+//
+// 目前價格為 max(dutch_floor, dutch_start_price - dutch_decrement * floor((接受時間 - dutch_start_time) / dutch_interval))，
+// 和dutchPrice的計算方式相同
+var DutchAcceptScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 or redis.call('EXISTS', KEYS[3]) == 0 then
+    return {-1, 0}
+end
+
+-- 檢查拍賣是否已結束
+local state = redis.call('HMGET', KEYS[3], 'closed', 'end_time', 'dutch_start_price', 'dutch_start_time', 'dutch_decrement', 'dutch_interval', 'dutch_floor')
+local accept_time = tonumber(ARGV[3])
+if state[1] == '1' then
+    return {-2, 0}
+end
+local end_time = tonumber(state[2])
+if end_time and accept_time >= end_time then
+    return {-2, 0}
+end
+
+-- 計算目前價格
+local start_price = tonumber(state[3])
+if not start_price then
+    return {0, 0}
+end
+local steps = math.max(0, math.floor((accept_time - tonumber(state[4])) / tonumber(state[6])))
+local price = math.max(tonumber(state[7]), start_price - steps * tonumber(state[5]))
+
+-- 以目前價格得標並結標
+redis.call('SET', KEYS[1], price, 'EX', ARGV[2])
+redis.call('HSET', KEYS[3], 'closed', '1')
+redis.call('EXPIRE', KEYS[3], ARGV[2])
+redis.call('HSET', KEYS[3], 'last_bid_id', redis.call('XADD', KEYS[2], '*', 'data', ARGV[1], 'amount', price))
+
+return {1, price}
+`)
+
+// SealedBidScript 用於在密封出價拍賣中出價，出價只需要不低於起標價，不會和其他出價比較
+//
+//	KEYS[1] - 競價商品鍵
+//	KEYS[2] - 競價的 stream
+//	KEYS[3] - 競價商品的狀態鍵(hash，包含 sealed_minimum 和 sealed_bids 欄位)
+//	ARGV[1] - 出價資訊(結構參考BidInfo，會進行msgpack和base64的處理)
+//	ARGV[2] - 過期時間(秒)
+//	ARGV[3] - 出價時間(unix毫秒)
+//	ARGV[4] - 出價金額
+//
+// 返回值為{狀態, 最低出價}，狀態為:
+//
+//	1  - 出價成功
+//	0  - 出價低於起標價，或不是密封出價拍賣
+//	-1 - 拍賣商品ID不存在
+//	-2 - 拍賣已結束
+//
+// 出價成功時會以sealed欄位標記寫入stream，並累加sealed_bids作為是否已有出價的依據
+var SealedBidScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 or redis.call('EXISTS', KEYS[3]) == 0 then
+    return {-1, 0}
+end
+
+-- 檢查拍賣是否已結束
+local state = redis.call('HMGET', KEYS[3], 'closed', 'end_time', 'sealed_minimum')
+if state[1] == '1' then
+    return {-2, 0}
+end
+local end_time = tonumber(state[2])
+if end_time and tonumber(ARGV[3]) >= end_time then
+    return {-2, 0}
+end
+
+-- 檢查出價是否不低於起標價
+local minimum = tonumber(state[3])
+if not minimum or tonumber(ARGV[4]) < minimum then
+    return {0, minimum or 0}
+end
+
+redis.call('HINCRBY', KEYS[3], 'sealed_bids', 1)
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+redis.call('EXPIRE', KEYS[3], ARGV[2])
+redis.call('HSET', KEYS[3], 'last_bid_id', redis.call('XADD', KEYS[2], '*', 'data', ARGV[1], 'sealed', '1'))
+
+return {1, minimum}
+`)
+
+// MultiUnitBidScript 用於在多數量拍賣中出價，依出價金額排序的得標出價保存在 sorted set 中
+//
+//	KEYS[1] - 競價商品鍵(保存目前的統一成交價)
+//	KEYS[2] - 競價的 stream
+//	KEYS[3] - 競價商品的狀態鍵(hash，包含 quantity、multi_minimum 和 bid_seq 欄位)
+//	KEYS[4] - 得標出價的 sorted set
+//	ARGV[1] - 出價資訊(結構參考BidInfo，會進行msgpack和base64的處理)
+//	ARGV[2] - 過期時間(秒)
+//	ARGV[3] - 出價時間(unix毫秒)
+//	ARGV[4] - 出價金額
+//	ARGV[5] - 出價數量
+//	ARGV[6] - 出價者的使用者ID
+//
+// sorted set 的 score 為出價金額，member 為 <反序的出價序號>:<數量>:<使用者ID>，
+// 同額時 ZREVRANGE 會先返回序號較小(較早)的出價。每位使用者只保留最新的一筆出價，
+// 且新的出價金額和數量都不能低於先前的出價，因此完全沒有分配到數量的出價可以直接移除
+//
+// 返回值為{狀態, 下一次出價的最低金額, 統一成交價, 已被出價覆蓋的數量}，狀態為:
+//
+//	1  - 出價成功
+//	0  - 出價過低(低於起標價、不高於統一成交價，或不高於自己先前的出價)
+//	-1 - 拍賣商品ID不存在
+//	-2 - 拍賣已結束
+//	-3 - 數量不合法(超過拍賣數量，或低於自己先前出價的數量)
+var MultiUnitBidScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 or redis.call('EXISTS', KEYS[3]) == 0 then
+    return {-1, 0, 0, 0}
+end
+
+-- 檢查拍賣是否已結束
+local state = redis.call('HMGET', KEYS[3], 'closed', 'end_time', 'quantity', 'multi_minimum')
+if state[1] == '1' then
+    return {-2, 0, 0, 0}
+end
+local end_time = tonumber(state[2])
+if end_time and tonumber(ARGV[3]) >= end_time then
+    return {-2, 0, 0, 0}
+end
+
+local quantity = tonumber(state[3])
+local minimum = tonumber(state[4])
+local amount = tonumber(ARGV[4])
+local bid_quantity = tonumber(ARGV[5])
+local user = ARGV[6]
+if not quantity or not minimum or bid_quantity < 1 or bid_quantity > quantity then
+    return {-3, 0, 0, 0}
+end
+
+-- 依排序分配數量，返回尚未分配的數量、統一成交價(最低得標出價)、沒有分配到數量的出價，以及使用者自己的出價
+local function allocate(exclude)
+    local members = redis.call('ZREVRANGE', KEYS[4], 0, -1, 'WITHSCORES')
+    local remaining = quantity
+    local clearing = 0
+    local losers = {}
+    local own_member, own_amount, own_quantity
+    for i = 1, #members, 2 do
+        local member, score = members[i], tonumber(members[i + 1])
+        local _, _, q, u = string.find(member, '^%d+:(%d+):(.+)$')
+        if u == exclude then
+            own_member, own_amount, own_quantity = member, score, tonumber(q)
+        elseif remaining > 0 then
+            remaining = remaining - math.min(remaining, tonumber(q))
+            clearing = score
+        else
+            table.insert(losers, member)
+        end
+    end
+    return remaining, clearing, losers, own_member, own_amount, own_quantity
+end
+
+-- 下一次出價必須高於統一成交價，所有數量都還沒被覆蓋時只需要不低於起標價
+local function minimum_next_bid(remaining, clearing)
+    if remaining > 0 then
+        return minimum
+    end
+    return math.max(minimum, clearing + 1)
+end
+
+-- 檢查出價是否足以分配到數量，且金額和數量不低於自己先前的出價
+local remaining, clearing, _, own_member, own_amount, own_quantity = allocate(user)
+if own_quantity and bid_quantity < own_quantity then
+    return {-3, 0, 0, 0}
+end
+local minimum_bid = minimum_next_bid(remaining, clearing)
+if own_amount and own_amount >= minimum_bid then
+    minimum_bid = own_amount + 1
+end
+if amount < minimum_bid then
+    return {0, minimum_bid, 0, 0}
+end
+
+-- 以新的出價取代使用者先前的出價
+if own_member then
+    redis.call('ZREM', KEYS[4], own_member)
+end
+local seq = redis.call('HINCRBY', KEYS[3], 'bid_seq', 1)
+redis.call('ZADD', KEYS[4], amount, string.format('%012d:%d:%s', 999999999999 - seq, bid_quantity, user))
+
+-- 重新分配數量，移除沒有分配到數量的出價
+local losers
+remaining, clearing, losers = allocate(nil)
+if #losers > 0 then
+    redis.call('ZREM', KEYS[4], unpack(losers))
+end
+
+redis.call('SET', KEYS[1], clearing, 'EX', ARGV[2])
+redis.call('EXPIRE', KEYS[3], ARGV[2])
+redis.call('EXPIRE', KEYS[4], ARGV[2])
+redis.call('HSET', KEYS[3], 'last_bid_id', redis.call('XADD', KEYS[2], '*', 'data', ARGV[1]))
+
+return {1, minimum_next_bid(remaining, clearing), clearing, quantity - remaining}
+`)
+
+// LoadRankingScript 用於將資料庫中多數量拍賣的出價載入得標出價的 sorted set
+//
+//	KEYS[1] - 得標出價的 sorted set
+//	KEYS[2] - 競價商品的狀態鍵
+//	ARGV[1] - 過期時間(秒)
+//	ARGV[2...] - 依出價時間排序的{出價金額, 數量, 使用者ID}，每位使用者只需要最新的一筆出價
+//
+// 返回值:
+//
+//	1 - 已載入
+//	0 - sorted set 已存在，不進行覆寫
+var LoadRankingScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+    return 0
+end
+
+local seq = tonumber(redis.call('HGET', KEYS[2], 'bid_seq')) or 0
+for i = 2, #ARGV, 3 do
+    seq = seq + 1
+    redis.call('ZADD', KEYS[1], ARGV[i], string.format('%012d:%d:%s', 999999999999 - seq, ARGV[i + 1], ARGV[i + 2]))
+end
+redis.call('HSET', KEYS[2], 'bid_seq', seq)
+if #ARGV > 1 then
+    redis.call('EXPIRE', KEYS[1], ARGV[1])
+end
+redis.call('EXPIRE', KEYS[2], ARGV[1])
 
 return 1
 `)
+
+// CloseAuctionScript 用於將拍賣標記為已結標，之後的出價都會被BidScript拒絕
+//
+//	KEYS[1] - 競價商品的狀態鍵
+//	ARGV[1] - 結標時間(unix毫秒)
+//	ARGV[2] - 過期時間(秒)
+//
+// 返回值為{狀態, 最後一筆出價的stream ID}，寫入bid stream的腳本都會在狀態鍵的last_bid_id欄位記錄ID，沒有出價時為空字串，狀態為:
+//
+//	1 - 已標記為結標(包含已經被直購結標的情況)
+//	0 - Redis中記錄的結束時間尚未到達，不進行結標
+var CloseAuctionScript = redis.NewScript(`
+local last_bid_id = redis.call('HGET', KEYS[1], 'last_bid_id') or ''
+if redis.call('HGET', KEYS[1], 'closed') == '1' then
+    redis.call('EXPIRE', KEYS[1], ARGV[2])
+    return {1, last_bid_id}
+end
+
+local end_time = tonumber(redis.call('HGET', KEYS[1], 'end_time'))
+if end_time and tonumber(ARGV[1]) < end_time then
+    return {0, ''}
+end
+
+redis.call('HSET', KEYS[1], 'closed', '1')
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+
+return {1, last_bid_id}
+`)
+
+// runCloseAuctionScript 執行CloseAuctionScript，返回是否已結標和最後一筆出價的stream ID
+func runCloseAuctionScript(ctx context.Context, client redis.Scripter, keys []string, args ...any) (bool, string, error) {
+	result, err := CloseAuctionScript.Run(ctx, client, keys, args...).Slice()
+	if err != nil {
+		return false, "", err
+	}
+	if len(result) != 2 {
+		return false, "", fmt.Errorf("invalid script result: %v", result)
+	}
+	closed, ok := result[0].(int64)
+	if !ok {
+		return false, "", fmt.Errorf("invalid script result: %v", result)
+	}
+	lastBidID, _ := result[1].(string)
+	return closed == 1, lastBidID, nil
+}
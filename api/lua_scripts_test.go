@@ -3,16 +3,20 @@ package api
 import (
 	"context"
 	"encoding/base64"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/vmihailenco/msgpack/v5"
 
 	redisAdapter "q4/adapters/redis"
+	"q4/models"
 )
 
 // compareBidInfo compares two BidInfo structs with proper time comparison
@@ -41,6 +45,7 @@ func TestBidScript(t *testing.T) {
 
 	ctx := context.Background()
 	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
 	itemID := uuid.New()
 	user := BidInfoUser{
 		ID:   uuid.New(),
@@ -52,6 +57,7 @@ func TestBidScript(t *testing.T) {
 		setupFunc   func()
 		itemKey     string
 		streamKey   string
+		stateKey    string
 		bidAmount   string
 		bidInfo     BidInfo
 		expireTime  string
@@ -63,23 +69,86 @@ func TestBidScript(t *testing.T) {
 			setupFunc: func() {},
 			itemKey:   "item:nonexistent",
 			streamKey: "stream:bids",
+			stateKey:  "item:nonexistent:state",
 			bidAmount: "100",
 			bidInfo: BidInfo{
 				ItemID:    itemID,
 				User:      user,
 				Amount:    100,
 				CreatedAt: now,
+				Currency:  models.DefaultCurrency,
 			},
 			expireTime: "3600",
 			want:       -1,
 		},
+		{
+			name: "商品狀態不存在時應返回-1",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+			},
+			itemKey:    "item:1",
+			streamKey:  "stream:bids",
+			stateKey:   "item:1:state",
+			bidAmount:  "200",
+			expireTime: "3600",
+			bidInfo: BidInfo{
+				ItemID:    itemID,
+				User:      user,
+				Amount:    200,
+				CreatedAt: now,
+				Currency:  models.DefaultCurrency,
+			},
+			want: -1,
+		},
+		{
+			name: "拍賣已結標時應返回-2",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "closed", "1")
+			},
+			itemKey:    "item:1",
+			streamKey:  "stream:bids",
+			stateKey:   "item:1:state",
+			bidAmount:  "200",
+			expireTime: "3600",
+			bidInfo: BidInfo{
+				ItemID:    itemID,
+				User:      user,
+				Amount:    200,
+				CreatedAt: now,
+				Currency:  models.DefaultCurrency,
+			},
+			want: -2,
+		},
+		{
+			name: "超過結束時間時應返回-2",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", strconv.FormatInt(now.Add(-time.Second).UnixMilli(), 10))
+			},
+			itemKey:    "item:1",
+			streamKey:  "stream:bids",
+			stateKey:   "item:1:state",
+			bidAmount:  "200",
+			expireTime: "3600",
+			bidInfo: BidInfo{
+				ItemID:    itemID,
+				User:      user,
+				Amount:    200,
+				CreatedAt: now,
+				Currency:  models.DefaultCurrency,
+			},
+			want: -2,
+		},
 		{
 			name: "出價金額不足時應返回0",
 			setupFunc: func() {
 				mr.Set("item:1", "200")
+				mr.HSet("item:1:state", "end_time", endTime)
 			},
 			itemKey:    "item:1",
 			streamKey:  "stream:bids",
+			stateKey:   "item:1:state",
 			bidAmount:  "100",
 			expireTime: "3600",
 			bidInfo: BidInfo{
@@ -87,6 +156,7 @@ func TestBidScript(t *testing.T) {
 				User:      user,
 				Amount:    100,
 				CreatedAt: now,
+				Currency:  models.DefaultCurrency,
 			},
 			want: 0,
 		},
@@ -94,9 +164,11 @@ func TestBidScript(t *testing.T) {
 			name: "競價成功時應返回1且寫入stream",
 			setupFunc: func() {
 				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime)
 			},
 			itemKey:    "item:1",
 			streamKey:  "stream:bids",
+			stateKey:   "item:1:state",
 			bidAmount:  "200",
 			expireTime: "3600",
 			bidInfo: BidInfo{
@@ -104,6 +176,7 @@ func TestBidScript(t *testing.T) {
 				User:      user,
 				Amount:    200,
 				CreatedAt: now,
+				Currency:  models.DefaultCurrency,
 			},
 			want:        1,
 			checkStream: true,
@@ -124,10 +197,10 @@ func TestBidScript(t *testing.T) {
 			bidInfo := base64.StdEncoding.EncodeToString(bidInfoBytes)
 
 			// 執行腳本
-			result, err := BidScript.Run(ctx, client,
-				[]string{tt.itemKey, tt.streamKey},
-				tt.bidAmount, bidInfo, tt.expireTime,
-			).Int()
+			result, _, _, err := runBidScript(ctx, client,
+				[]string{tt.itemKey, tt.streamKey, tt.stateKey, tt.itemKey + ":proxy"},
+				tt.bidAmount, bidInfo, tt.expireTime, tt.bidInfo.CreatedAt.UnixMilli(), tt.bidAmount, tt.bidInfo.User.ID.String(),
+			)
 
 			// 驗證結果
 			assert.NoError(t, err)
@@ -159,3 +232,1218 @@ func TestBidScript(t *testing.T) {
 		})
 	}
 }
+
+func TestBidScriptSoftClose(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		Amount:    200,
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+
+	tests := []struct {
+		name        string
+		endTime     time.Time
+		wantResult  int
+		wantEndTime time.Time
+	}{
+		{
+			name:        "結束前區間內出價應延後結束時間",
+			endTime:     now.Add(10 * time.Second),
+			wantResult:  1 | BidFlagExtended,
+			wantEndTime: now.Add(30 * time.Second),
+		},
+		{
+			name:        "區間外出價不應延後結束時間",
+			endTime:     now.Add(time.Hour),
+			wantResult:  1,
+			wantEndTime: now.Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			mr.Set("item:1", "100")
+			mr.HSet("item:1:state",
+				"end_time", strconv.FormatInt(tt.endTime.UnixMilli(), 10),
+				"soft_close_window", "60000",
+				"soft_close_extension", "30000",
+			)
+
+			result, _, extendedEndTime, err := runBidScript(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:proxy"},
+				"200", data, "3600", now.UnixMilli(), "200", bidInfo.User.ID.String(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+
+			// 檢查腳本返回的延後結束時間
+			if result&BidFlagExtended != 0 {
+				if assert.NotNil(t, extendedEndTime) {
+					assert.Equal(t, tt.wantEndTime.UnixMilli(), extendedEndTime.UnixMilli())
+				}
+			} else {
+				assert.Nil(t, extendedEndTime)
+			}
+
+			// 檢查Redis中的結束時間
+			endTime := client.HGet(ctx, "item:1:state", "end_time").Val()
+			assert.Equal(t, strconv.FormatInt(tt.wantEndTime.UnixMilli(), 10), endTime)
+
+			// 檢查stream記錄中的延後結束時間
+			streams, err := client.XRange(ctx, "stream:bids", "-", "+").Result()
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(streams))
+			assert.Equal(t, streams[0].ID, client.HGet(ctx, "item:1:state", "last_bid_id").Val())
+			parsed, err := parseBidInfo(streams[0].Values)
+			assert.NoError(t, err)
+			compareBidInfo(t, bidInfo, parsed)
+			if result&BidFlagExtended != 0 {
+				if assert.NotNil(t, parsed.ExtendedEndTime) {
+					assert.Equal(t, tt.wantEndTime.UnixMilli(), parsed.ExtendedEndTime.UnixMilli())
+				}
+			} else {
+				assert.Nil(t, parsed.ExtendedEndTime)
+			}
+		})
+	}
+}
+
+func TestBidScriptProxyBid(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	itemID := uuid.New()
+	newBidInfo := func(name string, amount models.Money) (BidInfo, string) {
+		bidInfo := BidInfo{
+			ItemID:    itemID,
+			User:      BidInfoUser{ID: uuid.New(), Name: name},
+			Amount:    amount,
+			CreatedAt: now,
+			Currency:  models.DefaultCurrency,
+		}
+		bidInfoBytes, err := msgpack.Marshal(bidInfo)
+		assert.NoError(t, err)
+		return bidInfo, base64.StdEncoding.EncodeToString(bidInfoBytes)
+	}
+	leader, leaderData := newBidInfo("Leader", 100)
+	challenger, challengerData := newBidInfo("Challenger", 120)
+
+	type streamBid struct {
+		user   string
+		amount models.Money
+	}
+	tests := []struct {
+		name       string
+		proxyMax   string
+		maxBid     string
+		wantResult int
+		wantPrice  string
+		wantBids   []streamBid
+		wantProxy  map[string]string
+	}{
+		{
+			name:       "代理出價上限較高時應以最小增額超過挑戰者",
+			proxyMax:   "300",
+			maxBid:     "150",
+			wantResult: 1 | BidFlagOutbid,
+			wantPrice:  "151",
+			wantBids:   []streamBid{{"Challenger", 150}, {"Leader", 151}},
+			wantProxy:  map[string]string{"user": leader.User.ID.String(), "max": "300"},
+		},
+		{
+			name:       "上限相同時應由先設定的代理出價領先",
+			proxyMax:   "300",
+			maxBid:     "300",
+			wantResult: 1 | BidFlagOutbid,
+			wantPrice:  "300",
+			wantBids:   []streamBid{{"Challenger", 300}, {"Leader", 300}},
+			wantProxy:  map[string]string{"user": leader.User.ID.String(), "max": "300"},
+		},
+		{
+			name:       "挑戰者上限較高時應取代代理出價的領先者",
+			proxyMax:   "200",
+			maxBid:     "500",
+			wantResult: 1,
+			wantPrice:  "201",
+			wantBids:   []streamBid{{"Leader", 200}, {"Challenger", 201}},
+			wantProxy:  map[string]string{"user": challenger.User.ID.String(), "max": "500"},
+		},
+		{
+			name:       "挑戰者沒有代理出價且超過上限時應清除代理出價",
+			proxyMax:   "110",
+			maxBid:     "120",
+			wantResult: 1,
+			wantPrice:  "120",
+			wantBids:   []streamBid{{"Leader", 110}, {"Challenger", 120}},
+			wantProxy:  map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			mr.Set("item:1", "100")
+			mr.HSet("item:1:state", "end_time", endTime)
+			mr.HSet("item:1:proxy", "user", leader.User.ID.String(), "max", tt.proxyMax, "data", leaderData)
+
+			result, _, _, err := runBidScript(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:proxy"},
+				"120", challengerData, "3600", now.UnixMilli(), tt.maxBid, challenger.User.ID.String(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantPrice, client.Get(ctx, "item:1").Val())
+
+			// 檢查stream中依序寫入的出價
+			streams, err := client.XRange(ctx, "stream:bids", "-", "+").Result()
+			assert.NoError(t, err)
+			bids := make([]streamBid, len(streams))
+			for i, stream := range streams {
+				parsed, err := parseBidInfo(stream.Values)
+				assert.NoError(t, err)
+				assert.Equal(t, itemID, parsed.ItemID)
+				assert.Equal(t, now.UnixMilli(), parsed.CreatedAt.UnixMilli())
+				bids[i] = streamBid{parsed.User.Name, parsed.Amount}
+			}
+			assert.Equal(t, tt.wantBids, bids)
+
+			// 檢查代理出價的領先者
+			proxy := client.HGetAll(ctx, "item:1:proxy").Val()
+			delete(proxy, "data")
+			assert.Equal(t, tt.wantProxy, proxy)
+		})
+	}
+}
+
+func TestBidScriptOutbid(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	leaderID := uuid.New()
+	challenger := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "Challenger"},
+		Amount:    120,
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	challengerBytes, err := msgpack.Marshal(challenger)
+	assert.NoError(t, err)
+	challengerData := base64.StdEncoding.EncodeToString(challengerBytes)
+
+	tests := []struct {
+		name       string
+		leader     string
+		proxyMax   string
+		wantOutbid *uuid.UUID
+		wantLeader string
+	}{
+		{
+			name:       "沒有領先者時不應標記被超過的使用者",
+			wantLeader: challenger.User.ID.String(),
+		},
+		{
+			name:       "領先者改變時應在最後一筆出價標記被超過的使用者",
+			leader:     leaderID.String(),
+			wantOutbid: &leaderID,
+			wantLeader: challenger.User.ID.String(),
+		},
+		{
+			name:       "領先者自己加價時不應標記",
+			leader:     challenger.User.ID.String(),
+			wantLeader: challenger.User.ID.String(),
+		},
+		{
+			name:       "代理出價守住領先時不應標記",
+			leader:     leaderID.String(),
+			proxyMax:   "300",
+			wantLeader: leaderID.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			mr.Set("item:1", "100")
+			mr.HSet("item:1:state", "end_time", endTime)
+			if tt.leader != "" {
+				mr.HSet("item:1:state", "leader", tt.leader)
+			}
+			if tt.proxyMax != "" {
+				mr.HSet("item:1:proxy", "user", leaderID.String(), "max", tt.proxyMax, "data", challengerData)
+			}
+
+			_, _, _, err := runBidScript(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:proxy"},
+				"120", challengerData, "3600", now.UnixMilli(), "120", challenger.User.ID.String(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantLeader, mr.HGet("item:1:state", "leader"))
+
+			// 只有最後一筆出價會帶有被超過的使用者
+			streams, err := client.XRange(ctx, "stream:bids", "-", "+").Result()
+			assert.NoError(t, err)
+			for i, stream := range streams {
+				parsed, err := parseBidInfo(stream.Values)
+				assert.NoError(t, err)
+				if i == len(streams)-1 {
+					assert.Equal(t, tt.wantOutbid, parsed.OutbidUserID)
+				} else {
+					assert.Nil(t, parsed.OutbidUserID)
+				}
+			}
+		})
+	}
+}
+
+func TestBidScriptIncrement(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+
+	tests := []struct {
+		name        string
+		state       []string
+		current     string
+		bid         string
+		wantResult  int
+		wantMinimum models.Money
+	}{
+		{
+			name:        "未設定規則時只需要高於目前價格",
+			current:     "10000",
+			bid:         "10001",
+			wantResult:  1,
+			wantMinimum: 10002,
+		},
+		{
+			name:        "固定加價不足時應返回0和最低出價金額",
+			state:       []string{"increment_tiers", "0:50"},
+			current:     "10000",
+			bid:         "10001",
+			wantResult:  0,
+			wantMinimum: 10050,
+		},
+		{
+			name:        "百分比加價應無條件進位",
+			state:       []string{"increment_percent", "5"},
+			current:     "1010",
+			bid:         "1061",
+			wantResult:  1,
+			wantMinimum: 1115,
+		},
+		{
+			name:        "百分比加價不足時應返回0",
+			state:       []string{"increment_percent", "5"},
+			current:     "1010",
+			bid:         "1060",
+			wantResult:  0,
+			wantMinimum: 1061,
+		},
+		{
+			name:        "區間加價應使用價格所在區間的金額",
+			state:       []string{"increment_tiers", "0:10,1000:50,10000:100"},
+			current:     "990",
+			bid:         "1000",
+			wantResult:  1,
+			wantMinimum: 1050,
+		},
+		{
+			name:        "區間加價不足時應返回0",
+			state:       []string{"increment_tiers", "0:10,1000:50,10000:100"},
+			current:     "10000",
+			bid:         "10050",
+			wantResult:  0,
+			wantMinimum: 10100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			mr.Set("item:1", tt.current)
+			mr.HSet("item:1:state", append([]string{"end_time", endTime}, tt.state...)...)
+
+			result, minimum, _, err := runBidScript(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:proxy"},
+				tt.bid, data, "3600", now.UnixMilli(), tt.bid, bidInfo.User.ID.String(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantMinimum, minimum)
+		})
+	}
+}
+
+func TestBidScriptReservePrice(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		Amount:    150,
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+
+	tests := []struct {
+		name       string
+		current    string
+		bid        string
+		maxBid     string
+		wantResult int
+		wantPrice  string
+	}{
+		{
+			name:       "未達底價時不應標記",
+			current:    "100",
+			bid:        "150",
+			maxBid:     "150",
+			wantResult: 1,
+			wantPrice:  "150",
+		},
+		{
+			name:       "代理出價上限達到底價時應直接以底價出價",
+			current:    "100",
+			bid:        "150",
+			maxBid:     "500",
+			wantResult: 1 | BidFlagReserveMet,
+			wantPrice:  "300",
+		},
+		{
+			name:       "已達底價後不應重複標記",
+			current:    "300",
+			bid:        "350",
+			maxBid:     "350",
+			wantResult: 1,
+			wantPrice:  "350",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			mr.Set("item:1", tt.current)
+			mr.HSet("item:1:state", "end_time", endTime, "reserve_price", "300")
+
+			result, _, _, err := runBidScript(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:proxy"},
+				tt.bid, data, "3600", now.UnixMilli(), tt.maxBid, bidInfo.User.ID.String(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantPrice, client.Get(ctx, "item:1").Val())
+		})
+	}
+}
+
+func TestBuyNowScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		Amount:    500,
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+
+	tests := []struct {
+		name      string
+		setupFunc func()
+		want      int
+		wantPrice string
+	}{
+		{
+			name:      "商品不存在時應返回-1",
+			setupFunc: func() {},
+			want:      -1,
+		},
+		{
+			name: "拍賣已結標時應返回-2",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "closed", "1", "buy_now_price", "500")
+			},
+			want:      -2,
+			wantPrice: "100",
+		},
+		{
+			name: "直購價已被撤下時應返回0",
+			setupFunc: func() {
+				mr.Set("item:1", "300")
+				mr.HSet("item:1:state", "end_time", endTime)
+			},
+			want:      0,
+			wantPrice: "300",
+		},
+		{
+			name: "購買成功時應以直購價得標並結標",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "buy_now_price", "500", "buy_now_threshold", "250")
+			},
+			want:      1,
+			wantPrice: "500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			tt.setupFunc()
+
+			result, _, err := runStatusScript(ctx, client, BuyNowScript,
+				[]string{"item:1", "stream:bids", "item:1:state"},
+				data, "3600", now.UnixMilli(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+			if tt.wantPrice != "" {
+				assert.Equal(t, tt.wantPrice, client.Get(ctx, "item:1").Val())
+			}
+
+			streams, err := client.XRange(ctx, "stream:bids", "-", "+").Result()
+			assert.NoError(t, err)
+			if result != 1 {
+				assert.Empty(t, streams)
+				return
+			}
+			// 購買後拍賣應被標記為結標，且直購價被撤下
+			assert.Equal(t, "1", client.HGet(ctx, "item:1:state", "closed").Val())
+			assert.False(t, client.HExists(ctx, "item:1:state", "buy_now_price").Val())
+			if assert.Equal(t, 1, len(streams)) {
+				parsed, err := parseBidInfo(streams[0].Values)
+				assert.NoError(t, err)
+				compareBidInfo(t, bidInfo, parsed)
+			}
+		})
+	}
+}
+
+func TestDutchAcceptScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	auction := &models.AuctionItem{
+		StartingPrice:   1000,
+		StartTime:       now.Add(-150 * time.Second),
+		EndTime:         now.Add(time.Hour),
+		Type:            models.AuctionTypeDutch,
+		DutchDecrement:  100,
+		DutchInterval:   60,
+		DutchFloorPrice: 700,
+	}
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		Amount:    dutchPrice(auction, now),
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+	setupState := func(fields ...any) {
+		state := append([]any{"end_time", auction.EndTime.UnixMilli()}, dutchState(auction)...)
+		client.HSet(ctx, "item:1:state", append(state, fields...)...)
+	}
+
+	tests := []struct {
+		name      string
+		setupFunc func()
+		accept    time.Time
+		want      int
+		wantPrice models.Money
+	}{
+		{
+			name:      "商品不存在時應返回-1",
+			setupFunc: func() {},
+			accept:    now,
+			want:      -1,
+		},
+		{
+			name: "拍賣已結標時應返回-2",
+			setupFunc: func() {
+				mr.Set("item:1", "1000")
+				setupState("closed", "1")
+			},
+			accept: now,
+			want:   -2,
+		},
+		{
+			name: "拍賣已結束時應返回-2",
+			setupFunc: func() {
+				mr.Set("item:1", "1000")
+				setupState()
+			},
+			accept: auction.EndTime,
+			want:   -2,
+		},
+		{
+			name: "不是荷式拍賣時應返回0",
+			setupFunc: func() {
+				mr.Set("item:1", "1000")
+				mr.HSet("item:1:state", "end_time", strconv.FormatInt(auction.EndTime.UnixMilli(), 10))
+			},
+			accept: now,
+			want:   0,
+		},
+		{
+			name: "應以接受時間計算的價格成交",
+			setupFunc: func() {
+				mr.Set("item:1", "1000")
+				setupState()
+			},
+			accept:    now,
+			want:      1,
+			wantPrice: 800,
+		},
+		{
+			name: "價格不會低於最低價",
+			setupFunc: func() {
+				mr.Set("item:1", "1000")
+				setupState()
+			},
+			accept:    now.Add(10 * time.Minute),
+			want:      1,
+			wantPrice: 700,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			tt.setupFunc()
+
+			result, price, err := runStatusScript(ctx, client, DutchAcceptScript,
+				[]string{"item:1", "stream:bids", "item:1:state"},
+				data, "3600", tt.accept.UnixMilli(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+
+			streams, err := client.XRange(ctx, "stream:bids", "-", "+").Result()
+			assert.NoError(t, err)
+			if result != 1 {
+				assert.Empty(t, streams)
+				return
+			}
+			// 成交價格應和dutchPrice的計算結果一致，且拍賣被標記為結標
+			assert.Equal(t, tt.wantPrice, price)
+			assert.Equal(t, dutchPrice(auction, tt.accept), price)
+			assert.Equal(t, strconv.FormatUint(uint64(price), 10), client.Get(ctx, "item:1").Val())
+			assert.Equal(t, "1", client.HGet(ctx, "item:1:state", "closed").Val())
+			if assert.Equal(t, 1, len(streams)) {
+				parsed, err := parseBidInfo(streams[0].Values)
+				assert.NoError(t, err)
+				assert.Equal(t, price, parsed.Amount)
+			}
+		})
+	}
+}
+
+func TestSealedBidScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		Amount:    150,
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+
+	tests := []struct {
+		name      string
+		setupFunc func()
+		amount    models.Money
+		want      int
+		wantBids  string
+	}{
+		{
+			name:      "商品不存在時應返回-1",
+			setupFunc: func() {},
+			amount:    150,
+			want:      -1,
+		},
+		{
+			name: "拍賣已結標時應返回-2",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "closed", "1", "sealed_minimum", "100")
+			},
+			amount: 150,
+			want:   -2,
+		},
+		{
+			name: "低於起標價時應返回0",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "sealed_minimum", "100")
+			},
+			amount: 99,
+			want:   0,
+		},
+		{
+			name: "低於其他出價時仍應接受",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "sealed_minimum", "100", "sealed_bids", "3")
+			},
+			amount:   150,
+			want:     1,
+			wantBids: "4",
+		},
+		{
+			name: "剛好等於起標價時應接受",
+			setupFunc: func() {
+				mr.Set("item:1", "100")
+				mr.HSet("item:1:state", "end_time", endTime, "sealed_minimum", "100")
+			},
+			amount:   100,
+			want:     1,
+			wantBids: "1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			tt.setupFunc()
+
+			result, minimum, err := runStatusScript(ctx, client, SealedBidScript,
+				[]string{"item:1", "stream:bids", "item:1:state"},
+				data, "3600", now.UnixMilli(), int64(tt.amount),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+
+			streams, err := client.XRange(ctx, "stream:bids", "-", "+").Result()
+			assert.NoError(t, err)
+			if result != 1 {
+				assert.Empty(t, streams)
+				return
+			}
+			// 密封出價不應改變最高出價
+			assert.Equal(t, models.Money(100), minimum)
+			assert.Equal(t, "100", client.Get(ctx, "item:1").Val())
+			assert.Equal(t, tt.wantBids, client.HGet(ctx, "item:1:state", "sealed_bids").Val())
+			if assert.Equal(t, 1, len(streams)) {
+				parsed, err := parseBidInfo(streams[0].Values)
+				assert.NoError(t, err)
+				assert.True(t, parsed.Sealed)
+				compareBidInfo(t, bidInfo, parsed)
+			}
+		})
+	}
+}
+
+func TestBidScriptBuyNowWithdrawn(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	endTime := strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10)
+	bidInfo := BidInfo{
+		ItemID:    uuid.New(),
+		User:      BidInfoUser{ID: uuid.New(), Name: "TestUser"},
+		CreatedAt: now,
+		Currency:  models.DefaultCurrency,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	assert.NoError(t, err)
+	data := base64.StdEncoding.EncodeToString(bidInfoBytes)
+
+	tests := []struct {
+		name       string
+		bid        string
+		wantResult int
+		wantBuyNow bool
+	}{
+		{
+			name:       "未超過門檻時應保留直購價",
+			bid:        "250",
+			wantResult: 1,
+			wantBuyNow: true,
+		},
+		{
+			name:       "超過門檻時應撤下直購價",
+			bid:        "251",
+			wantResult: 1 | BidFlagBuyNowWithdrawn,
+			wantBuyNow: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			mr.Set("item:1", "100")
+			mr.HSet("item:1:state", "end_time", endTime, "buy_now_price", "500", "buy_now_threshold", "250")
+
+			result, _, _, err := runBidScript(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:proxy"},
+				tt.bid, data, "3600", now.UnixMilli(), tt.bid, bidInfo.User.ID.String(),
+			)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantResult, result)
+			assert.Equal(t, tt.wantBuyNow, client.HExists(ctx, "item:1:state", "buy_now_price").Val())
+		})
+	}
+}
+
+func TestLoadAuctionScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+
+	t.Run("鍵不存在時應寫入出價和狀態", func(t *testing.T) {
+		mr.FlushAll()
+		err := LoadAuctionScript.Run(ctx, client, []string{"item:1", "item:1:state"}, "100", "3600", "end_time", "1000").Err()
+		assert.NoError(t, err)
+		assert.Equal(t, "100", client.Get(ctx, "item:1").Val())
+		assert.Equal(t, "1000", client.HGet(ctx, "item:1:state", "end_time").Val())
+		assert.True(t, client.TTL(ctx, "item:1:state").Val() > 0)
+	})
+
+	t.Run("已存在的出價和狀態不應被覆寫", func(t *testing.T) {
+		mr.FlushAll()
+		mr.Set("item:1", "200")
+		mr.HSet("item:1:state", "end_time", "1000", "closed", "1")
+		err := LoadAuctionScript.Run(ctx, client, []string{"item:1", "item:1:state"}, "100", "3600", "end_time", "2000").Err()
+		assert.NoError(t, err)
+		assert.Equal(t, "200", client.Get(ctx, "item:1").Val())
+		assert.Equal(t, "1000", client.HGet(ctx, "item:1:state", "end_time").Val())
+		assert.Equal(t, "1", client.HGet(ctx, "item:1:state", "closed").Val())
+	})
+}
+
+func TestLoadProxyBidScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+
+	t.Run("鍵不存在時應寫入代理出價", func(t *testing.T) {
+		mr.FlushAll()
+		result, err := LoadProxyBidScript.Run(ctx, client, []string{"item:1:proxy"}, "3600", "user-1", "300", "data").Int()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.Equal(t, map[string]string{"user": "user-1", "max": "300", "data": "data"}, client.HGetAll(ctx, "item:1:proxy").Val())
+		assert.True(t, client.TTL(ctx, "item:1:proxy").Val() > 0)
+	})
+
+	t.Run("已存在的代理出價不應被覆寫", func(t *testing.T) {
+		mr.FlushAll()
+		mr.HSet("item:1:proxy", "user", "user-2", "max", "500", "data", "other")
+		result, err := LoadProxyBidScript.Run(ctx, client, []string{"item:1:proxy"}, "3600", "user-1", "300", "data").Int()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result)
+		assert.Equal(t, "user-2", client.HGet(ctx, "item:1:proxy", "user").Val())
+	})
+}
+
+func TestMultiUnitBidScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Now()
+	mr.Set("item:1", "100")
+	mr.HSet("item:1:state", "end_time", strconv.FormatInt(now.Add(time.Hour).UnixMilli(), 10), "quantity", "3", "multi_minimum", "100")
+
+	// 依序執行的出價，每一步都依賴前一步的結果
+	steps := []struct {
+		name         string
+		user         string
+		amount       models.Money
+		quantity     uint32
+		want         []int64
+		wantRanking  []string
+		wantClearing string
+	}{
+		{
+			name:         "數量未被完全覆蓋時以起標價出價",
+			user:         "A",
+			amount:       100,
+			quantity:     2,
+			want:         []int64{1, 100, 100, 2},
+			wantRanking:  []string{"A"},
+			wantClearing: "100",
+		},
+		{
+			name:         "低於起標價應被拒絕",
+			user:         "B",
+			amount:       99,
+			quantity:     1,
+			want:         []int64{0, 100, 0, 0},
+			wantRanking:  []string{"A"},
+			wantClearing: "100",
+		},
+		{
+			name:         "數量被完全覆蓋後下一次出價需高於統一成交價",
+			user:         "B",
+			amount:       120,
+			quantity:     1,
+			want:         []int64{1, 101, 100, 3},
+			wantRanking:  []string{"B", "A"},
+			wantClearing: "100",
+		},
+		{
+			name:         "不高於統一成交價應被拒絕",
+			user:         "C",
+			amount:       100,
+			quantity:     1,
+			want:         []int64{0, 101, 0, 0},
+			wantRanking:  []string{"B", "A"},
+			wantClearing: "100",
+		},
+		{
+			name:         "沒有分配到數量的出價應被移除",
+			user:         "C",
+			amount:       101,
+			quantity:     2,
+			want:         []int64{1, 102, 101, 3},
+			wantRanking:  []string{"B", "C"},
+			wantClearing: "101",
+		},
+		{
+			name:         "新的出價不能低於自己先前的出價",
+			user:         "B",
+			amount:       110,
+			quantity:     1,
+			want:         []int64{0, 121, 0, 0},
+			wantRanking:  []string{"B", "C"},
+			wantClearing: "101",
+		},
+		{
+			name:         "新的數量不能低於自己先前的數量",
+			user:         "C",
+			amount:       130,
+			quantity:     1,
+			want:         []int64{-3, 0, 0, 0},
+			wantRanking:  []string{"B", "C"},
+			wantClearing: "101",
+		},
+		{
+			name:         "數量不能超過拍賣數量",
+			user:         "D",
+			amount:       130,
+			quantity:     4,
+			want:         []int64{-3, 0, 0, 0},
+			wantRanking:  []string{"B", "C"},
+			wantClearing: "101",
+		},
+		{
+			name:         "提高出價時應取代自己先前的出價",
+			user:         "C",
+			amount:       130,
+			quantity:     2,
+			want:         []int64{1, 121, 120, 3},
+			wantRanking:  []string{"C", "B"},
+			wantClearing: "120",
+		},
+	}
+
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			result, err := MultiUnitBidScript.Run(ctx, client,
+				[]string{"item:1", "stream:bids", "item:1:state", "item:1:ranking"},
+				"data", "3600", now.UnixMilli(), int64(step.amount), step.quantity, step.user,
+			).Int64Slice()
+			assert.NoError(t, err)
+			assert.Equal(t, step.want, result)
+
+			members, err := client.ZRevRange(ctx, "item:1:ranking", 0, -1).Result()
+			assert.NoError(t, err)
+			users := lo.Map(members, func(member string, _ int) string {
+				return member[strings.LastIndex(member, ":")+1:]
+			})
+			assert.Equal(t, step.wantRanking, users)
+			assert.Equal(t, step.wantClearing, client.Get(ctx, "item:1").Val())
+		})
+	}
+
+	t.Run("拍賣已結束時應返回-2", func(t *testing.T) {
+		result, err := MultiUnitBidScript.Run(ctx, client,
+			[]string{"item:1", "stream:bids", "item:1:state", "item:1:ranking"},
+			"data", "3600", now.Add(2*time.Hour).UnixMilli(), 200, 1, "D",
+		).Int64Slice()
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{-2, 0, 0, 0}, result)
+	})
+}
+
+func TestLoadRankingScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+
+	t.Run("應依出價時間載入並保留同額時的先後順序", func(t *testing.T) {
+		mr.FlushAll()
+		mr.HSet("item:1:state", "quantity", "3")
+		result, err := LoadRankingScript.Run(ctx, client, []string{"item:1:ranking", "item:1:state"},
+			"3600", 100, 1, "A", 120, 1, "B", 100, 2, "C",
+		).Int()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		members, err := client.ZRevRange(ctx, "item:1:ranking", 0, -1).Result()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"999999999997:1:B", "999999999998:1:A", "999999999996:2:C"}, members)
+		assert.Equal(t, "3", client.HGet(ctx, "item:1:state", "bid_seq").Val())
+	})
+
+	t.Run("已存在的排名不應被覆寫", func(t *testing.T) {
+		mr.FlushAll()
+		mr.ZAdd("item:1:ranking", 100, "999999999998:1:A")
+		result, err := LoadRankingScript.Run(ctx, client, []string{"item:1:ranking", "item:1:state"},
+			"3600", 120, 1, "B",
+		).Int()
+		assert.NoError(t, err)
+		assert.Equal(t, 0, result)
+		assert.Equal(t, []string{"999999999998:1:A"}, client.ZRevRange(ctx, "item:1:ranking", 0, -1).Val())
+	})
+}
+
+func TestCloseAuctionScript(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+
+	client := redis.NewClient(&redis.Options{
+		Addr: mr.Addr(),
+	})
+	defer client.Close()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		setupFunc     func()
+		now           string
+		want          bool
+		wantClosed    bool
+		wantLastBidID string
+	}{
+		{
+			name:       "狀態不存在時應直接標記為結標",
+			setupFunc:  func() {},
+			now:        "1000",
+			want:       true,
+			wantClosed: true,
+		},
+		{
+			name: "已到結束時間時應標記為結標",
+			setupFunc: func() {
+				mr.HSet("item:1:state", "end_time", "1000")
+			},
+			now:        "1000",
+			want:       true,
+			wantClosed: true,
+		},
+		{
+			name: "已被直購結標時應直接返回1",
+			setupFunc: func() {
+				mr.HSet("item:1:state", "end_time", "2000", "closed", "1")
+			},
+			now:        "1000",
+			want:       true,
+			wantClosed: true,
+		},
+		{
+			name: "應返回最後一筆出價的stream ID",
+			setupFunc: func() {
+				mr.HSet("item:1:state", "end_time", "1000", "last_bid_id", "1700000000000-3")
+			},
+			now:           "1000",
+			want:          true,
+			wantClosed:    true,
+			wantLastBidID: "1700000000000-3",
+		},
+		{
+			name: "未到結束時間時應返回0",
+			setupFunc: func() {
+				mr.HSet("item:1:state", "end_time", "2000")
+			},
+			now:        "1000",
+			want:       false,
+			wantClosed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			tt.setupFunc()
+
+			result, lastBidID, err := runCloseAuctionScript(ctx, client, []string{"item:1:state"}, tt.now, "3600")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+			assert.Equal(t, tt.wantLastBidID, lastBidID)
+
+			closed := client.HGet(ctx, "item:1:state", "closed").Val()
+			assert.Equal(t, tt.wantClosed, closed == "1")
+		})
+	}
+}
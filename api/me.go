@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// List my auction items
+// (GET /me/auctions)
+func (impl *ServerImpl) GetMeAuctions(ctx context.Context, request openapi.GetMeAuctionsRequestObject) (openapi.GetMeAuctionsResponseObject, error) {
+	const op = "GetMeAuctions"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeAuctions401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeAuctions401Response{}, nil
+	}
+	// 使用和拍賣物品列表相同的篩選、排序和分頁，只列出自己建立的拍賣物品
+	params := myAuctionsListParams(&request.Params)
+	now := time.Now()
+	query, message, err := impl.auctionListQuery(ctx, &params, now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to build query, err=%w", op, err)
+	}
+	if message != "" {
+		return openapi.GetMeAuctions400JSONResponse{
+			Message: lo.ToPtr(message),
+		}, nil
+	}
+	var auctions []models.AuctionItem
+	if result := query.Where("auction_items.user_id = ?", uuid.MustParse(token.Subject)).Find(&auctions); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list auction items, err=%w", op, result.Error)
+	}
+	output, err := impl.auctionListItems(ctx, auctions, lo.FromPtr(params.Search), now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to convert auction items, err=%w", op, err)
+	}
+	return openapi.GetMeAuctions200JSONResponse{
+		Count: len(output),
+		Items: output,
+	}, nil
+}
+
+// List auctions I have bid on
+// (GET /me/bids)
+func (impl *ServerImpl) GetMeBids(ctx context.Context, request openapi.GetMeBidsRequestObject) (openapi.GetMeBidsResponseObject, error) {
+	const op = "GetMeBids"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeBids401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeBids401Response{}, nil
+	}
+	// 使用和拍賣物品列表相同的篩選、排序和分頁，只列出自己出價過的拍賣物品
+	userID := uuid.MustParse(token.Subject)
+	params := myBidsListParams(&request.Params)
+	now := time.Now()
+	query, message, err := impl.auctionListQuery(ctx, &params, now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to build query, err=%w", op, err)
+	}
+	if message != "" {
+		return openapi.GetMeBids400JSONResponse{
+			Message: lo.ToPtr(message),
+		}, nil
+	}
+	var auctions []models.AuctionItem
+	if result := query.
+		Where("auction_items.id IN (?)", impl.db.Model(&models.Bid{}).Select("auction_item_id").Where("user_id = ?", userID)).
+		Find(&auctions); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list auction items, err=%w", op, result.Error)
+	}
+	items, err := impl.auctionListItems(ctx, auctions, lo.FromPtr(params.Search), now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to convert auction items, err=%w", op, err)
+	}
+	// 查詢出價紀錄來判斷出價狀態
+	//  - 多數量拍賣需要所有出價者的出價才能計算數量的分配
+	bids := map[uuid.UUID][]models.Bid{}
+	if len(auctions) > 0 {
+		ids := lo.Map(auctions, func(auction models.AuctionItem, _ int) uuid.UUID {
+			return auction.ID
+		})
+		multiUnitIDs := lo.FilterMap(auctions, func(auction models.AuctionItem, _ int) (uuid.UUID, bool) {
+			return auction.ID, auction.IsMultiUnit()
+		})
+		var rows []models.Bid
+		if result := impl.db.WithContext(ctx).
+			Where("auction_item_id IN ?", ids).
+			Where("(user_id = ? OR auction_item_id IN ?)", userID, multiUnitIDs).
+			Order("created_at").
+			Find(&rows); result.Error != nil {
+			return nil, fmt.Errorf("[%s] Fail to find bids, err=%w", op, result.Error)
+		}
+		bids = lo.GroupBy(rows, func(bid models.Bid) uuid.UUID {
+			return bid.AuctionItemID
+		})
+	}
+	output := make([]openapi.MyBidItem, len(auctions))
+	for i := range auctions {
+		item := items[i]
+		output[i] = openapi.MyBidItem{
+			BidStatus:  bidStatus(&auctions[i], userID, bids[auctions[i].ID]),
+			Currency:   item.Currency,
+			CurrentBid: item.CurrentBid,
+			EndTime:    item.EndTime,
+			Id:         item.Id,
+			IsEnded:    item.IsEnded,
+			MyBid:      myHighestBid(userID, bids[auctions[i].ID]),
+			ReserveMet: item.ReserveMet,
+			Snippet:    item.Snippet,
+			StartTime:  item.StartTime,
+			Status:     item.Status,
+			Title:      item.Title,
+		}
+	}
+	return openapi.GetMeBids200JSONResponse{
+		Count: len(output),
+		Items: output,
+	}, nil
+}
+
+// bidStatus 依拍賣目前的狀態判斷使用者出價的狀態
+//   - 密封出價拍賣在結標前不公開出價，狀態為pending
+//   - 單一數量的拍賣以目前最高出價(結標後為得標出價)是否為自己的出價判斷
+//   - 多數量拍賣以自己是否分配到數量判斷
+//
+// NOTE: bids需要包含使用者在此拍賣的所有出價，多數量拍賣還需要包含其他出價者的出價
+func bidStatus(auction *models.AuctionItem, userID uuid.UUID, bids []models.Bid) openapi.BidStatus {
+	var allocated bool
+	if auction.IsMultiUnit() {
+		allocated = lo.ContainsBy(allocateUnits(auction.Quantity, bids), func(allocation UnitAllocation) bool {
+			return allocation.Bid.UserID == userID
+		})
+	} else {
+		leading := lo.Ternary(auction.Status == models.AuctionStatusSold, auction.WinningBidID, auction.CurrentBidID)
+		allocated = leading != nil && lo.ContainsBy(bids, func(bid models.Bid) bool {
+			return bid.ID == *leading && bid.UserID == userID
+		})
+	}
+	switch auction.Status {
+	case models.AuctionStatusActive:
+		if auction.Type.IsSealed() {
+			return openapi.Pending
+		}
+		return lo.Ternary(allocated, openapi.Winning, openapi.Outbid)
+	case models.AuctionStatusSold:
+		return lo.Ternary(allocated, openapi.Won, openapi.Lost)
+	default:
+		return openapi.Lost
+	}
+}
+
+// myHighestBid 返回使用者在出價紀錄中的最高出價
+func myHighestBid(userID uuid.UUID, bids []models.Bid) models.Money {
+	var highest models.Money
+	for _, bid := range bids {
+		if bid.UserID == userID {
+			highest = max(highest, bid.Amount)
+		}
+	}
+	return highest
+}
+
+// myAuctionsListParams 將我的拍賣物品的參數轉換為拍賣物品列表的參數
+func myAuctionsListParams(params *openapi.GetMeAuctionsParams) openapi.GetAuctionItemsParams {
+	return openapi.GetAuctionItemsParams{
+		Title:        params.Title,
+		Search:       params.Search,
+		StartPrice:   params.StartPrice,
+		CurrentBid:   params.CurrentBid,
+		StartTime:    params.StartTime,
+		EndTime:      params.EndTime,
+		Sort:         params.Sort,
+		LastItemID:   params.LastItemID,
+		Size:         params.Size,
+		ExcludeEnded: params.ExcludeEnded,
+		Category:     params.Category,
+		Tags:         params.Tags,
+		TagMatch:     params.TagMatch,
+	}
+}
+
+// myBidsListParams 將我的出價的參數轉換為拍賣物品列表的參數
+func myBidsListParams(params *openapi.GetMeBidsParams) openapi.GetAuctionItemsParams {
+	return openapi.GetAuctionItemsParams{
+		Title:        params.Title,
+		Search:       params.Search,
+		StartPrice:   params.StartPrice,
+		CurrentBid:   params.CurrentBid,
+		StartTime:    params.StartTime,
+		EndTime:      params.EndTime,
+		Sort:         params.Sort,
+		LastItemID:   params.LastItemID,
+		Size:         params.Size,
+		ExcludeEnded: params.ExcludeEnded,
+		Category:     params.Category,
+		Tags:         params.Tags,
+		TagMatch:     params.TagMatch,
+	}
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+func TestBidStatus(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	me, other := uuid.New(), uuid.New()
+	bid := func(user uuid.UUID, amount models.Money, quantity uint32, seconds int) models.Bid {
+		b := models.Bid{ID: uuid.New(), UserID: user, Amount: amount, Quantity: quantity}
+		b.CreatedAt = start.Add(time.Duration(seconds) * time.Second)
+		return b
+	}
+	myBid, otherBid := bid(me, 100, 1, 0), bid(other, 120, 1, 1)
+	newAuction := func(modify func(auction *models.AuctionItem)) *models.AuctionItem {
+		auction := &models.AuctionItem{
+			StartingPrice: 50,
+			Status:        models.AuctionStatusActive,
+			Type:          models.AuctionTypeEnglish,
+			Quantity:      1,
+		}
+		modify(auction)
+		return auction
+	}
+
+	tests := []struct {
+		name    string
+		auction *models.AuctionItem
+		bids    []models.Bid
+		want    openapi.BidStatus
+	}{
+		{
+			name: "目前最高出價是自己的出價",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.CurrentBidID = &myBid.ID
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Winning,
+		},
+		{
+			name: "目前最高出價是其他人的出價",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.CurrentBidID = &otherBid.ID
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Outbid,
+		},
+		{
+			name: "密封出價拍賣在結標前不公開",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeSealedFirst
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Pending,
+		},
+		{
+			name: "得標出價是自己的出價",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Status = models.AuctionStatusSold
+				auction.CurrentBidID = &myBid.ID
+				auction.WinningBidID = &myBid.ID
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Won,
+		},
+		{
+			name: "得標出價是其他人的出價",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Status = models.AuctionStatusSold
+				auction.CurrentBidID = &otherBid.ID
+				auction.WinningBidID = &otherBid.ID
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Lost,
+		},
+		{
+			name: "未達底價流標",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Status = models.AuctionStatusUnsold
+				auction.CurrentBidID = &myBid.ID
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Lost,
+		},
+		{
+			name: "拍賣已取消",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Status = models.AuctionStatusCancelled
+				auction.CurrentBidID = &myBid.ID
+			}),
+			bids: []models.Bid{myBid},
+			want: openapi.Lost,
+		},
+		{
+			name: "多數量拍賣分配到數量",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Quantity = 2
+				auction.CurrentBidID = &myBid.ID
+			}),
+			bids: []models.Bid{myBid, otherBid},
+			want: openapi.Winning,
+		},
+		{
+			name: "多數量拍賣沒有分配到數量",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Quantity = 2
+			}),
+			bids: []models.Bid{myBid, bid(other, 120, 2, 1)},
+			want: openapi.Outbid,
+		},
+		{
+			name: "多數量拍賣結標後分配到數量",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Quantity = 2
+				auction.Status = models.AuctionStatusSold
+				auction.WinningBidID = &otherBid.ID
+			}),
+			bids: []models.Bid{myBid, otherBid},
+			want: openapi.Won,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, bidStatus(tt.auction, me, tt.bids))
+		})
+	}
+}
+
+func TestMyHighestBid(t *testing.T) {
+	me, other := uuid.New(), uuid.New()
+	bids := []models.Bid{
+		{UserID: me, Amount: 100},
+		{UserID: other, Amount: 300},
+		{UserID: me, Amount: 200},
+	}
+	assert.Equal(t, models.Money(200), myHighestBid(me, bids))
+	assert.Equal(t, models.Money(0), myHighestBid(uuid.New(), bids))
+}
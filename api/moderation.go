@@ -0,0 +1,535 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+)
+
+const (
+	// 檢舉說明、審核備註和停權原因的最大長度(字元數)
+	maxModerationTextLength = 1000
+	// 審核案件每頁預設返回的數量
+	defaultModerationCasePageSize = 20
+	// 審核案件每頁最多返回的數量
+	maxModerationCasePageSize = 100
+)
+
+// errAlreadyReported 使用者已經檢舉過同一個拍賣物品
+var errAlreadyReported = errors.New("already reported")
+
+// Report an auction item
+// (POST /auction/item/{itemID}/report)
+func (impl *ServerImpl) PostAuctionItemItemIDReport(ctx context.Context, request openapi.PostAuctionItemItemIDReportRequestObject) (openapi.PostAuctionItemItemIDReportResponseObject, error) {
+	const op = "PostAuctionItemItemIDReport"
+	// 檢查檢舉內容是否合法
+	reason := models.ReportReason(request.Body.Reason)
+	if !reason.Valid() {
+		return openapi.PostAuctionItemItemIDReport400JSONResponse{
+			Message: lo.ToPtr("Invalid reason"),
+		}, nil
+	}
+	details := strings.TrimSpace(lo.FromPtr(request.Body.Details))
+	if utf8.RuneCountInString(details) > maxModerationTextLength {
+		return openapi.PostAuctionItemItemIDReport400JSONResponse{
+			Message: lo.ToPtr("Details too long"),
+		}, nil
+	}
+	if reason == models.ReportReasonOther && details == "" {
+		return openapi.PostAuctionItemItemIDReport400JSONResponse{
+			Message: lo.ToPtr("Details are required for other reasons"),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDReport401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDReport401Response{}, nil
+	}
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDReport404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 賣家不能檢舉自己的拍賣
+	userID := uuid.MustParse(token.Subject)
+	if auction.UserID == userID {
+		return openapi.PostAuctionItemItemIDReport403Response{}, nil
+	}
+	// 將檢舉加入拍賣物品的審核案件，已被駁回的案件會重新開啟
+	now := time.Now()
+	report := models.Report{
+		AuctionItemID: auction.ID,
+		ReporterID:    userID,
+		Reason:        reason,
+		Details:       impl.htmlChecker.Sanitize(details),
+	}
+	if err := impl.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		moderationCase := models.ModerationCase{
+			AuctionItemID:  auction.ID,
+			Status:         models.ModerationCaseOpen,
+			ReportCount:    1,
+			LastReportedAt: now,
+		}
+		if result := tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "auction_item_id"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"status":           models.ModerationCaseOpen,
+				"report_count":     gorm.Expr("moderation_cases.report_count + 1"),
+				"last_reported_at": now,
+				"updated_at":       now,
+			}),
+		}).Create(&moderationCase); result.Error != nil {
+			return fmt.Errorf("fail to save moderation case, err=%w", result.Error)
+		}
+		report.ModerationCaseID = moderationCase.ID
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&report)
+		if result.Error != nil {
+			return fmt.Errorf("fail to save report, err=%w", result.Error)
+		}
+		// 已經檢舉過時撤銷案件的更新
+		if result.RowsAffected == 0 {
+			return errAlreadyReported
+		}
+		return nil
+	}); err != nil {
+		if errors.Is(err, errAlreadyReported) {
+			return openapi.PostAuctionItemItemIDReport409JSONResponse{
+				Message: lo.ToPtr("Item already reported"),
+			}, nil
+		}
+		return nil, fmt.Errorf("[%s] %w", op, err)
+	}
+	slog.Info("Auction item reported", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()), slog.String("reason", string(reason)))
+	report.Reporter = models.User{ID: userID, Username: token.Username}
+	return openapi.PostAuctionItemItemIDReport201JSONResponse(reportToAPI(report, 0)), nil
+}
+
+// List moderation cases
+// (GET /moderation/cases)
+func (impl *ServerImpl) GetModerationCases(ctx context.Context, request openapi.GetModerationCasesRequestObject) (openapi.GetModerationCasesResponseObject, error) {
+	const op = "GetModerationCases"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultModerationCasePageSize))
+	if size == 0 || size > maxModerationCasePageSize {
+		return openapi.GetModerationCases400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	caseStatus := models.ModerationCaseStatus(lo.FromPtrOr(request.Params.Status, openapi.ModerationCaseOpen))
+	if caseStatus != models.ModerationCaseOpen && caseStatus != models.ModerationCaseActioned && caseStatus != models.ModerationCaseDismissed {
+		return openapi.GetModerationCases400JSONResponse{
+			Message: lo.ToPtr("Invalid status"),
+		}, nil
+	}
+	// 檢查使用者是否為審核員或管理員
+	_, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.GetModerationCases401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.GetModerationCases403Response{}, nil
+	}
+	// 依開啟的順序查詢案件，被隱藏的拍賣物品也需要載入
+	query := impl.db.WithContext(ctx).
+		Preload("AuctionItem", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Preload("AuctionItem.User").
+		Preload("Reports", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at").Order("id")
+		}).
+		Preload("Reports.Reporter").
+		Preload("ResolvedBy").
+		Where("status = ?", caseStatus).
+		Order("created_at").
+		Order("id").
+		Limit(size)
+	//  - cursor
+	if request.Params.LastCaseID != nil {
+		last := models.ModerationCase{ID: *request.Params.LastCaseID}
+		if result := impl.db.WithContext(ctx).First(&last); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return openapi.GetModerationCases400JSONResponse{
+					Message: lo.ToPtr("Last case not found"),
+				}, nil
+			}
+			return nil, fmt.Errorf("[%s] Fail to find last case, err=%w", op, result.Error)
+		}
+		query = query.Where("(created_at > ? OR created_at = ? AND id > ?)", last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	var cases []models.ModerationCase
+	if result := query.Find(&cases); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list moderation cases, err=%w", op, result.Error)
+	}
+	return openapi.GetModerationCases200JSONResponse{
+		Count: len(cases),
+		Items: lo.Map(cases, moderationCaseToAPI),
+	}, nil
+}
+
+// Dismiss a moderation case
+// (POST /moderation/cases/{caseID}/dismiss)
+func (impl *ServerImpl) PostModerationCasesCaseIDDismiss(ctx context.Context, request openapi.PostModerationCasesCaseIDDismissRequestObject) (openapi.PostModerationCasesCaseIDDismissResponseObject, error) {
+	const op = "PostModerationCasesCaseIDDismiss"
+	note := strings.TrimSpace(lo.FromPtr(request.Body.Note))
+	if utf8.RuneCountInString(note) > maxModerationTextLength {
+		return openapi.PostModerationCasesCaseIDDismiss400JSONResponse{
+			Message: lo.ToPtr("Note too long"),
+		}, nil
+	}
+	// 檢查使用者是否為審核員或管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostModerationCasesCaseIDDismiss401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostModerationCasesCaseIDDismiss403Response{}, nil
+	}
+	// 檢查案件是否存在
+	moderationCase := models.ModerationCase{ID: request.CaseID}
+	if result := impl.db.WithContext(ctx).First(&moderationCase); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostModerationCasesCaseIDDismiss404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find moderation case, err=%w", op, result.Error)
+	}
+	// 只更新開啟中的案件，避免覆蓋同時進行的處理
+	result := impl.db.WithContext(ctx).
+		Model(&models.ModerationCase{}).
+		Where("id = ? AND status = ?", moderationCase.ID, models.ModerationCaseOpen).
+		Updates(moderationCaseResolution(models.ModerationCaseDismissed, uuid.MustParse(token.Subject), note, time.Now()))
+	if result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to dismiss moderation case, err=%w", op, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return openapi.PostModerationCasesCaseIDDismiss409JSONResponse{
+			Message: lo.ToPtr("Case is not open"),
+		}, nil
+	}
+	slog.Info("Moderation case dismissed", slog.String("user", token.Subject), slog.String("caseID", moderationCase.ID.String()))
+	return openapi.PostModerationCasesCaseIDDismiss204Response{}, nil
+}
+
+// Hide an auction item
+// (POST /moderation/auction/item/{itemID}/hide)
+func (impl *ServerImpl) PostModerationAuctionItemItemIDHide(ctx context.Context, request openapi.PostModerationAuctionItemItemIDHideRequestObject) (openapi.PostModerationAuctionItemItemIDHideResponseObject, error) {
+	const op = "PostModerationAuctionItemItemIDHide"
+	note := strings.TrimSpace(lo.FromPtr(request.Body.Note))
+	if utf8.RuneCountInString(note) > maxModerationTextLength {
+		return openapi.PostModerationAuctionItemItemIDHide400JSONResponse{
+			Message: lo.ToPtr("Note too long"),
+		}, nil
+	}
+	// 檢查使用者是否為審核員或管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostModerationAuctionItemItemIDHide401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostModerationAuctionItemItemIDHide403Response{}, nil
+	}
+	// 檢查拍賣物品是否存在，已隱藏或已取消的拍賣物品不需要再隱藏
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostModerationAuctionItemItemIDHide404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	hidden, cancelled, err := impl.hideAuction(ctx, auction.ID, uuid.MustParse(token.Subject), note)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to hide auction, err=%w", op, err)
+	}
+	if !hidden {
+		return openapi.PostModerationAuctionItemItemIDHide404Response{}, nil
+	}
+	slog.Info("Auction hidden", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()), slog.Bool("cancelled", cancelled))
+	if err := impl.unindexSuggestTitle(ctx, auction.Title); err != nil {
+		slog.Error("Fail to unindex auction title", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+	}
+	// 競價中的拍賣被取消，通知SSE訂閱者並結束串流
+	if cancelled {
+		event := openapi.AuctionEndedEvent{
+			Status: openapi.AuctionStatus(models.AuctionStatusCancelled),
+			Time:   time.Now(),
+		}
+		if err := impl.publishAuctionEvent(auction.ID, AuctionEventEnded, event); err != nil {
+			slog.Error("Fail to publish ended event", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+		}
+	}
+	return openapi.PostModerationAuctionItemItemIDHide204Response{}, nil
+}
+
+// Void the bids of a hidden auction item
+// (POST /moderation/auction/item/{itemID}/void-bids)
+func (impl *ServerImpl) PostModerationAuctionItemItemIDVoidBids(ctx context.Context, request openapi.PostModerationAuctionItemItemIDVoidBidsRequestObject) (openapi.PostModerationAuctionItemItemIDVoidBidsResponseObject, error) {
+	const op = "PostModerationAuctionItemItemIDVoidBids"
+	// 檢查使用者是否為審核員或管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostModerationAuctionItemItemIDVoidBids401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostModerationAuctionItemItemIDVoidBids403Response{}, nil
+	}
+	// 檢查拍賣物品是否存在且已被隱藏
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).Unscoped().First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostModerationAuctionItemItemIDVoidBids404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	if auction.HiddenAt == nil {
+		return openapi.PostModerationAuctionItemItemIDVoidBids409JSONResponse{
+			Message: lo.ToPtr("Auction is not hidden"),
+		}, nil
+	}
+	// 作廢所有出價並清除得標結果
+	var voided int64
+	if err := impl.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("auction_item_id = ?", auction.ID).Delete(&models.Bid{})
+		if result.Error != nil {
+			return fmt.Errorf("fail to void bids, err=%w", result.Error)
+		}
+		voided = result.RowsAffected
+		if result := tx.Unscoped().Model(&models.AuctionItem{}).Where("id = ?", auction.ID).Updates(map[string]any{
+			"status":         models.AuctionStatusCancelled,
+			"current_bid_id": nil,
+			"winning_bid_id": nil,
+			"final_price":    nil,
+		}); result.Error != nil {
+			return fmt.Errorf("fail to clear auction result, err=%w", result.Error)
+		}
+		return voidFeedbacks(tx, auction.ID)
+	}); err != nil {
+		return nil, fmt.Errorf("[%s] %w", op, err)
+	}
+	// 作廢的出價不應再被代理出價或多數量拍賣的得標出價引用
+	if err := impl.redisClient.Del(ctx, impl.auctionKey(auction.ID, "proxy"), impl.auctionKey(auction.ID, "ranking")).Err(); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to delete voided bids in Redis, err=%w", op, err)
+	}
+	slog.Info("Bids voided", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()), slog.Int64("voided", voided))
+	return openapi.PostModerationAuctionItemItemIDVoidBids200JSONResponse{Voided: int(voided)}, nil
+}
+
+// voidFeedbacks 刪除拍賣的評價，並從收到評價的使用者的評價統計中扣除
+func voidFeedbacks(tx *gorm.DB, itemID uuid.UUID) error {
+	var feedbacks []models.Feedback
+	if result := tx.Where("auction_item_id = ?", itemID).Find(&feedbacks); result.Error != nil {
+		return fmt.Errorf("fail to find feedbacks, err=%w", result.Error)
+	}
+	for _, feedback := range feedbacks {
+		if result := tx.Delete(&models.Feedback{}, "id = ?", feedback.ID); result.Error != nil {
+			return fmt.Errorf("fail to delete feedback, err=%w", result.Error)
+		}
+		if result := tx.Model(&models.User{}).Where("id = ?", feedback.ToUserID).Updates(map[string]any{
+			"rating_count": gorm.Expr("rating_count - 1"),
+			"rating_sum":   gorm.Expr("rating_sum - ?", feedback.Rating),
+		}); result.Error != nil {
+			return fmt.Errorf("fail to update reputation, err=%w", result.Error)
+		}
+	}
+	return nil
+}
+
+// Ban a user
+// (POST /moderation/users/{username}/ban)
+func (impl *ServerImpl) PostModerationUsersUsernameBan(ctx context.Context, request openapi.PostModerationUsersUsernameBanRequestObject) (openapi.PostModerationUsersUsernameBanResponseObject, error) {
+	const op = "PostModerationUsersUsernameBan"
+	reason := strings.TrimSpace(request.Body.Reason)
+	if reason == "" || utf8.RuneCountInString(reason) > maxModerationTextLength {
+		return openapi.PostModerationUsersUsernameBan400JSONResponse{
+			Message: lo.ToPtr("Invalid reason"),
+		}, nil
+	}
+	// 檢查使用者是否為審核員或管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostModerationUsersUsernameBan401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostModerationUsersUsernameBan403Response{}, nil
+	}
+	if request.Username == token.Username {
+		return openapi.PostModerationUsersUsernameBan400JSONResponse{
+			Message: lo.ToPtr("Cannot ban yourself"),
+		}, nil
+	}
+	result := impl.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("username = ?", request.Username).
+		Updates(map[string]any{
+			"banned_at":  time.Now(),
+			"ban_reason": reason,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to ban user, err=%w", op, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return openapi.PostModerationUsersUsernameBan404Response{}, nil
+	}
+	slog.Info("User banned", slog.String("user", token.Subject), slog.String("username", request.Username))
+	return openapi.PostModerationUsersUsernameBan204Response{}, nil
+}
+
+// Lift the ban of a user
+// (DELETE /moderation/users/{username}/ban)
+func (impl *ServerImpl) DeleteModerationUsersUsernameBan(ctx context.Context, request openapi.DeleteModerationUsersUsernameBanRequestObject) (openapi.DeleteModerationUsersUsernameBanResponseObject, error) {
+	const op = "DeleteModerationUsersUsernameBan"
+	// 檢查使用者是否為審核員或管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.DeleteModerationUsersUsernameBan401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.DeleteModerationUsersUsernameBan403Response{}, nil
+	}
+	result := impl.db.WithContext(ctx).
+		Model(&models.User{}).
+		Where("username = ?", request.Username).
+		Updates(map[string]any{
+			"banned_at":  nil,
+			"ban_reason": "",
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to lift ban, err=%w", op, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return openapi.DeleteModerationUsersUsernameBan404Response{}, nil
+	}
+	slog.Info("User ban lifted", slog.String("user", token.Subject), slog.String("username", request.Username))
+	return openapi.DeleteModerationUsersUsernameBan204Response{}, nil
+}
+
+// hideAuction 隱藏拍賣物品，返回是否有隱藏以及競價中的拍賣是否被取消
+//   - 競價中的拍賣改為已取消
+//   - 記錄隱藏時間並透過soft delete刪除，讓拍賣物品從所有查詢中消失
+//   - 將開啟中的審核案件標記為已處理
+//   - 資料庫更新成功後在Redis中標記為已結標，之後的出價都會被拒絕
+func (impl *ServerImpl) hideAuction(ctx context.Context, itemID uuid.UUID, moderatorID uuid.UUID, note string) (bool, bool, error) {
+	lockKey := fmt.Sprintf("%sauction:%s:lock", impl.config.Redis.KeyPrefix, itemID)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return false, false, fmt.Errorf("fail to acquire bid lock, err=%w", err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			slog.Warn("Fail to release bid lock", slog.String("auctionID", itemID.String()), slog.Any("error", err))
+		}
+	}()
+
+	now := time.Now()
+	hidden, cancelled := false, false
+	err = impl.db.WithContext(lockCtx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.AuctionItem{}).
+			Where("id = ? AND status = ?", itemID, models.AuctionStatusActive).
+			Update("status", models.AuctionStatusCancelled)
+		if result.Error != nil {
+			return result.Error
+		}
+		cancelled = result.RowsAffected > 0
+		result = tx.Model(&models.AuctionItem{}).
+			Where("id = ?", itemID).
+			Updates(map[string]any{
+				"hidden_at":  now,
+				"deleted_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		hidden = true
+		return tx.Model(&models.ModerationCase{}).
+			Where("auction_item_id = ? AND status = ?", itemID, models.ModerationCaseOpen).
+			Updates(moderationCaseResolution(models.ModerationCaseActioned, moderatorID, note, now)).Error
+	})
+	if err != nil {
+		return false, false, fmt.Errorf("fail to hide auction item, err=%w", err)
+	}
+	if hidden {
+		if err := impl.closeAuctionInRedis(lockCtx, itemID); err != nil {
+			return false, false, err
+		}
+	}
+	return hidden, hidden && cancelled, nil
+}
+
+// userBanned 檢查使用者是否已被停權
+func (impl *ServerImpl) userBanned(ctx context.Context, userID uuid.UUID) (bool, error) {
+	var count int64
+	if result := impl.db.WithContext(ctx).Model(&models.User{}).Where("id = ? AND banned_at IS NOT NULL", userID).Count(&count); result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// moderationCaseResolution 返回結束審核案件時需要更新的欄位
+func moderationCaseResolution(status models.ModerationCaseStatus, moderatorID uuid.UUID, note string, now time.Time) map[string]any {
+	return map[string]any{
+		"status":         status,
+		"resolved_by_id": moderatorID,
+		"resolved_at":    now,
+		"note":           note,
+	}
+}
+
+// reportToAPI 轉換檢舉
+// NOTE: report需要預先載入Reporter
+func reportToAPI(report models.Report, _ int) openapi.Report {
+	return openapi.Report{
+		Id:       report.ID,
+		ItemId:   report.AuctionItemID,
+		Reporter: report.Reporter.Username,
+		Reason:   openapi.ReportReason(report.Reason),
+		Details:  report.Details,
+		Time:     report.CreatedAt,
+	}
+}
+
+// moderationCaseToAPI 轉換審核案件
+// NOTE: moderationCase需要預先載入AuctionItem.User、Reports.Reporter和ResolvedBy
+func moderationCaseToAPI(moderationCase models.ModerationCase, _ int) openapi.ModerationCase {
+	output := openapi.ModerationCase{
+		Id:             moderationCase.ID,
+		ItemId:         moderationCase.AuctionItemID,
+		ItemTitle:      moderationCase.AuctionItem.Title,
+		Seller:         moderationCase.AuctionItem.User.Username,
+		Hidden:         moderationCase.AuctionItem.HiddenAt != nil,
+		Status:         openapi.ModerationCaseStatus(moderationCase.Status),
+		ReportCount:    moderationCase.ReportCount,
+		LastReportedAt: moderationCase.LastReportedAt,
+		Reports:        lo.Map(moderationCase.Reports, reportToAPI),
+		ResolvedAt:     moderationCase.ResolvedAt,
+		Note:           moderationCase.Note,
+	}
+	if moderationCase.ResolvedBy != nil {
+		output.ResolvedBy = &moderationCase.ResolvedBy.Username
+	}
+	return output
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+func TestModerationCaseToAPI(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	itemID, reportID := uuid.New(), uuid.New()
+	report := models.Report{
+		ID:            reportID,
+		AuctionItemID: itemID,
+		Reason:        models.ReportReasonCounterfeit,
+		Details:       "fake logo",
+		Reporter:      models.User{Username: "reporter"},
+	}
+	report.CreatedAt = now
+	wantReport := openapi.Report{
+		Id:       reportID,
+		ItemId:   itemID,
+		Reporter: "reporter",
+		Reason:   openapi.Counterfeit,
+		Details:  "fake logo",
+		Time:     now,
+	}
+
+	tests := []struct {
+		name           string
+		moderationCase models.ModerationCase
+		wantHidden     bool
+		wantResolvedBy *string
+	}{
+		{
+			name: "開啟中的案件",
+			moderationCase: models.ModerationCase{
+				Status:      models.ModerationCaseOpen,
+				AuctionItem: models.AuctionItem{ID: itemID, Title: "Watch", User: models.User{Username: "seller"}},
+			},
+		},
+		{
+			name: "已隱藏的拍賣物品",
+			moderationCase: models.ModerationCase{
+				Status:      models.ModerationCaseActioned,
+				AuctionItem: models.AuctionItem{ID: itemID, Title: "Watch", User: models.User{Username: "seller"}, HiddenAt: &now},
+				ResolvedBy:  &models.User{Username: "admin"},
+				ResolvedAt:  &now,
+				Note:        "counterfeit",
+			},
+			wantHidden:     true,
+			wantResolvedBy: lo.ToPtr("admin"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.moderationCase.AuctionItemID = itemID
+			tt.moderationCase.ReportCount = 1
+			tt.moderationCase.LastReportedAt = now
+			tt.moderationCase.Reports = []models.Report{report}
+			got := moderationCaseToAPI(tt.moderationCase, 0)
+			assert.Equal(t, "Watch", got.ItemTitle)
+			assert.Equal(t, "seller", got.Seller)
+			assert.Equal(t, tt.wantHidden, got.Hidden)
+			assert.Equal(t, openapi.ModerationCaseStatus(tt.moderationCase.Status), got.Status)
+			assert.Equal(t, tt.wantResolvedBy, got.ResolvedBy)
+			assert.Equal(t, tt.moderationCase.ResolvedAt, got.ResolvedAt)
+			assert.Equal(t, tt.moderationCase.Note, got.Note)
+			assert.Equal(t, []openapi.Report{wantReport}, got.Reports)
+		})
+	}
+}
@@ -0,0 +1,229 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// UnitAllocation 代表多數量拍賣中分配給一筆出價的數量
+type UnitAllocation struct {
+	Bid      models.Bid
+	Quantity uint32
+}
+
+// placeMultiUnitBid 處理多數量拍賣的出價
+//   - 出價會取代出價者先前的出價，金額和數量都不能低於先前的出價
+//   - 所有數量都被覆蓋後，出價必須高於統一成交價
+//   - 不支援代理出價
+func (impl *ServerImpl) placeMultiUnitBid(ctx context.Context, auction *models.AuctionItem, token *openapi.JWT, body *openapi.PostAuctionItemItemIDBidsJSONRequestBody) (openapi.PostAuctionItemItemIDBidsResponseObject, error) {
+	const op = "placeMultiUnitBid"
+	if body.MaxBid != nil {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Multi-quantity auction does not support proxy bidding"),
+		}, nil
+	}
+	quantity := lo.FromPtrOr(body.Quantity, 1)
+	if quantity == 0 || quantity > auction.Quantity {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Invalid quantity"),
+		}, nil
+	}
+
+	lockCtx, unlock, err := impl.lockBid(ctx, auction.ID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] %w", op, err)
+	}
+	defer unlock()
+
+	bidInfo := BidInfo{
+		ItemID:    auction.ID,
+		User:      impl.bidInfoUser(ctx, token),
+		Amount:    body.Bid,
+		CreatedAt: time.Now(),
+		Currency:  auction.Currency,
+		Quantity:  quantity,
+	}
+	bidInfoBytes, err := msgpack.Marshal(bidInfo)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to marshal bid info, err=%w", op, err)
+	}
+	keys := []string{
+		impl.auctionKey(auction.ID),
+		impl.config.Redis.StreamKeys.BidStream,
+		impl.auctionKey(auction.ID, "state"),
+		impl.auctionKey(auction.ID, "ranking"),
+	}
+	args := []any{base64.StdEncoding.EncodeToString(bidInfoBytes), impl.config.Redis.ExpireTime.Seconds(), bidInfo.CreatedAt.UnixMilli(), int64(body.Bid), quantity, token.Subject}
+	result, err := MultiUnitBidScript.Run(lockCtx, impl.redisClient, keys, args...).Int64Slice()
+	if err == nil && len(result) > 0 && result[0] == -1 {
+		// Redis中沒有拍賣資訊時，從資料庫載入後再試一次
+		if err := impl.loadAuctionToRedis(lockCtx, auction); err != nil {
+			return nil, fmt.Errorf("[%s] Fail to load auction into Redis, err=%w", op, err)
+		}
+		result, err = MultiUnitBidScript.Run(lockCtx, impl.redisClient, keys, args...).Int64Slice()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to place bid, err=%w", op, err)
+	}
+	if len(result) != 4 {
+		return nil, fmt.Errorf("[%s] Invalid script result: %v", op, result)
+	}
+
+	status, minimumBid := result[0], models.Money(result[1])
+	if status == -2 {
+		return openapi.PostAuctionItemItemIDBids410JSONResponse{}, nil
+	} else if status == -3 {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Invalid quantity"),
+		}, nil
+	} else if status == 0 {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message:    lo.ToPtr("Bid too low"),
+			MinimumBid: lo.ToPtr(minimumBid),
+		}, nil
+	} else if status != 1 {
+		return nil, fmt.Errorf("[%s] Invalid script return value: %d", op, status)
+	}
+	slog.Info("Multi-quantity bid placed", slog.String("user", token.Subject), slog.Int64("bid", int64(body.Bid)), slog.Int64("quantity", int64(quantity)), slog.String("auctionID", auction.ID.String()))
+	if err := impl.publishAuctionEvent(auction.ID, AuctionEventClearing, openapi.AuctionClearingEvent{
+		ClearingPrice:  models.Money(result[2]),
+		ContestedUnits: uint32(result[3]),
+		Quantity:       auction.Quantity,
+	}); err != nil {
+		slog.Error("Fail to publish clearing event", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+	}
+	return openapi.PostAuctionItemItemIDBids200Response{}, nil
+}
+
+// multiUnitState 將多數量拍賣的設定轉換為MultiUnitBidScript使用的狀態欄位，單一數量的拍賣返回nil
+func multiUnitState(auction *models.AuctionItem) []any {
+	if !auction.IsMultiUnit() {
+		return nil
+	}
+	return []any{"quantity", auction.Quantity, "multi_minimum", int64(auction.StartingPrice)}
+}
+
+// loadRankingToRedis 將資料庫中多數量拍賣每位出價者最新的出價寫入Redis的 sorted set
+func (impl *ServerImpl) loadRankingToRedis(ctx context.Context, auction *models.AuctionItem) error {
+	if !auction.IsMultiUnit() {
+		return nil
+	}
+	var bids []models.Bid
+	if result := impl.db.WithContext(ctx).Where("auction_item_id = ?", auction.ID).Find(&bids); result.Error != nil {
+		return fmt.Errorf("fail to find bids, err=%w", result.Error)
+	}
+	args := []any{impl.config.Redis.ExpireTime.Seconds()}
+	for _, bid := range latestBidPerUser(bids) {
+		args = append(args, int64(bid.Amount), bid.Quantity, bid.UserID.String())
+	}
+	rankingKey := impl.auctionKey(auction.ID, "ranking")
+	stateKey := impl.auctionKey(auction.ID, "state")
+	return LoadRankingScript.Run(ctx, impl.redisClient, []string{rankingKey, stateKey}, args...).Err()
+}
+
+// syncMultiUnitBid 儲存多數量拍賣的出價，並將最高出價更新為決定統一成交價的出價
+func (impl *ServerImpl) syncMultiUnitBid(ctx context.Context, auction *models.AuctionItem, record *models.Bid) error {
+	if result := impl.db.WithContext(ctx).Create(record); result.Error != nil {
+		return fmt.Errorf("fail to save bid, err=%w", result.Error)
+	}
+	allocations, err := impl.allocateAuctionUnits(ctx, auction)
+	if err != nil {
+		return err
+	}
+	if len(allocations) == 0 {
+		return nil
+	}
+	clearingBid := allocations[len(allocations)-1].Bid
+	if result := impl.db.WithContext(ctx).Model(&models.AuctionItem{ID: auction.ID}).Update("current_bid_id", clearingBid.ID); result.Error != nil {
+		return fmt.Errorf("fail to update clearing bid, err=%w", result.Error)
+	}
+	return nil
+}
+
+// clearMultiUnitBids 在結標時決定多數量拍賣的得標出價，返回排名最高的出價和統一成交價
+// 呼叫前需要先等待所有出價都同步回資料庫
+func (impl *ServerImpl) clearMultiUnitBids(ctx context.Context, auction *models.AuctionItem) (*models.Bid, models.Money, error) {
+	allocations, err := impl.allocateAuctionUnits(ctx, auction)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(allocations) == 0 {
+		return nil, 0, nil
+	}
+	return &allocations[0].Bid, allocations[len(allocations)-1].Bid.Amount, nil
+}
+
+// allocateAuctionUnits 從資料庫讀取多數量拍賣的出價並分配數量
+func (impl *ServerImpl) allocateAuctionUnits(ctx context.Context, auction *models.AuctionItem) ([]UnitAllocation, error) {
+	var bids []models.Bid
+	if result := impl.db.WithContext(ctx).Preload("User").Where("auction_item_id = ?", auction.ID).Find(&bids); result.Error != nil {
+		return nil, fmt.Errorf("fail to find bids, err=%w", result.Error)
+	}
+	return allocateUnits(auction.Quantity, bids), nil
+}
+
+// allocateUnits 依出價金額由高到低分配數量，計算方式和MultiUnitBidScript相同
+//   - 每位出價者只計算最新的一筆出價
+//   - 同額時先出價者優先
+//   - 最後一筆分配到數量的出價可能只分配到部分數量，它的金額就是統一成交價
+func allocateUnits(quantity uint32, bids []models.Bid) []UnitAllocation {
+	ranked := latestBidPerUser(bids)
+	slices.SortStableFunc(ranked, func(a, b models.Bid) int {
+		return cmp.Compare(b.Amount, a.Amount)
+	})
+	var allocations []UnitAllocation
+	remaining := quantity
+	for _, bid := range ranked {
+		if remaining == 0 {
+			break
+		}
+		allocated := min(remaining, max(bid.Quantity, 1))
+		allocations = append(allocations, UnitAllocation{Bid: bid, Quantity: allocated})
+		remaining -= allocated
+	}
+	return allocations
+}
+
+// latestBidPerUser 返回每位出價者最新的一筆出價，並依出價時間排序
+func latestBidPerUser(bids []models.Bid) []models.Bid {
+	sorted := slices.Clone(bids)
+	slices.SortStableFunc(sorted, func(a, b models.Bid) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	latest := make(map[uuid.UUID]int, len(sorted))
+	for i, bid := range sorted {
+		latest[bid.UserID] = i
+	}
+	return lo.Filter(sorted, func(bid models.Bid, i int) bool {
+		return latest[bid.UserID] == i
+	})
+}
+
+// winningBidsToAPI 轉換多數量拍賣目前分配到數量的出價，單一數量的拍賣返回nil
+func winningBidsToAPI(auction *models.AuctionItem, bids []models.Bid) *[]openapi.WinningBid {
+	if !auction.IsMultiUnit() {
+		return nil
+	}
+	winningBids := lo.Map(allocateUnits(auction.Quantity, bids), func(allocation UnitAllocation, _ int) openapi.WinningBid {
+		return openapi.WinningBid{
+			Bid:            allocation.Bid.Amount,
+			Quantity:       allocation.Quantity,
+			User:           allocation.Bid.User.Username,
+			UserReputation: lo.ToPtr(reputationToAPI(allocation.Bid.User.Reputation)),
+			Time:           allocation.Bid.CreatedAt,
+		}
+	})
+	return &winningBids
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+)
+
+func TestAllocateUnits(t *testing.T) {
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	bid := func(user uuid.UUID, amount models.Money, quantity uint32, seconds int) models.Bid {
+		b := models.Bid{ID: uuid.New(), UserID: user, Amount: amount, Quantity: quantity}
+		b.CreatedAt = start.Add(time.Duration(seconds) * time.Second)
+		return b
+	}
+
+	tests := []struct {
+		name          string
+		quantity      uint32
+		bids          []models.Bid
+		wantAmounts   []models.Money
+		wantQuantity  []uint32
+		wantClearing  models.Money
+		wantNoWinners bool
+	}{
+		{
+			name:          "沒有出價時沒有得標者",
+			quantity:      3,
+			bids:          nil,
+			wantNoWinners: true,
+		},
+		{
+			name:         "數量未被完全覆蓋時所有出價都得標",
+			quantity:     3,
+			bids:         []models.Bid{bid(alice, 100, 1, 0), bid(bob, 150, 1, 1)},
+			wantAmounts:  []models.Money{150, 100},
+			wantQuantity: []uint32{1, 1},
+			wantClearing: 100,
+		},
+		{
+			name:         "最後一筆得標出價只分配到剩餘數量",
+			quantity:     3,
+			bids:         []models.Bid{bid(alice, 100, 2, 0), bid(bob, 120, 1, 1), bid(carol, 110, 2, 2)},
+			wantAmounts:  []models.Money{120, 110},
+			wantQuantity: []uint32{1, 2},
+			wantClearing: 110,
+		},
+		{
+			name:         "每位出價者只計算最新的出價",
+			quantity:     2,
+			bids:         []models.Bid{bid(alice, 100, 1, 0), bid(bob, 110, 1, 1), bid(alice, 130, 2, 2)},
+			wantAmounts:  []models.Money{130},
+			wantQuantity: []uint32{2},
+			wantClearing: 130,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocations := allocateUnits(tt.quantity, tt.bids)
+			if tt.wantNoWinners {
+				assert.Empty(t, allocations)
+				return
+			}
+			amounts := make([]models.Money, len(allocations))
+			quantities := make([]uint32, len(allocations))
+			for i, allocation := range allocations {
+				amounts[i] = allocation.Bid.Amount
+				quantities[i] = allocation.Quantity
+			}
+			assert.Equal(t, tt.wantAmounts, amounts)
+			assert.Equal(t, tt.wantQuantity, quantities)
+			assert.Equal(t, tt.wantClearing, allocations[len(allocations)-1].Bid.Amount)
+		})
+	}
+
+	t.Run("同額時先出價者優先得標", func(t *testing.T) {
+		bids := []models.Bid{bid(carol, 100, 1, 2), bid(alice, 100, 1, 0), bid(bob, 100, 1, 1)}
+		allocations := allocateUnits(2, bids)
+		if assert.Len(t, allocations, 2) {
+			assert.Equal(t, alice, allocations[0].Bid.UserID)
+			assert.Equal(t, bob, allocations[1].Bid.UserID)
+		}
+	})
+}
@@ -0,0 +1,429 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+	"q4/notifications"
+)
+
+const (
+	// 通知每頁預設返回的數量
+	defaultNotificationPageSize = 20
+	// 通知每頁最多返回的數量
+	maxNotificationPageSize = 100
+)
+
+// List my notifications
+// (GET /me/notifications)
+func (impl *ServerImpl) GetMeNotifications(ctx context.Context, request openapi.GetMeNotificationsRequestObject) (openapi.GetMeNotificationsResponseObject, error) {
+	const op = "GetMeNotifications"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultNotificationPageSize))
+	if size == 0 || size > maxNotificationPageSize {
+		return openapi.GetMeNotifications400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeNotifications401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeNotifications401Response{}, nil
+	}
+	userID := uuid.MustParse(token.Subject)
+	// 計算未讀的通知數量
+	var unread int64
+	if result := impl.db.WithContext(ctx).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&unread); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to count unread notifications, err=%w", op, result.Error)
+	}
+	// 由新到舊查詢通知，時間相同時依ID排序
+	query := impl.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(size)
+	if lo.FromPtr(request.Params.Unread) {
+		query = query.Where("read_at IS NULL")
+	}
+	//  - cursor
+	if request.Params.LastNotificationID != nil {
+		last := models.Notification{ID: *request.Params.LastNotificationID}
+		if result := impl.db.WithContext(ctx).Where("user_id = ?", userID).First(&last); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return openapi.GetMeNotifications400JSONResponse{
+					Message: lo.ToPtr("Last notification not found"),
+				}, nil
+			}
+			return nil, fmt.Errorf("[%s] Fail to find last notification, err=%w", op, result.Error)
+		}
+		query = query.Where("(created_at < ? OR created_at = ? AND id < ?)", last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	var items []models.Notification
+	if result := query.Find(&items); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list notifications, err=%w", op, result.Error)
+	}
+	return openapi.GetMeNotifications200JSONResponse{
+		Unread: int(unread),
+		Count:  len(items),
+		Items:  lo.Map(items, notificationToAPI),
+	}, nil
+}
+
+// Mark a notification as read
+// (PUT /me/notifications/{notificationID}/read)
+func (impl *ServerImpl) PutMeNotificationsNotificationIDRead(ctx context.Context, request openapi.PutMeNotificationsNotificationIDReadRequestObject) (openapi.PutMeNotificationsNotificationIDReadResponseObject, error) {
+	const op = "PutMeNotificationsNotificationIDRead"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PutMeNotificationsNotificationIDRead401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PutMeNotificationsNotificationIDRead401Response{}, nil
+	}
+	// 只能標記自己的通知，已讀的通知不會更新時間
+	notification := models.Notification{ID: request.NotificationID}
+	if result := impl.db.WithContext(ctx).Where("user_id = ?", token.Subject).First(&notification); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PutMeNotificationsNotificationIDRead404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find notification, err=%w", op, result.Error)
+	}
+	if notification.ReadAt == nil {
+		if result := impl.db.WithContext(ctx).Model(&notification).Update("read_at", time.Now()); result.Error != nil {
+			return nil, fmt.Errorf("[%s] Fail to mark notification as read, err=%w", op, result.Error)
+		}
+	}
+	return openapi.PutMeNotificationsNotificationIDRead204Response{}, nil
+}
+
+// Get my notification preferences
+// (GET /me/notification-preferences)
+func (impl *ServerImpl) GetMeNotificationPreferences(ctx context.Context, request openapi.GetMeNotificationPreferencesRequestObject) (openapi.GetMeNotificationPreferencesResponseObject, error) {
+	const op = "GetMeNotificationPreferences"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeNotificationPreferences401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeNotificationPreferences401Response{}, nil
+	}
+	// 沒有設定時使用預設值
+	var preference models.NotificationPreference
+	if result := impl.db.WithContext(ctx).Where("user_id = ?", token.Subject).Limit(1).Find(&preference); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to find notification preference, err=%w", op, result.Error)
+	}
+	return openapi.GetMeNotificationPreferences200JSONResponse(notificationPreferenceToAPI(preference)), nil
+}
+
+// Update my notification preferences
+// (PUT /me/notification-preferences)
+func (impl *ServerImpl) PutMeNotificationPreferences(ctx context.Context, request openapi.PutMeNotificationPreferencesRequestObject) (openapi.PutMeNotificationPreferencesResponseObject, error) {
+	const op = "PutMeNotificationPreferences"
+	// 檢查通知設定是否合法
+	preference, message := impl.notificationPreferenceFromAPI(*request.Body)
+	if message != "" {
+		return openapi.PutMeNotificationPreferences400JSONResponse{
+			Message: lo.ToPtr(message),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PutMeNotificationPreferences401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PutMeNotificationPreferences401Response{}, nil
+	}
+	// 新增或覆寫通知設定
+	preference.UserID = uuid.MustParse(token.Subject)
+	if result := impl.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "webhook_url", "channels", "updated_at"}),
+	}).Create(&preference); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to save notification preference, err=%w", op, result.Error)
+	}
+	return openapi.PutMeNotificationPreferences200JSONResponse(notificationPreferenceToAPI(preference)), nil
+}
+
+// runOutbidNotificationWorker 從bid stream讀取出價，通知領先出價被超過的使用者，直到ctx被取消
+// 使用獨立的consumer group，不影響出價同步的進度
+func (impl *ServerImpl) runOutbidNotificationWorker(ctx context.Context) {
+	logger := slog.Default().With(slog.String("caller", "OutbidNotification"))
+	ch := impl.notificationConsumer.Subscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			if err := impl.notifyOutbid(ctx, msg.Data); err != nil {
+				logger.Error("Fail to notify outbid user", slog.Any("error", err))
+				if err := msg.Fail(ctx, err); err != nil {
+					logger.Error("Fail to fail message", slog.Any("error", err))
+				}
+				continue
+			}
+			if err := msg.Done(ctx); err != nil {
+				logger.Error("Fail to done message", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// notifyOutbid 通知出價中被超過的前一位領先者，沒有領先者改變的出價不做任何事
+func (impl *ServerImpl) notifyOutbid(ctx context.Context, bidInfo BidInfo) error {
+	if bidInfo.OutbidUserID == nil {
+		return nil
+	}
+	auction := models.AuctionItem{ID: bidInfo.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		// 拍賣已被取消時不需要通知
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("fail to find auction item, err=%w", result.Error)
+	}
+	return impl.notifyUsers(ctx, []uuid.UUID{*bidInfo.OutbidUserID}, notifications.Message{
+		Kind:          models.NotificationKindOutbid,
+		AuctionItemID: &auction.ID,
+		Title:         fmt.Sprintf("You have been outbid on %s", auction.Title),
+		Body:          fmt.Sprintf("The current bid is %s.", bidInfo.Currency.Format(bidInfo.Amount)),
+		Time:          bidInfo.CreatedAt,
+		DedupKey:      fmt.Sprintf("outbid:%s:%d", auction.ID, bidInfo.CreatedAt.UnixMilli()),
+	})
+}
+
+// notifyWinners 通知成交拍賣的得標者
+// NOTE: auction需要預先載入WinningBid.User
+func (impl *ServerImpl) notifyWinners(ctx context.Context, auction *models.AuctionItem) error {
+	buyers, err := impl.auctionBuyers(ctx, auction)
+	if err != nil {
+		return fmt.Errorf("fail to find buyers, err=%w", err)
+	}
+	return impl.notifyUsers(ctx, lo.Map(buyers, func(user models.User, _ int) uuid.UUID { return user.ID }), notifications.Message{
+		Kind:          models.NotificationKindWon,
+		AuctionItemID: &auction.ID,
+		Title:         fmt.Sprintf("You won %s", auction.Title),
+		Body:          fmt.Sprintf("The final price is %s.", auction.Currency.Format(lo.FromPtr(auction.FinalPrice))),
+		Time:          lo.FromPtr(auction.SettledAt),
+		DedupKey:      fmt.Sprintf("won:%s", auction.ID),
+	})
+}
+
+// runEndingSoonNotificationWorker 定期通知即將結束的拍賣的關注者和出價者，直到ctx被取消
+func (impl *ServerImpl) runEndingSoonNotificationWorker(ctx context.Context) {
+	logger := slog.Default().With(slog.String("caller", "EndingSoonNotification"))
+	ticker := time.NewTicker(impl.config.Notification.EndingSoonInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := impl.notifyEndingSoon(ctx); err != nil {
+				logger.Error("Fail to notify ending soon auctions", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// notifyEndingSoon 通知在EndingSoonWindow內結束的拍賣的關注者和出價者(賣家除外)，每個使用者在每個拍賣只會收到一次
+//
+// 流程:
+//   - 1. 取得通知鎖，確保同一時間只有一個實例在檢查
+//   - 2. 查詢即將結束的拍賣
+//   - 3. 查詢每個拍賣的關注者和出價者並送出通知
+func (impl *ServerImpl) notifyEndingSoon(ctx context.Context) error {
+	const op = "notifyEndingSoon"
+	logger := slog.Default().With(slog.String("caller", "EndingSoonNotification"))
+
+	// 取得通知鎖
+	lockKey := fmt.Sprintf("%sending-soon-notification-lock", impl.config.Redis.KeyPrefix)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return fmt.Errorf("[%s] Fail to acquire notification lock, err=%w", op, err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			logger.Warn("Fail to release notification lock", slog.Any("error", err))
+		}
+	}()
+
+	// 查詢即將結束的拍賣
+	now := time.Now()
+	var auctions []models.AuctionItem
+	if result := impl.db.WithContext(lockCtx).
+		Where("status = ? AND end_time > ? AND end_time <= ?", models.AuctionStatusActive, now, now.Add(impl.config.Notification.EndingSoonWindow)).
+		Find(&auctions); result.Error != nil {
+		return fmt.Errorf("[%s] Fail to find ending soon auctions, err=%w", op, result.Error)
+	}
+	for _, auction := range auctions {
+		var watcherIDs, bidderIDs []uuid.UUID
+		if result := impl.db.WithContext(lockCtx).Model(&models.WatchlistItem{}).
+			Where("auction_item_id = ?", auction.ID).
+			Pluck("user_id", &watcherIDs); result.Error != nil {
+			return fmt.Errorf("[%s] Fail to find watchers, err=%w", op, result.Error)
+		}
+		if result := impl.db.WithContext(lockCtx).Model(&models.Bid{}).
+			Where("auction_item_id = ?", auction.ID).
+			Distinct().
+			Pluck("user_id", &bidderIDs); result.Error != nil {
+			return fmt.Errorf("[%s] Fail to find bidders, err=%w", op, result.Error)
+		}
+		userIDs := lo.Without(lo.Uniq(append(watcherIDs, bidderIDs...)), auction.UserID)
+		if err := impl.notifyUsers(lockCtx, userIDs, notifications.Message{
+			Kind:          models.NotificationKindEndingSoon,
+			AuctionItemID: &auction.ID,
+			Title:         fmt.Sprintf("%s is ending soon", auction.Title),
+			Body:          fmt.Sprintf("The auction ends at %s.", auction.EndTime.UTC().Format(time.RFC3339)),
+			Time:          now,
+			DedupKey:      fmt.Sprintf("ending_soon:%s", auction.ID),
+		}); err != nil {
+			logger.Error("Fail to notify ending soon auction", slog.String("itemID", auction.ID.String()), slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+// notifyUsers 依據每個使用者的通知設定送出通知，message.DedupKey會加上使用者ID
+// 單一使用者失敗不影響其他使用者
+func (impl *ServerImpl) notifyUsers(ctx context.Context, userIDs []uuid.UUID, message notifications.Message) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	var users []models.User
+	if result := impl.db.WithContext(ctx).Where("id IN ?", userIDs).Find(&users); result.Error != nil {
+		return fmt.Errorf("fail to find users, err=%w", result.Error)
+	}
+	var preferences []models.NotificationPreference
+	if result := impl.db.WithContext(ctx).Where("user_id IN ?", userIDs).Find(&preferences); result.Error != nil {
+		return fmt.Errorf("fail to find notification preferences, err=%w", result.Error)
+	}
+	preferenceByUser := lo.KeyBy(preferences, func(preference models.NotificationPreference) uuid.UUID {
+		return preference.UserID
+	})
+	var errs []error
+	for _, user := range users {
+		preference := preferenceByUser[user.ID]
+		userMessage := message
+		if userMessage.DedupKey != "" {
+			userMessage.DedupKey = fmt.Sprintf("%s:%s", message.DedupKey, user.ID)
+		}
+		if err := impl.notifier.Dispatch(ctx, notifications.Recipient{
+			UserID:     user.ID,
+			Username:   user.Username,
+			Email:      preference.Email,
+			WebhookURL: preference.WebhookURL,
+		}, preference.ChannelsFor(message.Kind), userMessage); err != nil {
+			errs = append(errs, fmt.Errorf("fail to notify user %s, err=%w", user.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// notificationPreferenceFromAPI 檢查並轉換通知設定，不合法時返回錯誤訊息
+func (impl *ServerImpl) notificationPreferenceFromAPI(body openapi.NotificationPreferences) (models.NotificationPreference, string) {
+	preference := models.NotificationPreference{
+		Email:      strings.TrimSpace(body.Email),
+		WebhookURL: strings.TrimSpace(body.WebhookUrl),
+		Channels:   make(map[models.NotificationKind][]models.NotificationChannel),
+	}
+	if preference.Email != "" {
+		address, err := mail.ParseAddress(preference.Email)
+		if err != nil || address.Name != "" || address.Address != preference.Email || len(preference.Email) > 255 {
+			return models.NotificationPreference{}, "Invalid email"
+		}
+	}
+	if preference.WebhookURL != "" && !impl.validWebhookURL(preference.WebhookURL) {
+		return models.NotificationPreference{}, "Invalid webhook URL"
+	}
+	for kind, channels := range map[models.NotificationKind]*[]openapi.NotificationChannel{
+		models.NotificationKindOutbid:     body.Channels.Outbid,
+		models.NotificationKindWon:        body.Channels.Won,
+		models.NotificationKindEndingSoon: body.Channels.EndingSoon,
+	} {
+		if channels == nil {
+			continue
+		}
+		converted := make([]models.NotificationChannel, 0, len(*channels))
+		for _, channel := range lo.Uniq(*channels) {
+			c := models.NotificationChannel(channel)
+			switch {
+			case !c.Valid():
+				return models.NotificationPreference{}, "Invalid channels"
+			case c == models.NotificationChannelEmail && preference.Email == "":
+				return models.NotificationPreference{}, "Email is required for the email channel"
+			case c == models.NotificationChannelWebhook && preference.WebhookURL == "":
+				return models.NotificationPreference{}, "Webhook URL is required for the webhook channel"
+			}
+			converted = append(converted, c)
+		}
+		preference.Channels[kind] = converted
+	}
+	return preference, ""
+}
+
+// notificationPreferenceToAPI 轉換通知設定，沒有設定的通知種類返回預設的管道
+func notificationPreferenceToAPI(preference models.NotificationPreference) openapi.NotificationPreferences {
+	channels := func(kind models.NotificationKind) *[]openapi.NotificationChannel {
+		return lo.ToPtr(lo.Map(preference.ChannelsFor(kind), func(channel models.NotificationChannel, _ int) openapi.NotificationChannel {
+			return openapi.NotificationChannel(channel)
+		}))
+	}
+	return openapi.NotificationPreferences{
+		Email:      preference.Email,
+		WebhookUrl: preference.WebhookURL,
+		Channels: openapi.NotificationChannels{
+			Outbid:     channels(models.NotificationKindOutbid),
+			Won:        channels(models.NotificationKindWon),
+			EndingSoon: channels(models.NotificationKindEndingSoon),
+		},
+	}
+}
+
+// notificationToAPI 轉換站內通知
+func notificationToAPI(notification models.Notification, _ int) openapi.Notification {
+	return openapi.Notification{
+		Id:     notification.ID,
+		Kind:   openapi.NotificationKind(notification.Kind),
+		ItemId: notification.AuctionItemID,
+		Title:  notification.Title,
+		Body:   notification.Body,
+		Read:   notification.ReadAt != nil,
+		Time:   notification.CreatedAt,
+	}
+}
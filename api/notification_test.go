@@ -0,0 +1,91 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+	"q4/webhooks"
+)
+
+func TestNotificationPreferenceFromAPI(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         openapi.NotificationPreferences
+		wantMessage  string
+		wantChannels map[models.NotificationKind][]models.NotificationChannel
+	}{
+		{
+			name: "沒有設定的通知種類不寫入",
+			body: openapi.NotificationPreferences{
+				Email: "user@example.com",
+				Channels: openapi.NotificationChannels{
+					Outbid: &[]openapi.NotificationChannel{openapi.NotificationChannelEmail, openapi.NotificationChannelInbox, openapi.NotificationChannelEmail},
+					Won:    &[]openapi.NotificationChannel{},
+				},
+			},
+			wantChannels: map[models.NotificationKind][]models.NotificationChannel{
+				models.NotificationKindOutbid: {models.NotificationChannelEmail, models.NotificationChannelInbox},
+				models.NotificationKindWon:    {},
+			},
+		},
+		{
+			name:        "電子郵件格式錯誤",
+			body:        openapi.NotificationPreferences{Email: "Someone <user@example.com>"},
+			wantMessage: "Invalid email",
+		},
+		{
+			name:        "webhook URL只能使用http或https",
+			body:        openapi.NotificationPreferences{WebhookUrl: "ftp://example.com/hook"},
+			wantMessage: "Invalid webhook URL",
+		},
+		{
+			name: "不支援的管道",
+			body: openapi.NotificationPreferences{
+				Channels: openapi.NotificationChannels{EndingSoon: &[]openapi.NotificationChannel{"sms"}},
+			},
+			wantMessage: "Invalid channels",
+		},
+		{
+			name: "使用電子郵件管道時需要電子郵件地址",
+			body: openapi.NotificationPreferences{
+				Channels: openapi.NotificationChannels{Won: &[]openapi.NotificationChannel{openapi.NotificationChannelEmail}},
+			},
+			wantMessage: "Email is required for the email channel",
+		},
+		{
+			name: "使用webhook管道時需要webhook URL",
+			body: openapi.NotificationPreferences{
+				Channels: openapi.NotificationChannels{Won: &[]openapi.NotificationChannel{openapi.NotificationChannelWebhook}},
+			},
+			wantMessage: "Webhook URL is required for the webhook channel",
+		},
+	}
+
+	impl := &ServerImpl{webhookSender: webhooks.NewSender(time.Second, false)}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preference, message := impl.notificationPreferenceFromAPI(tt.body)
+			assert.Equal(t, tt.wantMessage, message)
+			if tt.wantMessage == "" {
+				assert.Equal(t, tt.wantChannels, preference.Channels)
+			}
+		})
+	}
+}
+
+func TestNotificationPreferenceToAPI(t *testing.T) {
+	preference := notificationPreferenceToAPI(models.NotificationPreference{
+		Channels: map[models.NotificationKind][]models.NotificationChannel{
+			models.NotificationKindOutbid: {models.NotificationChannelWebhook},
+		},
+	})
+	assert.Equal(t, lo.ToPtr([]openapi.NotificationChannel{openapi.NotificationChannelWebhook}), preference.Channels.Outbid)
+	// 沒有設定的通知種類只會送出站內通知
+	assert.Equal(t, lo.ToPtr([]openapi.NotificationChannel{openapi.NotificationChannelInbox}), preference.Channels.Won)
+	assert.Equal(t, lo.ToPtr([]openapi.NotificationChannel{openapi.NotificationChannelInbox}), preference.Channels.EndingSoon)
+}
@@ -9,6 +9,8 @@ import (
 
 type JWT struct {
 	Username string `json:"username"`
+	// Roles 登入時依IdP群組取得的角色
+	Roles []string `json:"roles,omitempty"`
 	jwt.RegisteredClaims
 }
 
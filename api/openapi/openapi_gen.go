@@ -17,6 +17,8 @@ import (
 	"strings"
 	"time"
 
+	"q4/models"
+
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
@@ -28,19 +30,122 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for GetAuctionItemsParamsSortKey.
+// Defines values for AuctionSortKey.
+const (
+	CurrentBid AuctionSortKey = "currentBid"
+	EndTime    AuctionSortKey = "endTime"
+	Relevance  AuctionSortKey = "relevance"
+	StartPrice AuctionSortKey = "startPrice"
+	StartTime  AuctionSortKey = "startTime"
+	Title      AuctionSortKey = "title"
+)
+
+// Defines values for AuctionStatus.
+const (
+	Active    AuctionStatus = "active"
+	Cancelled AuctionStatus = "cancelled"
+	Sold      AuctionStatus = "sold"
+	Unsold    AuctionStatus = "unsold"
+)
+
+// Defines values for AuctionType.
+const (
+	Dutch        AuctionType = "dutch"
+	English      AuctionType = "english"
+	SealedFirst  AuctionType = "sealed_first"
+	SealedSecond AuctionType = "sealed_second"
+)
+
+// Defines values for BidIncrementType.
+const (
+	Fixed   BidIncrementType = "fixed"
+	Percent BidIncrementType = "percent"
+	Tiered  BidIncrementType = "tiered"
+)
+
+// Defines values for BidStatus.
+const (
+	Lost    BidStatus = "lost"
+	Outbid  BidStatus = "outbid"
+	Pending BidStatus = "pending"
+	Winning BidStatus = "winning"
+	Won     BidStatus = "won"
+)
+
+// Defines values for Currency.
+const (
+	EUR Currency = "EUR"
+	JPY Currency = "JPY"
+	TWD Currency = "TWD"
+	USD Currency = "USD"
+)
+
+// Defines values for FeedbackRole.
+const (
+	Buyer  FeedbackRole = "buyer"
+	Seller FeedbackRole = "seller"
+)
+
+// Defines values for ModerationCaseStatus.
+const (
+	ModerationCaseActioned  ModerationCaseStatus = "actioned"
+	ModerationCaseDismissed ModerationCaseStatus = "dismissed"
+	ModerationCaseOpen      ModerationCaseStatus = "open"
+)
+
+// Defines values for NotificationChannel.
+const (
+	NotificationChannelEmail   NotificationChannel = "email"
+	NotificationChannelInbox   NotificationChannel = "inbox"
+	NotificationChannelWebhook NotificationChannel = "webhook"
+)
+
+// Defines values for NotificationKind.
+const (
+	NotificationKindEndingSoon NotificationKind = "ending_soon"
+	NotificationKindOutbid     NotificationKind = "outbid"
+	NotificationKindWon        NotificationKind = "won"
+)
+
+// Defines values for ReportReason.
+const (
+	Counterfeit ReportReason = "counterfeit"
+	Fraud       ReportReason = "fraud"
+	Offensive   ReportReason = "offensive"
+	Other       ReportReason = "other"
+	Prohibited  ReportReason = "prohibited"
+)
+
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Defines values for TagMatch.
+const (
+	All TagMatch = "all"
+	Any TagMatch = "any"
+)
+
+// Defines values for WebhookDeliveryStatus.
 const (
-	CurrentBid GetAuctionItemsParamsSortKey = "currentBid"
-	EndTime    GetAuctionItemsParamsSortKey = "endTime"
-	StartPrice GetAuctionItemsParamsSortKey = "startPrice"
-	StartTime  GetAuctionItemsParamsSortKey = "startTime"
-	Title      GetAuctionItemsParamsSortKey = "title"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
 )
 
-// Defines values for GetAuctionItemsParamsSortOrder.
+// Defines values for WebhookEventType.
 const (
-	Asc  GetAuctionItemsParamsSortOrder = "asc"
-	Desc GetAuctionItemsParamsSortOrder = "desc"
+	WebhookEventAuctionEnded    WebhookEventType = "auction.ended"
+	WebhookEventAuctionExtended WebhookEventType = "auction.extended"
+	WebhookEventBidPlaced       WebhookEventType = "bid.placed"
+)
+
+// Defines values for GetMeWatchlistParamsSort.
+const (
+	EndingSoon GetMeWatchlistParamsSort = "endingSoon"
+	Recent     GetMeWatchlistParamsSort = "recent"
 )
 
 // ApiResponse defines model for ApiResponse.
@@ -49,36 +154,802 @@ type ApiResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// AuctionBuyNowEvent defines model for AuctionBuyNowEvent.
+type AuctionBuyNowEvent struct {
+	Available bool `json:"available"`
+}
+
+// AuctionClearingEvent defines model for AuctionClearingEvent.
+type AuctionClearingEvent struct {
+	// ClearingPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	ClearingPrice Money `json:"clearingPrice"`
+
+	// ContestedUnits Number of units currently covered by winning bids. New bids must beat the clearing price once it reaches `quantity`.
+	ContestedUnits uint32 `json:"contestedUnits"`
+	Quantity       uint32 `json:"quantity"`
+}
+
+// AuctionEndedEvent defines model for AuctionEndedEvent.
+type AuctionEndedEvent struct {
+	// FinalPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	FinalPrice *Money        `json:"finalPrice,omitempty"`
+	Status     AuctionStatus `json:"status"`
+	Time       time.Time     `json:"time"`
+	WinningBid *BidEvent     `json:"winningBid,omitempty"`
+}
+
+// AuctionExtendedEvent defines model for AuctionExtendedEvent.
+type AuctionExtendedEvent struct {
+	EndTime time.Time `json:"endTime"`
+}
+
+// AuctionListItem defines model for AuctionListItem.
+type AuctionListItem struct {
+	// Currency ISO 4217 currency code of all amounts of an auction.
+	Currency Currency `json:"currency"`
+
+	// CurrentBid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	CurrentBid Money              `json:"currentBid"`
+	EndTime    time.Time          `json:"endTime"`
+	Id         openapi_types.UUID `json:"id"`
+	IsEnded    bool               `json:"isEnded"`
+
+	// ReserveMet Whether the current bid has reached the hidden reserve price. Omitted when the auction has no reserve price.
+	ReserveMet *bool `json:"reserveMet,omitempty"`
+
+	// Snippet HTML fragment of the description with matched words wrapped in `<mark>`. Only present when `search` is provided.
+	Snippet   *string       `json:"snippet,omitempty"`
+	StartTime time.Time     `json:"startTime"`
+	Status    AuctionStatus `json:"status"`
+	Title     string        `json:"title"`
+}
+
+// AuctionPriceEvent defines model for AuctionPriceEvent.
+type AuctionPriceEvent struct {
+	// Price Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Price Money     `json:"price"`
+	Time  time.Time `json:"time"`
+}
+
+// AuctionReserveEvent defines model for AuctionReserveEvent.
+type AuctionReserveEvent struct {
+	ReserveMet bool `json:"reserveMet"`
+}
+
+// AuctionSortKey The `relevance` key requires `search` and always lists the best matches first.
+type AuctionSortKey string
+
+// AuctionStatus defines model for AuctionStatus.
+type AuctionStatus string
+
+// AuctionType Auction mode.
+//   - english: buyers bid the price up, the highest bid wins.
+//   - dutch: the price drops on a fixed schedule, the first buyer to accept wins.
+//   - sealed_first: bids are hidden until the auction ends, the highest bidder pays their own bid.
+//   - sealed_second: bids are hidden until the auction ends, the highest bidder pays the second-highest bid (Vickrey).
+type AuctionType string
+
 // BidEvent defines model for BidEvent.
 type BidEvent struct {
-	Bid  uint32    `json:"bid"`
-	Time time.Time `json:"time"`
-	User string    `json:"user"`
+	// Bid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Bid Money `json:"bid"`
+
+	// Quantity Number of units requested. Only present in multi-quantity auctions.
+	Quantity *uint32   `json:"quantity,omitempty"`
+	Time     time.Time `json:"time"`
+	User     string    `json:"user"`
+
+	// UserReputation Aggregated ratings a user received from trading partners.
+	UserReputation *Reputation `json:"userReputation,omitempty"`
+}
+
+// BidIncrement Minimum increment rule for the next bid.
+//   - fixed: every bid must raise the price by at least `step`.
+//   - percent: every bid must raise the price by at least `percent` percent of the current price (rounded up).
+//   - tiered: the `step` of the last tier whose `from` is not above the current price applies. Tiers must start from 0 and be sorted by `from`.
+type BidIncrement struct {
+	Percent *uint32 `json:"percent,omitempty"`
+
+	// Step Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Step  *Money              `json:"step,omitempty"`
+	Tiers *[]BidIncrementTier `json:"tiers,omitempty"`
+	Type  BidIncrementType    `json:"type"`
+}
+
+// BidIncrementType defines model for BidIncrement.Type.
+type BidIncrementType string
+
+// BidIncrementTier defines model for BidIncrementTier.
+type BidIncrementTier struct {
+	// From Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	From Money `json:"from"`
+
+	// Step Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Step Money `json:"step"`
+}
+
+// BidRecord defines model for BidRecord.
+type BidRecord struct {
+	// Bid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Bid Money              `json:"bid"`
+	Id  openapi_types.UUID `json:"id"`
+
+	// Quantity Number of units requested. Only present in multi-quantity auctions.
+	Quantity *uint32   `json:"quantity,omitempty"`
+	Time     time.Time `json:"time"`
+	User     string    `json:"user"`
+
+	// UserReputation Aggregated ratings a user received from trading partners.
+	UserReputation *Reputation `json:"userReputation,omitempty"`
+}
+
+// BidStatus Status of the current user's bids on an auction.
+//   - pending: the sealed auction has not ended yet.
+//   - winning: the user currently holds the highest bid, or is allocated units of a multi-quantity auction.
+//   - outbid: another bidder has outbid the user.
+//   - won: the auction was sold to the user.
+//   - lost: the auction was sold to someone else, ended without a sale or was cancelled.
+type BidStatus string
+
+// Category defines model for Category.
+type Category struct {
+	Id   openapi_types.UUID `json:"id"`
+	Name string             `json:"name"`
+
+	// ParentId Parent category. Omitted for root categories.
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+}
+
+// Currency ISO 4217 currency code of all amounts of an auction.
+type Currency string
+
+// DutchSchedule The price starts at the starting price and drops by `decrement` every `interval` seconds until it reaches `floorPrice`.
+type DutchSchedule struct {
+	// Decrement Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Decrement Money `json:"decrement"`
+
+	// FloorPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	FloorPrice Money  `json:"floorPrice"`
+	Interval   uint32 `json:"interval"`
+}
+
+// Feedback defines model for Feedback.
+type Feedback struct {
+	Comment string `json:"comment"`
+
+	// From Username of the user who left the feedback.
+	From   string             `json:"from"`
+	Id     openapi_types.UUID `json:"id"`
+	ItemId openapi_types.UUID `json:"itemId"`
+	Rating uint32             `json:"rating"`
+
+	// Role Role of the rated user in the auction.
+	Role FeedbackRole `json:"role"`
+	Time time.Time    `json:"time"`
+
+	// To Username of the rated user.
+	To string `json:"to"`
+}
+
+// FeedbackRole Role of the rated user in the auction.
+type FeedbackRole string
+
+// ModerationCase defines model for ModerationCase.
+type ModerationCase struct {
+	// Hidden Whether the item is hidden.
+	Hidden         bool               `json:"hidden"`
+	Id             openapi_types.UUID `json:"id"`
+	ItemId         openapi_types.UUID `json:"itemId"`
+	ItemTitle      string             `json:"itemTitle"`
+	LastReportedAt time.Time          `json:"lastReportedAt"`
+
+	// Note Note left by the administrator who resolved the case.
+	Note        string `json:"note"`
+	ReportCount uint32 `json:"reportCount"`
+
+	// Reports Reports of the item, oldest first.
+	Reports    []Report   `json:"reports"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+
+	// ResolvedBy Username of the administrator who resolved the case.
+	ResolvedBy *string `json:"resolvedBy,omitempty"`
+
+	// Seller Username of the seller of the item.
+	Seller string `json:"seller"`
+
+	// Status Status of a moderation case.
+	//   - open: waiting for review.
+	//   - actioned: the auction item was hidden.
+	//   - dismissed: the reports were reviewed and no action was taken.
+	Status ModerationCaseStatus `json:"status"`
+}
+
+// ModerationCaseStatus Status of a moderation case.
+//   - open: waiting for review.
+//   - actioned: the auction item was hidden.
+//   - dismissed: the reports were reviewed and no action was taken.
+type ModerationCaseStatus string
+
+// Money Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+type Money = models.Money
+
+// MyBidItem defines model for MyBidItem.
+type MyBidItem struct {
+	// BidStatus Status of the current user's bids on an auction.
+	//   - pending: the sealed auction has not ended yet.
+	//   - winning: the user currently holds the highest bid, or is allocated units of a multi-quantity auction.
+	//   - outbid: another bidder has outbid the user.
+	//   - won: the auction was sold to the user.
+	//   - lost: the auction was sold to someone else, ended without a sale or was cancelled.
+	BidStatus BidStatus `json:"bidStatus"`
+
+	// Currency ISO 4217 currency code of all amounts of an auction.
+	Currency Currency `json:"currency"`
+
+	// CurrentBid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	CurrentBid Money              `json:"currentBid"`
+	EndTime    time.Time          `json:"endTime"`
+	Id         openapi_types.UUID `json:"id"`
+	IsEnded    bool               `json:"isEnded"`
+
+	// MyBid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	MyBid Money `json:"myBid"`
+
+	// ReserveMet Whether the current bid has reached the hidden reserve price. Omitted when the auction has no reserve price.
+	ReserveMet *bool `json:"reserveMet,omitempty"`
+
+	// Snippet HTML fragment of the description with matched words wrapped in `<mark>`. Only present when `search` is provided.
+	Snippet   *string       `json:"snippet,omitempty"`
+	StartTime time.Time     `json:"startTime"`
+	Status    AuctionStatus `json:"status"`
+	Title     string        `json:"title"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	Body   string              `json:"body"`
+	Id     openapi_types.UUID  `json:"id"`
+	ItemId *openapi_types.UUID `json:"itemId,omitempty"`
+
+	// Kind Kind of a notification.
+	//   - outbid: another user took the lead of an auction the user was leading.
+	//   - won: the user won an auction.
+	//   - ending_soon: an auction the user watches or has bid on is about to end.
+	Kind  NotificationKind `json:"kind"`
+	Read  bool             `json:"read"`
+	Time  time.Time        `json:"time"`
+	Title string           `json:"title"`
+}
+
+// NotificationChannel Channel to deliver a notification.
+//   - inbox: in-app notification listed by `GET /me/notifications`.
+//   - email: email sent to the address in the notification preferences.
+//   - webhook: JSON POST to the webhook URL in the notification preferences.
+type NotificationChannel string
+
+// NotificationChannels Channels to deliver each kind of notification. Kinds that are not set are only delivered to the inbox.
+type NotificationChannels struct {
+	EndingSoon *[]NotificationChannel `json:"ending_soon,omitempty"`
+	Outbid     *[]NotificationChannel `json:"outbid,omitempty"`
+	Won        *[]NotificationChannel `json:"won,omitempty"`
+}
+
+// NotificationKind Kind of a notification.
+//   - outbid: another user took the lead of an auction the user was leading.
+//   - won: the user won an auction.
+//   - ending_soon: an auction the user watches or has bid on is about to end.
+type NotificationKind string
+
+// NotificationPreferences defines model for NotificationPreferences.
+type NotificationPreferences struct {
+	// Channels Channels to deliver each kind of notification. Kinds that are not set are only delivered to the inbox.
+	Channels NotificationChannels `json:"channels"`
+
+	// Email Email address for the email channel. An empty string removes the address.
+	Email string `json:"email"`
+
+	// WebhookUrl HTTP(S) URL for the webhook channel. An empty string removes the URL.
+	WebhookUrl string `json:"webhookUrl"`
+}
+
+// Question defines model for Question.
+type Question struct {
+	// Answer Answer of the seller, empty until the question is answered.
+	Answer     string     `json:"answer"`
+	AnsweredAt *time.Time `json:"answeredAt,omitempty"`
+	AskedAt    time.Time  `json:"askedAt"`
+
+	// Asker Username of the user who asked the question.
+	Asker    string             `json:"asker"`
+	Id       openapi_types.UUID `json:"id"`
+	ItemId   openapi_types.UUID `json:"itemId"`
+	Question string             `json:"question"`
+}
+
+// Report defines model for Report.
+type Report struct {
+	Details string             `json:"details"`
+	Id      openapi_types.UUID `json:"id"`
+	ItemId  openapi_types.UUID `json:"itemId"`
+
+	// Reason Reason of a report.
+	Reason ReportReason `json:"reason"`
+
+	// Reporter Username of the user who reported the item.
+	Reporter string    `json:"reporter"`
+	Time     time.Time `json:"time"`
+}
+
+// ReportReason Reason of a report.
+type ReportReason string
+
+// Reputation Aggregated ratings a user received from trading partners.
+type Reputation struct {
+	// Count Number of ratings received.
+	Count uint32 `json:"count"`
+
+	// Score Average rating from 1 to 5, or 0 when the user has no ratings yet.
+	Score float64 `json:"score"`
+}
+
+// ShillFlag A user suspected of shill bidding, with the signals of the latest detection.
+type ShillFlag struct {
+	// Auctions Number of settled auctions the user bid on.
+	Auctions int `json:"auctions"`
+
+	// FlaggedAt Time of the latest detection.
+	FlaggedAt time.Time          `json:"flaggedAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// PushRatio Share of the auctions where the user rebid after being overtaken within one increment and then lost.
+	PushRatio  float64    `json:"pushRatio"`
+	ReviewedAt *time.Time `json:"reviewedAt,omitempty"`
+
+	// SameSellerRatio Share of the auctions from the seller the user bid on most.
+	SameSellerRatio float64 `json:"sameSellerRatio"`
+
+	// Score Suspicion score between 0 and 1, higher is more suspicious.
+	Score float64 `json:"score"`
+
+	// User Username of the flagged user.
+	User string `json:"user"`
+
+	// Wins Number of auctions the user won.
+	Wins int `json:"wins"`
+}
+
+// SoftClose A bid placed within `window` seconds before the end time pushes the end time to `extension` seconds after the bid.
+type SoftClose struct {
+	Extension uint32 `json:"extension"`
+	Window    uint32 `json:"window"`
+}
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// TagMatch How the `tags` filter is applied.
+//   - any: items with at least one of the tags.
+//   - all: items with every tag.
+type TagMatch string
+
+// WatchlistItem defines model for WatchlistItem.
+type WatchlistItem struct {
+	// Currency ISO 4217 currency code of all amounts of an auction.
+	Currency Currency `json:"currency"`
+
+	// CurrentPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	CurrentPrice Money              `json:"currentPrice"`
+	EndTime      time.Time          `json:"endTime"`
+	Id           openapi_types.UUID `json:"id"`
+	IsEnded      bool               `json:"isEnded"`
+	Status       AuctionStatus      `json:"status"`
+	Title        string             `json:"title"`
+	WatchedAt    time.Time          `json:"watchedAt"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  uint32    `json:"attempts"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Event Type of a webhook event.
+	//   - bid.placed: a bid was placed. Sealed bids are not sent. `data` is a `BidEvent`.
+	//   - auction.ended: the auction was settled. `data` is an `AuctionEndedEvent`.
+	//   - auction.extended: a bid in the soft close window extended the end time. `data` is an `AuctionExtendedEvent`.
+	Event         WebhookEventType   `json:"event"`
+	EventId       openapi_types.UUID `json:"eventId"`
+	Id            openapi_types.UUID `json:"id"`
+	ItemId        openapi_types.UUID `json:"itemId"`
+	LastAttemptAt *time.Time         `json:"lastAttemptAt,omitempty"`
+	LastError     string             `json:"lastError"`
+	NextAttemptAt *time.Time         `json:"nextAttemptAt,omitempty"`
+
+	// RedeliveryOf ID of the delivery this one redelivers.
+	RedeliveryOf *openapi_types.UUID `json:"redeliveryOf,omitempty"`
+
+	// ResponseStatus HTTP status of the last attempt. Missing when no response was received.
+	ResponseStatus *int `json:"responseStatus,omitempty"`
+
+	// Status Status of a webhook delivery.
+	//   - pending: waiting to be sent or retried.
+	//   - succeeded: the endpoint responded with a 2xx status.
+	//   - failed: all attempts failed.
+	Status WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryStatus Status of a webhook delivery.
+//   - pending: waiting to be sent or retried.
+//   - succeeded: the endpoint responded with a 2xx status.
+//   - failed: all attempts failed.
+type WebhookDeliveryStatus string
+
+// WebhookEndpoint defines model for WebhookEndpoint.
+type WebhookEndpoint struct {
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description string             `json:"description"`
+	Events      []WebhookEventType `json:"events"`
+	Id          openapi_types.UUID `json:"id"`
+	Url         string             `json:"url"`
+}
+
+// WebhookEvent Body of a webhook request.
+type WebhookEvent struct {
+	// Data Event content, see `WebhookEventType`.
+	Data interface{} `json:"data"`
+
+	// Id Event ID. Redeliveries of the same event keep the same ID.
+	Id     openapi_types.UUID `json:"id"`
+	ItemId openapi_types.UUID `json:"itemId"`
+	Time   time.Time          `json:"time"`
+
+	// Type Type of a webhook event.
+	//   - bid.placed: a bid was placed. Sealed bids are not sent. `data` is a `BidEvent`.
+	//   - auction.ended: the auction was settled. `data` is an `AuctionEndedEvent`.
+	//   - auction.extended: a bid in the soft close window extended the end time. `data` is an `AuctionExtendedEvent`.
+	Type WebhookEventType `json:"type"`
+}
+
+// WebhookEventType Type of a webhook event.
+//   - bid.placed: a bid was placed. Sealed bids are not sent. `data` is a `BidEvent`.
+//   - auction.ended: the auction was settled. `data` is an `AuctionEndedEvent`.
+//   - auction.extended: a bid in the soft close window extended the end time. `data` is an `AuctionExtendedEvent`.
+type WebhookEventType string
+
+// WinningBid defines model for WinningBid.
+type WinningBid struct {
+	// Bid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Bid Money `json:"bid"`
+
+	// Quantity Number of units allocated to the bid.
+	Quantity uint32    `json:"quantity"`
+	Time     time.Time `json:"time"`
+	User     string    `json:"user"`
+
+	// UserReputation Aggregated ratings a user received from trading partners.
+	UserReputation *Reputation `json:"userReputation,omitempty"`
+}
+
+// AuctionListCategory defines model for AuctionListCategory.
+type AuctionListCategory = openapi_types.UUID
+
+// AuctionListCurrentBid defines model for AuctionListCurrentBid.
+type AuctionListCurrentBid struct {
+	// From Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	From *Money `json:"from,omitempty"`
+
+	// To Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	To *Money `json:"to,omitempty"`
+}
+
+// AuctionListEndTime defines model for AuctionListEndTime.
+type AuctionListEndTime struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+// AuctionListExcludeEnded defines model for AuctionListExcludeEnded.
+type AuctionListExcludeEnded = bool
+
+// AuctionListLastItemID defines model for AuctionListLastItemID.
+type AuctionListLastItemID = openapi_types.UUID
+
+// AuctionListSearch defines model for AuctionListSearch.
+type AuctionListSearch = string
+
+// AuctionListSize defines model for AuctionListSize.
+type AuctionListSize = uint32
+
+// AuctionListSort defines model for AuctionListSort.
+type AuctionListSort struct {
+	// Key The `relevance` key requires `search` and always lists the best matches first.
+	Key   *AuctionSortKey `json:"key,omitempty"`
+	Order *SortOrder      `json:"order,omitempty"`
+}
+
+// AuctionListStartPrice defines model for AuctionListStartPrice.
+type AuctionListStartPrice struct {
+	// From Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	From *Money `json:"from,omitempty"`
+
+	// To Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	To *Money `json:"to,omitempty"`
+}
+
+// AuctionListStartTime defines model for AuctionListStartTime.
+type AuctionListStartTime struct {
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+// AuctionListTagMatch How the `tags` filter is applied.
+//   - any: items with at least one of the tags.
+//   - all: items with every tag.
+type AuctionListTagMatch = TagMatch
+
+// AuctionListTags defines model for AuctionListTags.
+type AuctionListTags = []string
+
+// AuctionListTitle defines model for AuctionListTitle.
+type AuctionListTitle = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// PostAuctionCategoryJSONBody defines parameters for PostAuctionCategory.
+type PostAuctionCategoryJSONBody struct {
+	Name     string              `json:"name"`
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+}
+
+// PostAuctionCategoryParams defines parameters for PostAuctionCategory.
+type PostAuctionCategoryParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// DeleteAuctionCategoryCategoryIDParams defines parameters for DeleteAuctionCategoryCategoryID.
+type DeleteAuctionCategoryCategoryIDParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PatchAuctionCategoryCategoryIDJSONBody defines parameters for PatchAuctionCategoryCategoryID.
+type PatchAuctionCategoryCategoryIDJSONBody struct {
+	Name     *string             `json:"name,omitempty"`
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+}
+
+// PatchAuctionCategoryCategoryIDParams defines parameters for PatchAuctionCategoryCategoryID.
+type PatchAuctionCategoryCategoryIDParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
 }
 
 // PostAuctionItemJSONBody defines parameters for PostAuctionItem.
 type PostAuctionItemJSONBody struct {
-	Carousels     *[]string  `json:"carousels,omitempty"`
-	Description   *string    `json:"description,omitempty"`
-	EndTime       time.Time  `json:"endTime"`
-	StartTime     *time.Time `json:"startTime,omitempty"`
-	StartingPrice *int64     `json:"startingPrice,omitempty"`
-	Title         string     `json:"title"`
+	// BidIncrement Minimum increment rule for the next bid.
+	//   - fixed: every bid must raise the price by at least `step`.
+	//   - percent: every bid must raise the price by at least `percent` percent of the current price (rounded up).
+	//   - tiered: the `step` of the last tier whose `from` is not above the current price applies. Tiers must start from 0 and be sorted by `from`.
+	BidIncrement *BidIncrement `json:"bidIncrement,omitempty"`
+
+	// BuyNowPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	BuyNowPrice *Money              `json:"buyNowPrice,omitempty"`
+	Carousels   *[]string           `json:"carousels,omitempty"`
+	CategoryId  *openapi_types.UUID `json:"categoryId,omitempty"`
+
+	// Currency ISO 4217 currency code of all amounts of an auction.
+	Currency    *Currency `json:"currency,omitempty"`
+	Description *string   `json:"description,omitempty"`
+
+	// Dutch The price starts at the starting price and drops by `decrement` every `interval` seconds until it reaches `floorPrice`.
+	Dutch   *DutchSchedule `json:"dutch,omitempty"`
+	EndTime time.Time      `json:"endTime"`
+
+	// Quantity Number of identical units sold in this listing. Multi-quantity auctions clear at a uniform price, the lowest winning bid.
+	Quantity *uint32 `json:"quantity,omitempty"`
+
+	// ReservePrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	ReservePrice *Money `json:"reservePrice,omitempty"`
+
+	// SoftClose A bid placed within `window` seconds before the end time pushes the end time to `extension` seconds after the bid.
+	SoftClose *SoftClose `json:"softClose,omitempty"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// StartingPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	StartingPrice *Money `json:"startingPrice,omitempty"`
+
+	// Tags Free-form tags. Tags are trimmed and lowercased, duplicates are removed.
+	Tags  *[]string `json:"tags,omitempty"`
+	Title string    `json:"title"`
+
+	// Type Auction mode.
+	//   - english: buyers bid the price up, the highest bid wins.
+	//   - dutch: the price drops on a fixed schedule, the first buyer to accept wins.
+	//   - sealed_first: bids are hidden until the auction ends, the highest bidder pays their own bid.
+	//   - sealed_second: bids are hidden until the auction ends, the highest bidder pays the second-highest bid (Vickrey).
+	Type *AuctionType `json:"type,omitempty"`
 }
 
 // PostAuctionItemParams defines parameters for PostAuctionItem.
 type PostAuctionItemParams struct {
+	// IdempotencyKey Unique key chosen by the client for this request. Retrying with the same key returns the original response instead of performing the action again.
+	// Keys are scoped to the current user and remembered for a limited time. Reusing a key with a different request body is rejected.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// DeleteAuctionItemItemIDParams defines parameters for DeleteAuctionItemItemID.
+type DeleteAuctionItemItemIDParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetAuctionItemItemIDParams defines parameters for GetAuctionItemItemID.
+type GetAuctionItemItemIDParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PatchAuctionItemItemIDJSONBody defines parameters for PatchAuctionItemItemID.
+type PatchAuctionItemItemIDJSONBody struct {
+	// BidIncrement Minimum increment rule for the next bid.
+	//   - fixed: every bid must raise the price by at least `step`.
+	//   - percent: every bid must raise the price by at least `percent` percent of the current price (rounded up).
+	//   - tiered: the `step` of the last tier whose `from` is not above the current price applies. Tiers must start from 0 and be sorted by `from`.
+	BidIncrement *BidIncrement `json:"bidIncrement,omitempty"`
+
+	// BuyNowPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	BuyNowPrice *Money              `json:"buyNowPrice,omitempty"`
+	Carousels   *[]string           `json:"carousels,omitempty"`
+	CategoryId  *openapi_types.UUID `json:"categoryId,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	EndTime     *time.Time          `json:"endTime,omitempty"`
+
+	// ReservePrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	ReservePrice *Money `json:"reservePrice,omitempty"`
+
+	// SoftClose A bid placed within `window` seconds before the end time pushes the end time to `extension` seconds after the bid.
+	SoftClose *SoftClose `json:"softClose,omitempty"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+
+	// StartingPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	StartingPrice *Money    `json:"startingPrice,omitempty"`
+	Tags          *[]string `json:"tags,omitempty"`
+	Title         *string   `json:"title,omitempty"`
+}
+
+// PatchAuctionItemItemIDParams defines parameters for PatchAuctionItemItemID.
+type PatchAuctionItemItemIDParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDAcceptParams defines parameters for PostAuctionItemItemIDAccept.
+type PostAuctionItemItemIDAcceptParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetAuctionItemItemIDBidsParams defines parameters for GetAuctionItemItemIDBids.
+type GetAuctionItemItemIDBidsParams struct {
+	// LastBidID The last bid ID of the previous page.
+	LastBidID *openapi_types.UUID `form:"lastBidID,omitempty" json:"lastBidID,omitempty"`
+
+	// Size The maximum number of bids to return.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+
 	// AccessToken access token for current user.
 	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
 }
 
 // PostAuctionItemItemIDBidsJSONBody defines parameters for PostAuctionItemItemIDBids.
 type PostAuctionItemItemIDBidsJSONBody struct {
-	Bid uint32 `json:"bid"`
+	// Bid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Bid Money `json:"bid"`
+
+	// MaxBid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	MaxBid *Money `json:"maxBid,omitempty"`
+
+	// Quantity Number of units requested in a multi-quantity auction. A new bid replaces the bidder's previous bid.
+	Quantity *uint32 `json:"quantity,omitempty"`
 }
 
 // PostAuctionItemItemIDBidsParams defines parameters for PostAuctionItemItemIDBids.
 type PostAuctionItemItemIDBidsParams struct {
+	// IdempotencyKey Unique key chosen by the client for this request. Retrying with the same key returns the original response instead of performing the action again.
+	// Keys are scoped to the current user and remembered for a limited time. Reusing a key with a different request body is rejected.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDBuyNowParams defines parameters for PostAuctionItemItemIDBuyNow.
+type PostAuctionItemItemIDBuyNowParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDFeedbackJSONBody defines parameters for PostAuctionItemItemIDFeedback.
+type PostAuctionItemItemIDFeedbackJSONBody struct {
+	// Buyer Username of the winner to rate. Only used by the seller, and required when a multi-quantity auction has more than one winner.
+	Buyer   *string `json:"buyer,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+	Rating  uint32  `json:"rating"`
+}
+
+// PostAuctionItemItemIDFeedbackParams defines parameters for PostAuctionItemItemIDFeedback.
+type PostAuctionItemItemIDFeedbackParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetAuctionItemItemIDQuestionsParams defines parameters for GetAuctionItemItemIDQuestions.
+type GetAuctionItemItemIDQuestionsParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDQuestionsJSONBody defines parameters for PostAuctionItemItemIDQuestions.
+type PostAuctionItemItemIDQuestionsJSONBody struct {
+	Question string `json:"question"`
+}
+
+// PostAuctionItemItemIDQuestionsParams defines parameters for PostAuctionItemItemIDQuestions.
+type PostAuctionItemItemIDQuestionsParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONBody defines parameters for PostAuctionItemItemIDQuestionsQuestionIDAnswer.
+type PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONBody struct {
+	Answer string `json:"answer"`
+}
+
+// PostAuctionItemItemIDQuestionsQuestionIDAnswerParams defines parameters for PostAuctionItemItemIDQuestionsQuestionIDAnswer.
+type PostAuctionItemItemIDQuestionsQuestionIDAnswerParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDRelistJSONBody defines parameters for PostAuctionItemItemIDRelist.
+type PostAuctionItemItemIDRelistJSONBody struct {
+	EndTime   time.Time  `json:"endTime"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+}
+
+// PostAuctionItemItemIDRelistParams defines parameters for PostAuctionItemItemIDRelist.
+type PostAuctionItemItemIDRelistParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostAuctionItemItemIDReportJSONBody defines parameters for PostAuctionItemItemIDReport.
+type PostAuctionItemItemIDReportJSONBody struct {
+	// Details Required when the reason is `other`.
+	Details *string `json:"details,omitempty"`
+
+	// Reason Reason of a report.
+	Reason ReportReason `json:"reason"`
+}
+
+// PostAuctionItemItemIDReportParams defines parameters for PostAuctionItemItemIDReport.
+type PostAuctionItemItemIDReportParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// DeleteAuctionItemItemIDWatchParams defines parameters for DeleteAuctionItemItemIDWatch.
+type DeleteAuctionItemItemIDWatchParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PutAuctionItemItemIDWatchParams defines parameters for PutAuctionItemItemIDWatch.
+type PutAuctionItemItemIDWatchParams struct {
 	// AccessToken access token for current user.
 	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
 }
@@ -86,53 +957,58 @@ type PostAuctionItemItemIDBidsParams struct {
 // GetAuctionItemsParams defines parameters for GetAuctionItems.
 type GetAuctionItemsParams struct {
 	// Title Search term for filtering items.
-	Title *string `form:"title,omitempty" json:"title,omitempty"`
+	Title *AuctionListTitle `form:"title,omitempty" json:"title,omitempty"`
+
+	// Search Full-text search over titles and descriptions. Supports quoted phrases, `or` and `-` to exclude words.
+	// Matching items include a highlighted `snippet` and can be sorted by `relevance`.
+	Search *AuctionListSearch `form:"search,omitempty" json:"search,omitempty"`
 
 	// StartPrice Starting price range for filtering items.
-	StartPrice *struct {
-		From *int `json:"from,omitempty"`
-		To   *int `json:"to,omitempty"`
-	} `json:"startPrice,omitempty"`
+	StartPrice *AuctionListStartPrice `json:"startPrice,omitempty"`
 
 	// CurrentBid Current bid range for filtering items.
-	CurrentBid *struct {
-		From *int `json:"from,omitempty"`
-		To   *int `json:"to,omitempty"`
-	} `json:"currentBid,omitempty"`
+	CurrentBid *AuctionListCurrentBid `json:"currentBid,omitempty"`
 
 	// StartTime The auction start time range for filtering items.
-	StartTime *struct {
-		From *time.Time `json:"from,omitempty"`
-		To   *time.Time `json:"to,omitempty"`
-	} `json:"startTime,omitempty"`
+	StartTime *AuctionListStartTime `json:"startTime,omitempty"`
 
 	// EndTime The auction end time range for filtering items.
-	EndTime *struct {
-		From *time.Time `json:"from,omitempty"`
-		To   *time.Time `json:"to,omitempty"`
-	} `json:"endTime,omitempty"`
+	EndTime *AuctionListEndTime `json:"endTime,omitempty"`
 
 	// Sort Sort criteria.
-	Sort *struct {
-		Key   *GetAuctionItemsParamsSortKey   `json:"key,omitempty"`
-		Order *GetAuctionItemsParamsSortOrder `json:"order,omitempty"`
-	} `json:"sort,omitempty"`
+	Sort *AuctionListSort `json:"sort,omitempty"`
 
 	// LastItemID The last item ID of the previous page.
-	LastItemID *openapi_types.UUID `form:"lastItemID,omitempty" json:"lastItemID,omitempty"`
+	LastItemID *AuctionListLastItemID `form:"lastItemID,omitempty" json:"lastItemID,omitempty"`
 
 	// Size The maximum number of items to return.
-	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+	Size *AuctionListSize `form:"size,omitempty" json:"size,omitempty"`
 
 	// ExcludeEnded Exclude ended items.
-	ExcludeEnded *bool `form:"excludeEnded,omitempty" json:"excludeEnded,omitempty"`
+	ExcludeEnded *AuctionListExcludeEnded `form:"excludeEnded,omitempty" json:"excludeEnded,omitempty"`
+
+	// Category Only items in this category or any of its subcategories.
+	Category *AuctionListCategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Tags Only items with the given tags, see `tagMatch`.
+	Tags     *AuctionListTags     `form:"tags,omitempty" json:"tags,omitempty"`
+	TagMatch *AuctionListTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
 }
 
-// GetAuctionItemsParamsSortKey defines parameters for GetAuctionItems.
-type GetAuctionItemsParamsSortKey string
+// GetAuctionSuggestParams defines parameters for GetAuctionSuggest.
+type GetAuctionSuggestParams struct {
+	// Q The text typed so far.
+	Q string `form:"q" json:"q"`
+
+	// Size The maximum number of suggestions of each kind.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+}
 
-// GetAuctionItemsParamsSortOrder defines parameters for GetAuctionItems.
-type GetAuctionItemsParamsSortOrder string
+// PostAuctionSuggestRebuildParams defines parameters for PostAuctionSuggestRebuild.
+type PostAuctionSuggestRebuildParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
 
 // GetAuthCallbackParams defines parameters for GetAuthCallback.
 type GetAuthCallbackParams struct {
@@ -173,29 +1049,404 @@ type PostImageParams struct {
 	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
 }
 
-// PostAuctionItemJSONRequestBody defines body for PostAuctionItem for application/json ContentType.
-type PostAuctionItemJSONRequestBody PostAuctionItemJSONBody
+// GetMeAuctionsParams defines parameters for GetMeAuctions.
+type GetMeAuctionsParams struct {
+	// Title Search term for filtering items.
+	Title *AuctionListTitle `form:"title,omitempty" json:"title,omitempty"`
+
+	// Search Full-text search over titles and descriptions. Supports quoted phrases, `or` and `-` to exclude words.
+	// Matching items include a highlighted `snippet` and can be sorted by `relevance`.
+	Search *AuctionListSearch `form:"search,omitempty" json:"search,omitempty"`
+
+	// StartPrice Starting price range for filtering items.
+	StartPrice *AuctionListStartPrice `json:"startPrice,omitempty"`
+
+	// CurrentBid Current bid range for filtering items.
+	CurrentBid *AuctionListCurrentBid `json:"currentBid,omitempty"`
+
+	// StartTime The auction start time range for filtering items.
+	StartTime *AuctionListStartTime `json:"startTime,omitempty"`
+
+	// EndTime The auction end time range for filtering items.
+	EndTime *AuctionListEndTime `json:"endTime,omitempty"`
+
+	// Sort Sort criteria.
+	Sort *AuctionListSort `json:"sort,omitempty"`
+
+	// LastItemID The last item ID of the previous page.
+	LastItemID *AuctionListLastItemID `form:"lastItemID,omitempty" json:"lastItemID,omitempty"`
+
+	// Size The maximum number of items to return.
+	Size *AuctionListSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// ExcludeEnded Exclude ended items.
+	ExcludeEnded *AuctionListExcludeEnded `form:"excludeEnded,omitempty" json:"excludeEnded,omitempty"`
+
+	// Category Only items in this category or any of its subcategories.
+	Category *AuctionListCategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Tags Only items with the given tags, see `tagMatch`.
+	Tags     *AuctionListTags     `form:"tags,omitempty" json:"tags,omitempty"`
+	TagMatch *AuctionListTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetMeBidsParams defines parameters for GetMeBids.
+type GetMeBidsParams struct {
+	// Title Search term for filtering items.
+	Title *AuctionListTitle `form:"title,omitempty" json:"title,omitempty"`
+
+	// Search Full-text search over titles and descriptions. Supports quoted phrases, `or` and `-` to exclude words.
+	// Matching items include a highlighted `snippet` and can be sorted by `relevance`.
+	Search *AuctionListSearch `form:"search,omitempty" json:"search,omitempty"`
+
+	// StartPrice Starting price range for filtering items.
+	StartPrice *AuctionListStartPrice `json:"startPrice,omitempty"`
+
+	// CurrentBid Current bid range for filtering items.
+	CurrentBid *AuctionListCurrentBid `json:"currentBid,omitempty"`
+
+	// StartTime The auction start time range for filtering items.
+	StartTime *AuctionListStartTime `json:"startTime,omitempty"`
+
+	// EndTime The auction end time range for filtering items.
+	EndTime *AuctionListEndTime `json:"endTime,omitempty"`
+
+	// Sort Sort criteria.
+	Sort *AuctionListSort `json:"sort,omitempty"`
+
+	// LastItemID The last item ID of the previous page.
+	LastItemID *AuctionListLastItemID `form:"lastItemID,omitempty" json:"lastItemID,omitempty"`
+
+	// Size The maximum number of items to return.
+	Size *AuctionListSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// ExcludeEnded Exclude ended items.
+	ExcludeEnded *AuctionListExcludeEnded `form:"excludeEnded,omitempty" json:"excludeEnded,omitempty"`
+
+	// Category Only items in this category or any of its subcategories.
+	Category *AuctionListCategory `form:"category,omitempty" json:"category,omitempty"`
+
+	// Tags Only items with the given tags, see `tagMatch`.
+	Tags     *AuctionListTags     `form:"tags,omitempty" json:"tags,omitempty"`
+	TagMatch *AuctionListTagMatch `form:"tagMatch,omitempty" json:"tagMatch,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetMeNotificationPreferencesParams defines parameters for GetMeNotificationPreferences.
+type GetMeNotificationPreferencesParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PutMeNotificationPreferencesParams defines parameters for PutMeNotificationPreferences.
+type PutMeNotificationPreferencesParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetMeNotificationsParams defines parameters for GetMeNotifications.
+type GetMeNotificationsParams struct {
+	// Unread Only list unread notifications.
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+
+	// LastNotificationID The last notification ID of the previous page.
+	LastNotificationID *openapi_types.UUID `form:"lastNotificationID,omitempty" json:"lastNotificationID,omitempty"`
+
+	// Size The maximum number of notifications to return.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PutMeNotificationsNotificationIDReadParams defines parameters for PutMeNotificationsNotificationIDRead.
+type PutMeNotificationsNotificationIDReadParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetMeWatchlistParams defines parameters for GetMeWatchlist.
+type GetMeWatchlistParams struct {
+	// Sort Sort order.
+	//   - recent: most recently watched first.
+	//   - endingSoon: running auctions ending soonest first, followed by ended auctions.
+	Sort *GetMeWatchlistParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetMeWatchlistParamsSort defines parameters for GetMeWatchlist.
+type GetMeWatchlistParamsSort string
+
+// GetMeWebhooksParams defines parameters for GetMeWebhooks.
+type GetMeWebhooksParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostMeWebhooksJSONBody defines parameters for PostMeWebhooks.
+type PostMeWebhooksJSONBody struct {
+	Description *string            `json:"description,omitempty"`
+	Events      []WebhookEventType `json:"events"`
+
+	// Url HTTP(S) URL to receive events.
+	Url string `json:"url"`
+}
+
+// PostMeWebhooksParams defines parameters for PostMeWebhooks.
+type PostMeWebhooksParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// DeleteMeWebhooksWebhookIDParams defines parameters for DeleteMeWebhooksWebhookID.
+type DeleteMeWebhooksWebhookIDParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetMeWebhooksWebhookIDDeliveriesParams defines parameters for GetMeWebhooksWebhookIDDeliveries.
+type GetMeWebhooksWebhookIDDeliveriesParams struct {
+	// Status Only list deliveries with this status.
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// LastDeliveryID The last delivery ID of the previous page.
+	LastDeliveryID *openapi_types.UUID `form:"lastDeliveryID,omitempty" json:"lastDeliveryID,omitempty"`
+
+	// Size The maximum number of deliveries to return.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverParams defines parameters for PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver.
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostModerationAuctionItemItemIDHideJSONBody defines parameters for PostModerationAuctionItemItemIDHide.
+type PostModerationAuctionItemItemIDHideJSONBody struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// PostModerationAuctionItemItemIDHideParams defines parameters for PostModerationAuctionItemItemIDHide.
+type PostModerationAuctionItemItemIDHideParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostModerationAuctionItemItemIDVoidBidsParams defines parameters for PostModerationAuctionItemItemIDVoidBids.
+type PostModerationAuctionItemItemIDVoidBidsParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetModerationCasesParams defines parameters for GetModerationCases.
+type GetModerationCasesParams struct {
+	// Status Only list cases in this status.
+	Status *ModerationCaseStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// LastCaseID The last case ID of the previous page.
+	LastCaseID *openapi_types.UUID `form:"lastCaseID,omitempty" json:"lastCaseID,omitempty"`
+
+	// Size The maximum number of cases to return.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostModerationCasesCaseIDDismissJSONBody defines parameters for PostModerationCasesCaseIDDismiss.
+type PostModerationCasesCaseIDDismissJSONBody struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// PostModerationCasesCaseIDDismissParams defines parameters for PostModerationCasesCaseIDDismiss.
+type PostModerationCasesCaseIDDismissParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetModerationShillFlagsParams defines parameters for GetModerationShillFlags.
+type GetModerationShillFlagsParams struct {
+	// Reviewed List reviewed flags instead of flags waiting for review.
+	Reviewed *bool `form:"reviewed,omitempty" json:"reviewed,omitempty"`
+
+	// LastFlagID The last flag ID of the previous page.
+	LastFlagID *openapi_types.UUID `form:"lastFlagID,omitempty" json:"lastFlagID,omitempty"`
+
+	// Size The maximum number of flags to return.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostModerationShillFlagsFlagIDReviewParams defines parameters for PostModerationShillFlagsFlagIDReview.
+type PostModerationShillFlagsFlagIDReviewParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// DeleteModerationUsersUsernameBanParams defines parameters for DeleteModerationUsersUsernameBan.
+type DeleteModerationUsersUsernameBanParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// PostModerationUsersUsernameBanJSONBody defines parameters for PostModerationUsersUsernameBan.
+type PostModerationUsersUsernameBanJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// PostModerationUsersUsernameBanParams defines parameters for PostModerationUsersUsernameBan.
+type PostModerationUsersUsernameBanParams struct {
+	// AccessToken access token for current user.
+	AccessToken *string `form:"accessToken,omitempty" json:"accessToken,omitempty"`
+}
+
+// GetUsersUsernameFeedbackParams defines parameters for GetUsersUsernameFeedback.
+type GetUsersUsernameFeedbackParams struct {
+	// Role Only list feedback received in this role.
+	Role *FeedbackRole `form:"role,omitempty" json:"role,omitempty"`
+
+	// LastFeedbackID The last feedback ID of the previous page.
+	LastFeedbackID *openapi_types.UUID `form:"lastFeedbackID,omitempty" json:"lastFeedbackID,omitempty"`
+
+	// Size The maximum number of feedback to return.
+	Size *uint32 `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// PostAuctionCategoryJSONRequestBody defines body for PostAuctionCategory for application/json ContentType.
+type PostAuctionCategoryJSONRequestBody PostAuctionCategoryJSONBody
+
+// PatchAuctionCategoryCategoryIDJSONRequestBody defines body for PatchAuctionCategoryCategoryID for application/json ContentType.
+type PatchAuctionCategoryCategoryIDJSONRequestBody PatchAuctionCategoryCategoryIDJSONBody
+
+// PostAuctionItemJSONRequestBody defines body for PostAuctionItem for application/json ContentType.
+type PostAuctionItemJSONRequestBody PostAuctionItemJSONBody
+
+// PatchAuctionItemItemIDJSONRequestBody defines body for PatchAuctionItemItemID for application/json ContentType.
+type PatchAuctionItemItemIDJSONRequestBody PatchAuctionItemItemIDJSONBody
 
 // PostAuctionItemItemIDBidsJSONRequestBody defines body for PostAuctionItemItemIDBids for application/json ContentType.
 type PostAuctionItemItemIDBidsJSONRequestBody PostAuctionItemItemIDBidsJSONBody
 
+// PostAuctionItemItemIDFeedbackJSONRequestBody defines body for PostAuctionItemItemIDFeedback for application/json ContentType.
+type PostAuctionItemItemIDFeedbackJSONRequestBody PostAuctionItemItemIDFeedbackJSONBody
+
+// PostAuctionItemItemIDQuestionsJSONRequestBody defines body for PostAuctionItemItemIDQuestions for application/json ContentType.
+type PostAuctionItemItemIDQuestionsJSONRequestBody PostAuctionItemItemIDQuestionsJSONBody
+
+// PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONRequestBody defines body for PostAuctionItemItemIDQuestionsQuestionIDAnswer for application/json ContentType.
+type PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONRequestBody PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONBody
+
+// PostAuctionItemItemIDRelistJSONRequestBody defines body for PostAuctionItemItemIDRelist for application/json ContentType.
+type PostAuctionItemItemIDRelistJSONRequestBody PostAuctionItemItemIDRelistJSONBody
+
+// PostAuctionItemItemIDReportJSONRequestBody defines body for PostAuctionItemItemIDReport for application/json ContentType.
+type PostAuctionItemItemIDReportJSONRequestBody PostAuctionItemItemIDReportJSONBody
+
+// PutMeNotificationPreferencesJSONRequestBody defines body for PutMeNotificationPreferences for application/json ContentType.
+type PutMeNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// PostMeWebhooksJSONRequestBody defines body for PostMeWebhooks for application/json ContentType.
+type PostMeWebhooksJSONRequestBody PostMeWebhooksJSONBody
+
+// PostModerationAuctionItemItemIDHideJSONRequestBody defines body for PostModerationAuctionItemItemIDHide for application/json ContentType.
+type PostModerationAuctionItemItemIDHideJSONRequestBody PostModerationAuctionItemItemIDHideJSONBody
+
+// PostModerationCasesCaseIDDismissJSONRequestBody defines body for PostModerationCasesCaseIDDismiss for application/json ContentType.
+type PostModerationCasesCaseIDDismissJSONRequestBody PostModerationCasesCaseIDDismissJSONBody
+
+// PostModerationUsersUsernameBanJSONRequestBody defines body for PostModerationUsersUsernameBan for application/json ContentType.
+type PostModerationUsersUsernameBanJSONRequestBody PostModerationUsersUsernameBanJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List categories
+	// (GET /auction/categories)
+	GetAuctionCategories(c *gin.Context)
+	// Add a new category
+	// (POST /auction/category)
+	PostAuctionCategory(c *gin.Context, params PostAuctionCategoryParams)
+	// Delete a category
+	// (DELETE /auction/category/{categoryID})
+	DeleteAuctionCategoryCategoryID(c *gin.Context, categoryID openapi_types.UUID, params DeleteAuctionCategoryCategoryIDParams)
+	// Edit a category
+	// (PATCH /auction/category/{categoryID})
+	PatchAuctionCategoryCategoryID(c *gin.Context, categoryID openapi_types.UUID, params PatchAuctionCategoryCategoryIDParams)
 	// Add a new auction item
 	// (POST /auction/item)
 	PostAuctionItem(c *gin.Context, params PostAuctionItemParams)
+	// Cancel an auction item
+	// (DELETE /auction/item/{itemID})
+	DeleteAuctionItemItemID(c *gin.Context, itemID openapi_types.UUID, params DeleteAuctionItemItemIDParams)
 	// Get auction item details
 	// (GET /auction/item/{itemID})
-	GetAuctionItemItemID(c *gin.Context, itemID openapi_types.UUID)
+	GetAuctionItemItemID(c *gin.Context, itemID openapi_types.UUID, params GetAuctionItemItemIDParams)
+	// Edit an auction item
+	// (PATCH /auction/item/{itemID})
+	PatchAuctionItemItemID(c *gin.Context, itemID openapi_types.UUID, params PatchAuctionItemItemIDParams)
+	// Accept the current price of a dutch auction
+	// (POST /auction/item/{itemID}/accept)
+	PostAuctionItemItemIDAccept(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDAcceptParams)
+	// List bids of an auction item
+	// (GET /auction/item/{itemID}/bids)
+	GetAuctionItemItemIDBids(c *gin.Context, itemID openapi_types.UUID, params GetAuctionItemItemIDBidsParams)
 	// Place a bid on an auction item
 	// (POST /auction/item/{itemID}/bids)
 	PostAuctionItemItemIDBids(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDBidsParams)
+	// Buy an auction item at its buy-now price
+	// (POST /auction/item/{itemID}/buy-now)
+	PostAuctionItemItemIDBuyNow(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDBuyNowParams)
 	// Track auction item events
 	// (GET /auction/item/{itemID}/events)
 	GetAuctionItemItemIDEvents(c *gin.Context, itemID openapi_types.UUID)
+	// List feedback of an auction item
+	// (GET /auction/item/{itemID}/feedback)
+	GetAuctionItemItemIDFeedback(c *gin.Context, itemID openapi_types.UUID)
+	// Leave feedback on an auction item
+	// (POST /auction/item/{itemID}/feedback)
+	PostAuctionItemItemIDFeedback(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDFeedbackParams)
+	// List questions of an auction item
+	// (GET /auction/item/{itemID}/questions)
+	GetAuctionItemItemIDQuestions(c *gin.Context, itemID openapi_types.UUID, params GetAuctionItemItemIDQuestionsParams)
+	// Ask a question about an auction item
+	// (POST /auction/item/{itemID}/questions)
+	PostAuctionItemItemIDQuestions(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDQuestionsParams)
+	// Answer a question about an auction item
+	// (POST /auction/item/{itemID}/questions/{questionID}/answer)
+	PostAuctionItemItemIDQuestionsQuestionIDAnswer(c *gin.Context, itemID openapi_types.UUID, questionID openapi_types.UUID, params PostAuctionItemItemIDQuestionsQuestionIDAnswerParams)
+	// Relist an unsold auction item
+	// (POST /auction/item/{itemID}/relist)
+	PostAuctionItemItemIDRelist(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDRelistParams)
+	// Report an auction item
+	// (POST /auction/item/{itemID}/report)
+	PostAuctionItemItemIDReport(c *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDReportParams)
+	// Unwatch an auction item
+	// (DELETE /auction/item/{itemID}/watch)
+	DeleteAuctionItemItemIDWatch(c *gin.Context, itemID openapi_types.UUID, params DeleteAuctionItemItemIDWatchParams)
+	// Watch an auction item
+	// (PUT /auction/item/{itemID}/watch)
+	PutAuctionItemItemIDWatch(c *gin.Context, itemID openapi_types.UUID, params PutAuctionItemItemIDWatchParams)
 	// List auction items
 	// (GET /auction/items)
 	GetAuctionItems(c *gin.Context, params GetAuctionItemsParams)
+	// Get search suggestions
+	// (GET /auction/suggest)
+	GetAuctionSuggest(c *gin.Context, params GetAuctionSuggestParams)
+	// Rebuild the suggestion index
+	// (POST /auction/suggest/rebuild)
+	PostAuctionSuggestRebuild(c *gin.Context, params PostAuctionSuggestRebuildParams)
 	// Exchange authorization code
 	// (GET /auth/callback)
 	GetAuthCallback(c *gin.Context, params GetAuthCallbackParams)
@@ -208,6 +1459,69 @@ type ServerInterface interface {
 	// Upload an image
 	// (POST /image)
 	PostImage(c *gin.Context, params PostImageParams)
+	// List my auction items
+	// (GET /me/auctions)
+	GetMeAuctions(c *gin.Context, params GetMeAuctionsParams)
+	// List auctions I have bid on
+	// (GET /me/bids)
+	GetMeBids(c *gin.Context, params GetMeBidsParams)
+	// Get my notification preferences
+	// (GET /me/notification-preferences)
+	GetMeNotificationPreferences(c *gin.Context, params GetMeNotificationPreferencesParams)
+	// Update my notification preferences
+	// (PUT /me/notification-preferences)
+	PutMeNotificationPreferences(c *gin.Context, params PutMeNotificationPreferencesParams)
+	// List my notifications
+	// (GET /me/notifications)
+	GetMeNotifications(c *gin.Context, params GetMeNotificationsParams)
+	// Mark a notification as read
+	// (PUT /me/notifications/{notificationID}/read)
+	PutMeNotificationsNotificationIDRead(c *gin.Context, notificationID openapi_types.UUID, params PutMeNotificationsNotificationIDReadParams)
+	// List watched auction items
+	// (GET /me/watchlist)
+	GetMeWatchlist(c *gin.Context, params GetMeWatchlistParams)
+	// List my webhooks
+	// (GET /me/webhooks)
+	GetMeWebhooks(c *gin.Context, params GetMeWebhooksParams)
+	// Register a webhook
+	// (POST /me/webhooks)
+	PostMeWebhooks(c *gin.Context, params PostMeWebhooksParams)
+	// Delete a webhook
+	// (DELETE /me/webhooks/{webhookID})
+	DeleteMeWebhooksWebhookID(c *gin.Context, webhookID openapi_types.UUID, params DeleteMeWebhooksWebhookIDParams)
+	// List deliveries of a webhook
+	// (GET /me/webhooks/{webhookID}/deliveries)
+	GetMeWebhooksWebhookIDDeliveries(c *gin.Context, webhookID openapi_types.UUID, params GetMeWebhooksWebhookIDDeliveriesParams)
+	// Redeliver a failed delivery
+	// (POST /me/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver)
+	PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(c *gin.Context, webhookID openapi_types.UUID, deliveryID openapi_types.UUID, params PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverParams)
+	// Hide an auction item
+	// (POST /moderation/auction/item/{itemID}/hide)
+	PostModerationAuctionItemItemIDHide(c *gin.Context, itemID openapi_types.UUID, params PostModerationAuctionItemItemIDHideParams)
+	// Void the bids of a hidden auction item
+	// (POST /moderation/auction/item/{itemID}/void-bids)
+	PostModerationAuctionItemItemIDVoidBids(c *gin.Context, itemID openapi_types.UUID, params PostModerationAuctionItemItemIDVoidBidsParams)
+	// List moderation cases
+	// (GET /moderation/cases)
+	GetModerationCases(c *gin.Context, params GetModerationCasesParams)
+	// Dismiss a moderation case
+	// (POST /moderation/cases/{caseID}/dismiss)
+	PostModerationCasesCaseIDDismiss(c *gin.Context, caseID openapi_types.UUID, params PostModerationCasesCaseIDDismissParams)
+	// List shill bidding flags
+	// (GET /moderation/shill-flags)
+	GetModerationShillFlags(c *gin.Context, params GetModerationShillFlagsParams)
+	// Mark a shill bidding flag as reviewed
+	// (POST /moderation/shill-flags/{flagID}/review)
+	PostModerationShillFlagsFlagIDReview(c *gin.Context, flagID openapi_types.UUID, params PostModerationShillFlagsFlagIDReviewParams)
+	// Lift the ban of a user
+	// (DELETE /moderation/users/{username}/ban)
+	DeleteModerationUsersUsernameBan(c *gin.Context, username string, params DeleteModerationUsersUsernameBanParams)
+	// Ban a user
+	// (POST /moderation/users/{username}/ban)
+	PostModerationUsersUsernameBan(c *gin.Context, username string, params PostModerationUsersUsernameBanParams)
+	// List feedback received by a user
+	// (GET /users/{username}/feedback)
+	GetUsersUsernameFeedback(c *gin.Context, username string, params GetUsersUsernameFeedbackParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -219,13 +1533,28 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostAuctionItem operation middleware
-func (siw *ServerInterfaceWrapper) PostAuctionItem(c *gin.Context) {
+// GetAuctionCategories operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionCategories(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuctionCategories(c)
+}
+
+// PostAuctionCategory operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionCategory(c *gin.Context) {
 
 	var err error
 
+	c.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostAuctionItemParams
+	var params PostAuctionCategoryParams
 
 	{
 		var cookie string
@@ -249,23 +1578,43 @@ func (siw *ServerInterfaceWrapper) PostAuctionItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostAuctionItem(c, params)
+	siw.Handler.PostAuctionCategory(c, params)
 }
 
-// GetAuctionItemItemID operation middleware
-func (siw *ServerInterfaceWrapper) GetAuctionItemItemID(c *gin.Context) {
+// DeleteAuctionCategoryCategoryID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAuctionCategoryCategoryID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "itemID" -------------
-	var itemID openapi_types.UUID
+	// ------------- Path parameter "categoryID" -------------
+	var categoryID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryID", c.Param("categoryID"), &categoryID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter categoryID: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAuctionCategoryCategoryIDParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -273,27 +1622,27 @@ func (siw *ServerInterfaceWrapper) GetAuctionItemItemID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAuctionItemItemID(c, itemID)
+	siw.Handler.DeleteAuctionCategoryCategoryID(c, categoryID, params)
 }
 
-// PostAuctionItemItemIDBids operation middleware
-func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDBids(c *gin.Context) {
+// PatchAuctionCategoryCategoryID operation middleware
+func (siw *ServerInterfaceWrapper) PatchAuctionCategoryCategoryID(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "itemID" -------------
-	var itemID openapi_types.UUID
+	// ------------- Path parameter "categoryID" -------------
+	var categoryID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "categoryID", c.Param("categoryID"), &categoryID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter categoryID: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostAuctionItemItemIDBidsParams
+	var params PatchAuctionCategoryCategoryIDParams
 
 	{
 		var cookie string
@@ -317,21 +1666,51 @@ func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDBids(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostAuctionItemItemIDBids(c, itemID, params)
+	siw.Handler.PatchAuctionCategoryCategoryID(c, categoryID, params)
 }
 
-// GetAuctionItemItemIDEvents operation middleware
-func (siw *ServerInterfaceWrapper) GetAuctionItemItemIDEvents(c *gin.Context) {
+// PostAuctionItem operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItem(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "itemID" -------------
-	var itemID openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
-		return
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -341,87 +1720,83 @@ func (siw *ServerInterfaceWrapper) GetAuctionItemItemIDEvents(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAuctionItemItemIDEvents(c, itemID)
+	siw.Handler.PostAuctionItem(c, params)
 }
 
-// GetAuctionItems operation middleware
-func (siw *ServerInterfaceWrapper) GetAuctionItems(c *gin.Context) {
+// DeleteAuctionItemItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAuctionItemItemID(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAuctionItemsParams
-
-	// ------------- Optional query parameter "title" -------------
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "title", c.Request.URL.Query(), &params.Title)
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter title: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "startPrice" -------------
-
-	err = runtime.BindQueryParameter("deepObject", true, false, "startPrice", c.Request.URL.Query(), &params.StartPrice)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startPrice: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "currentBid" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAuctionItemItemIDParams
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "currentBid", c.Request.URL.Query(), &params.CurrentBid)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currentBid: %w", err), http.StatusBadRequest)
-		return
-	}
+	{
+		var cookie string
 
-	// ------------- Optional query parameter "startTime" -------------
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "startTime", c.Request.URL.Query(), &params.StartTime)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startTime: %w", err), http.StatusBadRequest)
-		return
+		}
 	}
 
-	// ------------- Optional query parameter "endTime" -------------
-
-	err = runtime.BindQueryParameter("deepObject", true, false, "endTime", c.Request.URL.Query(), &params.EndTime)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endTime: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	siw.Handler.DeleteAuctionItemItemID(c, itemID, params)
+}
 
-	err = runtime.BindQueryParameter("deepObject", true, false, "sort", c.Request.URL.Query(), &params.Sort)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetAuctionItemItemID operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionItemItemID(c *gin.Context) {
 
-	// ------------- Optional query parameter "lastItemID" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "lastItemID", c.Request.URL.Query(), &params.LastItemID)
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastItemID: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "size" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionItemItemIDParams
 
-	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
-		return
-	}
+	{
+		var cookie string
 
-	// ------------- Optional query parameter "excludeEnded" -------------
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
 
-	err = runtime.BindQueryParameter("form", true, false, "excludeEnded", c.Request.URL.Query(), &params.ExcludeEnded)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter excludeEnded: %w", err), http.StatusBadRequest)
-		return
+		}
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -431,88 +1806,39 @@ func (siw *ServerInterfaceWrapper) GetAuctionItems(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAuctionItems(c, params)
+	siw.Handler.GetAuctionItemItemID(c, itemID, params)
 }
 
-// GetAuthCallback operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthCallback(c *gin.Context) {
+// PatchAuctionItemItemID operation middleware
+func (siw *ServerInterfaceWrapper) PatchAuctionItemItemID(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAuthCallbackParams
-
-	// ------------- Required query parameter "code" -------------
-
-	if paramValue := c.Query("code"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "state" -------------
-
-	if paramValue := c.Query("state"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument state is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "state", c.Request.URL.Query(), &params.State)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "redirect_url" -------------
-
-	if paramValue := c.Query("redirect_url"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument redirect_url is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, true, "redirect_url", c.Request.URL.Query(), &params.RedirectUrl)
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirect_url: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	{
-		var cookie string
-
-		if cookie, err = c.Cookie("requestState"); err == nil {
-			var value string
-			err = runtime.BindStyledParameterWithOptions("simple", "requestState", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requestState: %w", err), http.StatusBadRequest)
-				return
-			}
-			params.RequestState = &value
+	c.Set(BearerAuthScopes, []string{})
 
-		}
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchAuctionItemItemIDParams
 
 	{
 		var cookie string
 
-		if cookie, err = c.Cookie("requestNonce"); err == nil {
+		if cookie, err = c.Cookie("accessToken"); err == nil {
 			var value string
-			err = runtime.BindStyledParameterWithOptions("simple", "requestNonce", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
 			if err != nil {
-				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requestNonce: %w", err), http.StatusBadRequest)
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
 				return
 			}
-			params.RequestNonce = &value
+			params.AccessToken = &value
 
 		}
 	}
@@ -524,49 +1850,27 @@ func (siw *ServerInterfaceWrapper) GetAuthCallback(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAuthCallback(c, params)
+	siw.Handler.PatchAuctionItemItemID(c, itemID, params)
 }
 
-// GetAuthLogin operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthLogin(c *gin.Context) {
+// PostAuctionItemItemIDAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDAccept(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAuthLoginParams
-
-	// ------------- Required query parameter "redirect_url" -------------
-
-	if paramValue := c.Query("redirect_url"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument redirect_url is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, true, "redirect_url", c.Request.URL.Query(), &params.RedirectUrl)
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirect_url: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetAuthLogin(c, params)
-}
-
-// GetAuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthLogout(c *gin.Context) {
-
-	var err error
+	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAuthLogoutParams
+	var params PostAuctionItemItemIDAcceptParams
 
 	{
 		var cookie string
@@ -583,21 +1887,6 @@ func (siw *ServerInterfaceWrapper) GetAuthLogout(c *gin.Context) {
 		}
 	}
 
-	{
-		var cookie string
-
-		if cookie, err = c.Cookie("username"); err == nil {
-			var value string
-			err = runtime.BindStyledParameterWithOptions("simple", "username", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
-			if err != nil {
-				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
-				return
-			}
-			params.Username = &value
-
-		}
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -605,16 +1894,41 @@ func (siw *ServerInterfaceWrapper) GetAuthLogout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetAuthLogout(c, params)
+	siw.Handler.PostAuctionItemItemIDAccept(c, itemID, params)
 }
 
-// PostImage operation middleware
-func (siw *ServerInterfaceWrapper) PostImage(c *gin.Context) {
+// GetAuctionItemItemIDBids operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionItemItemIDBids(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params PostImageParams
+	var params GetAuctionItemItemIDBidsParams
+
+	// ------------- Optional query parameter "lastBidID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastBidID", c.Request.URL.Query(), &params.LastBidID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastBidID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	{
 		var cookie string
@@ -638,529 +1952,5928 @@ func (siw *ServerInterfaceWrapper) PostImage(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostImage(c, params)
+	siw.Handler.GetAuctionItemItemIDBids(c, itemID, params)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// PostAuctionItemItemIDBids operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDBids(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.POST(options.BaseURL+"/auction/item", wrapper.PostAuctionItem)
-	router.GET(options.BaseURL+"/auction/item/:itemID", wrapper.GetAuctionItemItemID)
-	router.POST(options.BaseURL+"/auction/item/:itemID/bids", wrapper.PostAuctionItemItemIDBids)
-	router.GET(options.BaseURL+"/auction/item/:itemID/events", wrapper.GetAuctionItemItemIDEvents)
-	router.GET(options.BaseURL+"/auction/items", wrapper.GetAuctionItems)
-	router.GET(options.BaseURL+"/auth/callback", wrapper.GetAuthCallback)
-	router.GET(options.BaseURL+"/auth/login", wrapper.GetAuthLogin)
-	router.GET(options.BaseURL+"/auth/logout", wrapper.GetAuthLogout)
-	router.POST(options.BaseURL+"/image", wrapper.PostImage)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type PostAuctionItemRequestObject struct {
-	Params PostAuctionItemParams
-	Body   *PostAuctionItemJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDBidsParams
 
-type PostAuctionItemResponseObject interface {
-	VisitPostAuctionItemResponse(w http.ResponseWriter) error
-}
+	headers := c.Request.Header
 
-type PostAuctionItem201ResponseHeaders struct {
-	Location string
-}
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-type PostAuctionItem201Response struct {
-	Headers PostAuctionItem201ResponseHeaders
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
 
-func (response PostAuctionItem201Response) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", response.Headers.Location)
+		params.IdempotencyKey = &IdempotencyKey
 
-	w.WriteHeader(201)
-	return nil
-}
+	}
 
-type PostAuctionItem400JSONResponse ApiResponse
+	{
+		var cookie string
 
-func (response PostAuctionItem400JSONResponse) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
 
-	return json.NewEncoder(w).Encode(response)
-}
+		}
+	}
 
-type PostAuctionItem401Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response PostAuctionItem401Response) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	siw.Handler.PostAuctionItemItemIDBids(c, itemID, params)
 }
 
-type GetAuctionItemItemIDRequestObject struct {
-	ItemID openapi_types.UUID `json:"itemID"`
-}
+// PostAuctionItemItemIDBuyNow operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDBuyNow(c *gin.Context) {
 
-type GetAuctionItemItemIDResponseObject interface {
-	VisitGetAuctionItemItemIDResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetAuctionItemItemID200JSONResponse struct {
-	BidRecords  []BidEvent `json:"bidRecords"`
-	Carousels   []string   `json:"carousels"`
-	Description string     `json:"description"`
-	EndTime     time.Time  `json:"endTime"`
-	StartPrice  int64      `json:"startPrice"`
-	StartTime   time.Time  `json:"startTime"`
-	Title       string     `json:"title"`
-}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-func (response GetAuctionItemItemID200JSONResponse) VisitGetAuctionItemItemIDResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type GetAuctionItemItemID404Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDBuyNowParams
 
-func (response GetAuctionItemItemID404Response) VisitGetAuctionItemItemIDResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	{
+		var cookie string
 
-type PostAuctionItemItemIDBidsRequestObject struct {
-	ItemID openapi_types.UUID `json:"itemID"`
-	Params PostAuctionItemItemIDBidsParams
-	Body   *PostAuctionItemItemIDBidsJSONRequestBody
-}
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
 
-type PostAuctionItemItemIDBidsResponseObject interface {
-	VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error
-}
+		}
+	}
 
-type PostAuctionItemItemIDBids200Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response PostAuctionItemItemIDBids200Response) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+	siw.Handler.PostAuctionItemItemIDBuyNow(c, itemID, params)
 }
 
-type PostAuctionItemItemIDBids400JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+// GetAuctionItemItemIDEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionItemItemIDEvents(c *gin.Context) {
 
-func (response PostAuctionItemItemIDBids400JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-type PostAuctionItemItemIDBids401Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostAuctionItemItemIDBids401Response) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostAuctionItemItemIDBids403JSONResponse struct {
-	Message *string `json:"message,omitempty"`
+	siw.Handler.GetAuctionItemItemIDEvents(c, itemID)
 }
 
-func (response PostAuctionItemItemIDBids403JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetAuctionItemItemIDFeedback operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionItemItemIDFeedback(c *gin.Context) {
 
-type PostAuctionItemItemIDBids404Response struct {
-}
+	var err error
 
-func (response PostAuctionItemItemIDBids404Response) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-type PostAuctionItemItemIDBids410JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostAuctionItemItemIDBids410JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(410)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetAuctionItemItemIDFeedback(c, itemID)
 }
 
-type GetAuctionItemItemIDEventsRequestObject struct {
-	ItemID openapi_types.UUID `json:"itemID"`
-}
+// PostAuctionItemItemIDFeedback operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDFeedback(c *gin.Context) {
 
-type GetAuctionItemItemIDEventsResponseObject interface {
-	VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetAuctionItemItemIDEvents200Response struct {
-}
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
 
-func (response GetAuctionItemItemIDEvents200Response) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetAuctionItemItemIDEvents403JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-func (response GetAuctionItemItemIDEvents403JSONResponse) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDFeedbackParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	{
+		var cookie string
 
-type GetAuctionItemItemIDEvents404Response struct {
-}
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
 
-func (response GetAuctionItemItemIDEvents404Response) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuctionItemItemIDFeedback(c, itemID, params)
+}
+
+// GetAuctionItemItemIDQuestions operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionItemItemIDQuestions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionItemItemIDQuestionsParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuctionItemItemIDQuestions(c, itemID, params)
+}
+
+// PostAuctionItemItemIDQuestions operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDQuestions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDQuestionsParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuctionItemItemIDQuestions(c, itemID, params)
+}
+
+// PostAuctionItemItemIDQuestionsQuestionIDAnswer operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDQuestionsQuestionIDAnswer(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "questionID" -------------
+	var questionID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionID", c.Param("questionID"), &questionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter questionID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDQuestionsQuestionIDAnswerParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuctionItemItemIDQuestionsQuestionIDAnswer(c, itemID, questionID, params)
+}
+
+// PostAuctionItemItemIDRelist operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDRelist(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDRelistParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuctionItemItemIDRelist(c, itemID, params)
+}
+
+// PostAuctionItemItemIDReport operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionItemItemIDReport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionItemItemIDReportParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuctionItemItemIDReport(c, itemID, params)
+}
+
+// DeleteAuctionItemItemIDWatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAuctionItemItemIDWatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAuctionItemItemIDWatchParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAuctionItemItemIDWatch(c, itemID, params)
+}
+
+// PutAuctionItemItemIDWatch operation middleware
+func (siw *ServerInterfaceWrapper) PutAuctionItemItemIDWatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAuctionItemItemIDWatchParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutAuctionItemItemIDWatch(c, itemID, params)
+}
+
+// GetAuctionItems operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionItems(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionItemsParams
+
+	// ------------- Optional query parameter "title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title", c.Request.URL.Query(), &params.Title)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter title: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startPrice" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "startPrice", c.Request.URL.Query(), &params.StartPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startPrice: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "currentBid" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "currentBid", c.Request.URL.Query(), &params.CurrentBid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currentBid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startTime" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "startTime", c.Request.URL.Query(), &params.StartTime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startTime: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endTime" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "endTime", c.Request.URL.Query(), &params.EndTime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endTime: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastItemID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastItemID", c.Request.URL.Query(), &params.LastItemID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastItemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "excludeEnded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeEnded", c.Request.URL.Query(), &params.ExcludeEnded)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter excludeEnded: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", c.Request.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tags: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagMatch", c.Request.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagMatch: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuctionItems(c, params)
+}
+
+// GetAuctionSuggest operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionSuggest(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionSuggestParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuctionSuggest(c, params)
+}
+
+// PostAuctionSuggestRebuild operation middleware
+func (siw *ServerInterfaceWrapper) PostAuctionSuggestRebuild(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuctionSuggestRebuildParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAuctionSuggestRebuild(c, params)
+}
+
+// GetAuthCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthCallback(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := c.Query("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument code is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", c.Request.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := c.Query("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument state is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", c.Request.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter state: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "redirect_url" -------------
+
+	if paramValue := c.Query("redirect_url"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument redirect_url is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "redirect_url", c.Request.URL.Query(), &params.RedirectUrl)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirect_url: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("requestState"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "requestState", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requestState: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.RequestState = &value
+
+		}
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("requestNonce"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "requestNonce", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requestNonce: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.RequestNonce = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuthCallback(c, params)
+}
+
+// GetAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthLogin(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthLoginParams
+
+	// ------------- Required query parameter "redirect_url" -------------
+
+	if paramValue := c.Query("redirect_url"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument redirect_url is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "redirect_url", c.Request.URL.Query(), &params.RedirectUrl)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter redirect_url: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuthLogin(c, params)
+}
+
+// GetAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthLogout(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthLogoutParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("username"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "username", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.Username = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuthLogout(c, params)
+}
+
+// PostImage operation middleware
+func (siw *ServerInterfaceWrapper) PostImage(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostImageParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostImage(c, params)
+}
+
+// GetMeAuctions operation middleware
+func (siw *ServerInterfaceWrapper) GetMeAuctions(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeAuctionsParams
+
+	// ------------- Optional query parameter "title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title", c.Request.URL.Query(), &params.Title)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter title: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startPrice" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "startPrice", c.Request.URL.Query(), &params.StartPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startPrice: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "currentBid" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "currentBid", c.Request.URL.Query(), &params.CurrentBid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currentBid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startTime" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "startTime", c.Request.URL.Query(), &params.StartTime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startTime: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endTime" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "endTime", c.Request.URL.Query(), &params.EndTime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endTime: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastItemID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastItemID", c.Request.URL.Query(), &params.LastItemID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastItemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "excludeEnded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeEnded", c.Request.URL.Query(), &params.ExcludeEnded)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter excludeEnded: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", c.Request.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tags: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagMatch", c.Request.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagMatch: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeAuctions(c, params)
+}
+
+// GetMeBids operation middleware
+func (siw *ServerInterfaceWrapper) GetMeBids(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeBidsParams
+
+	// ------------- Optional query parameter "title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title", c.Request.URL.Query(), &params.Title)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter title: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startPrice" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "startPrice", c.Request.URL.Query(), &params.StartPrice)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startPrice: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "currentBid" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "currentBid", c.Request.URL.Query(), &params.CurrentBid)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter currentBid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "startTime" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "startTime", c.Request.URL.Query(), &params.StartTime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startTime: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endTime" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "endTime", c.Request.URL.Query(), &params.EndTime)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endTime: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastItemID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastItemID", c.Request.URL.Query(), &params.LastItemID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastItemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "excludeEnded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeEnded", c.Request.URL.Query(), &params.ExcludeEnded)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter excludeEnded: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", c.Request.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter category: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", c.Request.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tags: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tagMatch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagMatch", c.Request.URL.Query(), &params.TagMatch)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagMatch: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeBids(c, params)
+}
+
+// GetMeNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetMeNotificationPreferences(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeNotificationPreferencesParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeNotificationPreferences(c, params)
+}
+
+// PutMeNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutMeNotificationPreferences(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutMeNotificationPreferencesParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutMeNotificationPreferences(c, params)
+}
+
+// GetMeNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetMeNotifications(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeNotificationsParams
+
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", c.Request.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unread: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastNotificationID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastNotificationID", c.Request.URL.Query(), &params.LastNotificationID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastNotificationID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeNotifications(c, params)
+}
+
+// PutMeNotificationsNotificationIDRead operation middleware
+func (siw *ServerInterfaceWrapper) PutMeNotificationsNotificationIDRead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "notificationID" -------------
+	var notificationID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationID", c.Param("notificationID"), &notificationID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter notificationID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutMeNotificationsNotificationIDReadParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutMeNotificationsNotificationIDRead(c, notificationID, params)
+}
+
+// GetMeWatchlist operation middleware
+func (siw *ServerInterfaceWrapper) GetMeWatchlist(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeWatchlistParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeWatchlist(c, params)
+}
+
+// GetMeWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetMeWebhooks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeWebhooksParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeWebhooks(c, params)
+}
+
+// PostMeWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostMeWebhooks(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostMeWebhooksParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMeWebhooks(c, params)
+}
+
+// DeleteMeWebhooksWebhookID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMeWebhooksWebhookID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMeWebhooksWebhookIDParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteMeWebhooksWebhookID(c, webhookID, params)
+}
+
+// GetMeWebhooksWebhookIDDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetMeWebhooksWebhookIDDeliveries(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMeWebhooksWebhookIDDeliveriesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastDeliveryID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastDeliveryID", c.Request.URL.Query(), &params.LastDeliveryID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastDeliveryID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMeWebhooksWebhookIDDeliveries(c, webhookID, params)
+}
+
+// PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", c.Param("webhookID"), &webhookID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter webhookID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "deliveryID" -------------
+	var deliveryID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryID", c.Param("deliveryID"), &deliveryID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deliveryID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(c, webhookID, deliveryID, params)
+}
+
+// PostModerationAuctionItemItemIDHide operation middleware
+func (siw *ServerInterfaceWrapper) PostModerationAuctionItemItemIDHide(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostModerationAuctionItemItemIDHideParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostModerationAuctionItemItemIDHide(c, itemID, params)
+}
+
+// PostModerationAuctionItemItemIDVoidBids operation middleware
+func (siw *ServerInterfaceWrapper) PostModerationAuctionItemItemIDVoidBids(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "itemID" -------------
+	var itemID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemID", c.Param("itemID"), &itemID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostModerationAuctionItemItemIDVoidBidsParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostModerationAuctionItemItemIDVoidBids(c, itemID, params)
+}
+
+// GetModerationCases operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationCases(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModerationCasesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastCaseID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastCaseID", c.Request.URL.Query(), &params.LastCaseID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastCaseID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetModerationCases(c, params)
+}
+
+// PostModerationCasesCaseIDDismiss operation middleware
+func (siw *ServerInterfaceWrapper) PostModerationCasesCaseIDDismiss(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "caseID" -------------
+	var caseID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "caseID", c.Param("caseID"), &caseID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter caseID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostModerationCasesCaseIDDismissParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostModerationCasesCaseIDDismiss(c, caseID, params)
+}
+
+// GetModerationShillFlags operation middleware
+func (siw *ServerInterfaceWrapper) GetModerationShillFlags(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetModerationShillFlagsParams
+
+	// ------------- Optional query parameter "reviewed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewed", c.Request.URL.Query(), &params.Reviewed)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter reviewed: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastFlagID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastFlagID", c.Request.URL.Query(), &params.LastFlagID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastFlagID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetModerationShillFlags(c, params)
+}
+
+// PostModerationShillFlagsFlagIDReview operation middleware
+func (siw *ServerInterfaceWrapper) PostModerationShillFlagsFlagIDReview(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "flagID" -------------
+	var flagID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "flagID", c.Param("flagID"), &flagID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter flagID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostModerationShillFlagsFlagIDReviewParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostModerationShillFlagsFlagIDReview(c, flagID, params)
+}
+
+// DeleteModerationUsersUsernameBan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteModerationUsersUsernameBan(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteModerationUsersUsernameBanParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteModerationUsersUsernameBan(c, username, params)
+}
+
+// PostModerationUsersUsernameBan operation middleware
+func (siw *ServerInterfaceWrapper) PostModerationUsersUsernameBan(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostModerationUsersUsernameBanParams
+
+	{
+		var cookie string
+
+		if cookie, err = c.Cookie("accessToken"); err == nil {
+			var value string
+			err = runtime.BindStyledParameterWithOptions("simple", "accessToken", cookie, &value, runtime.BindStyledParameterOptions{Explode: true, Required: false})
+			if err != nil {
+				siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter accessToken: %w", err), http.StatusBadRequest)
+				return
+			}
+			params.AccessToken = &value
+
+		}
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostModerationUsersUsernameBan(c, username, params)
+}
+
+// GetUsersUsernameFeedback operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUsernameFeedback(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "username" -------------
+	var username string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "username", c.Param("username"), &username, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter username: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersUsernameFeedbackParams
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "lastFeedbackID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastFeedbackID", c.Request.URL.Query(), &params.LastFeedbackID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lastFeedbackID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersUsernameFeedback(c, username, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/auction/categories", wrapper.GetAuctionCategories)
+	router.POST(options.BaseURL+"/auction/category", wrapper.PostAuctionCategory)
+	router.DELETE(options.BaseURL+"/auction/category/:categoryID", wrapper.DeleteAuctionCategoryCategoryID)
+	router.PATCH(options.BaseURL+"/auction/category/:categoryID", wrapper.PatchAuctionCategoryCategoryID)
+	router.POST(options.BaseURL+"/auction/item", wrapper.PostAuctionItem)
+	router.DELETE(options.BaseURL+"/auction/item/:itemID", wrapper.DeleteAuctionItemItemID)
+	router.GET(options.BaseURL+"/auction/item/:itemID", wrapper.GetAuctionItemItemID)
+	router.PATCH(options.BaseURL+"/auction/item/:itemID", wrapper.PatchAuctionItemItemID)
+	router.POST(options.BaseURL+"/auction/item/:itemID/accept", wrapper.PostAuctionItemItemIDAccept)
+	router.GET(options.BaseURL+"/auction/item/:itemID/bids", wrapper.GetAuctionItemItemIDBids)
+	router.POST(options.BaseURL+"/auction/item/:itemID/bids", wrapper.PostAuctionItemItemIDBids)
+	router.POST(options.BaseURL+"/auction/item/:itemID/buy-now", wrapper.PostAuctionItemItemIDBuyNow)
+	router.GET(options.BaseURL+"/auction/item/:itemID/events", wrapper.GetAuctionItemItemIDEvents)
+	router.GET(options.BaseURL+"/auction/item/:itemID/feedback", wrapper.GetAuctionItemItemIDFeedback)
+	router.POST(options.BaseURL+"/auction/item/:itemID/feedback", wrapper.PostAuctionItemItemIDFeedback)
+	router.GET(options.BaseURL+"/auction/item/:itemID/questions", wrapper.GetAuctionItemItemIDQuestions)
+	router.POST(options.BaseURL+"/auction/item/:itemID/questions", wrapper.PostAuctionItemItemIDQuestions)
+	router.POST(options.BaseURL+"/auction/item/:itemID/questions/:questionID/answer", wrapper.PostAuctionItemItemIDQuestionsQuestionIDAnswer)
+	router.POST(options.BaseURL+"/auction/item/:itemID/relist", wrapper.PostAuctionItemItemIDRelist)
+	router.POST(options.BaseURL+"/auction/item/:itemID/report", wrapper.PostAuctionItemItemIDReport)
+	router.DELETE(options.BaseURL+"/auction/item/:itemID/watch", wrapper.DeleteAuctionItemItemIDWatch)
+	router.PUT(options.BaseURL+"/auction/item/:itemID/watch", wrapper.PutAuctionItemItemIDWatch)
+	router.GET(options.BaseURL+"/auction/items", wrapper.GetAuctionItems)
+	router.GET(options.BaseURL+"/auction/suggest", wrapper.GetAuctionSuggest)
+	router.POST(options.BaseURL+"/auction/suggest/rebuild", wrapper.PostAuctionSuggestRebuild)
+	router.GET(options.BaseURL+"/auth/callback", wrapper.GetAuthCallback)
+	router.GET(options.BaseURL+"/auth/login", wrapper.GetAuthLogin)
+	router.GET(options.BaseURL+"/auth/logout", wrapper.GetAuthLogout)
+	router.POST(options.BaseURL+"/image", wrapper.PostImage)
+	router.GET(options.BaseURL+"/me/auctions", wrapper.GetMeAuctions)
+	router.GET(options.BaseURL+"/me/bids", wrapper.GetMeBids)
+	router.GET(options.BaseURL+"/me/notification-preferences", wrapper.GetMeNotificationPreferences)
+	router.PUT(options.BaseURL+"/me/notification-preferences", wrapper.PutMeNotificationPreferences)
+	router.GET(options.BaseURL+"/me/notifications", wrapper.GetMeNotifications)
+	router.PUT(options.BaseURL+"/me/notifications/:notificationID/read", wrapper.PutMeNotificationsNotificationIDRead)
+	router.GET(options.BaseURL+"/me/watchlist", wrapper.GetMeWatchlist)
+	router.GET(options.BaseURL+"/me/webhooks", wrapper.GetMeWebhooks)
+	router.POST(options.BaseURL+"/me/webhooks", wrapper.PostMeWebhooks)
+	router.DELETE(options.BaseURL+"/me/webhooks/:webhookID", wrapper.DeleteMeWebhooksWebhookID)
+	router.GET(options.BaseURL+"/me/webhooks/:webhookID/deliveries", wrapper.GetMeWebhooksWebhookIDDeliveries)
+	router.POST(options.BaseURL+"/me/webhooks/:webhookID/deliveries/:deliveryID/redeliver", wrapper.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver)
+	router.POST(options.BaseURL+"/moderation/auction/item/:itemID/hide", wrapper.PostModerationAuctionItemItemIDHide)
+	router.POST(options.BaseURL+"/moderation/auction/item/:itemID/void-bids", wrapper.PostModerationAuctionItemItemIDVoidBids)
+	router.GET(options.BaseURL+"/moderation/cases", wrapper.GetModerationCases)
+	router.POST(options.BaseURL+"/moderation/cases/:caseID/dismiss", wrapper.PostModerationCasesCaseIDDismiss)
+	router.GET(options.BaseURL+"/moderation/shill-flags", wrapper.GetModerationShillFlags)
+	router.POST(options.BaseURL+"/moderation/shill-flags/:flagID/review", wrapper.PostModerationShillFlagsFlagIDReview)
+	router.DELETE(options.BaseURL+"/moderation/users/:username/ban", wrapper.DeleteModerationUsersUsernameBan)
+	router.POST(options.BaseURL+"/moderation/users/:username/ban", wrapper.PostModerationUsersUsernameBan)
+	router.GET(options.BaseURL+"/users/:username/feedback", wrapper.GetUsersUsernameFeedback)
+}
+
+type GetAuctionCategoriesRequestObject struct {
+}
+
+type GetAuctionCategoriesResponseObject interface {
+	VisitGetAuctionCategoriesResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionCategories200JSONResponse []Category
+
+func (response GetAuctionCategories200JSONResponse) VisitGetAuctionCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionCategoryRequestObject struct {
+	Params PostAuctionCategoryParams
+	Body   *PostAuctionCategoryJSONRequestBody
+}
+
+type PostAuctionCategoryResponseObject interface {
+	VisitPostAuctionCategoryResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionCategory201ResponseHeaders struct {
+	Location string
+}
+
+type PostAuctionCategory201Response struct {
+	Headers PostAuctionCategory201ResponseHeaders
+}
+
+func (response PostAuctionCategory201Response) VisitPostAuctionCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", response.Headers.Location)
+
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostAuctionCategory400JSONResponse ApiResponse
+
+func (response PostAuctionCategory400JSONResponse) VisitPostAuctionCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionCategory401Response struct {
+}
+
+func (response PostAuctionCategory401Response) VisitPostAuctionCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionCategory403Response struct {
+}
+
+func (response PostAuctionCategory403Response) VisitPostAuctionCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteAuctionCategoryCategoryIDRequestObject struct {
+	CategoryID openapi_types.UUID `json:"categoryID"`
+	Params     DeleteAuctionCategoryCategoryIDParams
+}
+
+type DeleteAuctionCategoryCategoryIDResponseObject interface {
+	VisitDeleteAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAuctionCategoryCategoryID204Response struct {
+}
+
+func (response DeleteAuctionCategoryCategoryID204Response) VisitDeleteAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAuctionCategoryCategoryID401Response struct {
+}
+
+func (response DeleteAuctionCategoryCategoryID401Response) VisitDeleteAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteAuctionCategoryCategoryID403Response struct {
+}
+
+func (response DeleteAuctionCategoryCategoryID403Response) VisitDeleteAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteAuctionCategoryCategoryID404Response struct {
+}
+
+func (response DeleteAuctionCategoryCategoryID404Response) VisitDeleteAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteAuctionCategoryCategoryID409JSONResponse ApiResponse
+
+func (response DeleteAuctionCategoryCategoryID409JSONResponse) VisitDeleteAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAuctionCategoryCategoryIDRequestObject struct {
+	CategoryID openapi_types.UUID `json:"categoryID"`
+	Params     PatchAuctionCategoryCategoryIDParams
+	Body       *PatchAuctionCategoryCategoryIDJSONRequestBody
+}
+
+type PatchAuctionCategoryCategoryIDResponseObject interface {
+	VisitPatchAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error
+}
+
+type PatchAuctionCategoryCategoryID200Response struct {
+}
+
+func (response PatchAuctionCategoryCategoryID200Response) VisitPatchAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchAuctionCategoryCategoryID400JSONResponse ApiResponse
+
+func (response PatchAuctionCategoryCategoryID400JSONResponse) VisitPatchAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAuctionCategoryCategoryID401Response struct {
+}
+
+func (response PatchAuctionCategoryCategoryID401Response) VisitPatchAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchAuctionCategoryCategoryID403Response struct {
+}
+
+func (response PatchAuctionCategoryCategoryID403Response) VisitPatchAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PatchAuctionCategoryCategoryID404Response struct {
+}
+
+func (response PatchAuctionCategoryCategoryID404Response) VisitPatchAuctionCategoryCategoryIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemRequestObject struct {
+	Params PostAuctionItemParams
+	Body   *PostAuctionItemJSONRequestBody
+}
+
+type PostAuctionItemResponseObject interface {
+	VisitPostAuctionItemResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItem201ResponseHeaders struct {
+	Location string
+}
+
+type PostAuctionItem201Response struct {
+	Headers PostAuctionItem201ResponseHeaders
+}
+
+func (response PostAuctionItem201Response) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", response.Headers.Location)
+
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostAuctionItem400JSONResponse ApiResponse
+
+func (response PostAuctionItem400JSONResponse) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItem401Response struct {
+}
+
+func (response PostAuctionItem401Response) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItem403JSONResponse ApiResponse
+
+func (response PostAuctionItem403JSONResponse) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItem422JSONResponse ApiResponse
+
+func (response PostAuctionItem422JSONResponse) VisitPostAuctionItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuctionItemItemIDRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params DeleteAuctionItemItemIDParams
+}
+
+type DeleteAuctionItemItemIDResponseObject interface {
+	VisitDeleteAuctionItemItemIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteAuctionItemItemID204Response struct {
+}
+
+func (response DeleteAuctionItemItemID204Response) VisitDeleteAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAuctionItemItemID401Response struct {
+}
+
+func (response DeleteAuctionItemItemID401Response) VisitDeleteAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteAuctionItemItemID403Response struct {
+}
+
+func (response DeleteAuctionItemItemID403Response) VisitDeleteAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteAuctionItemItemID404Response struct {
+}
+
+func (response DeleteAuctionItemItemID404Response) VisitDeleteAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DeleteAuctionItemItemID410Response struct {
+}
+
+func (response DeleteAuctionItemItemID410Response) VisitDeleteAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(410)
+	return nil
+}
+
+type GetAuctionItemItemIDRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params GetAuctionItemItemIDParams
+}
+
+type GetAuctionItemItemIDResponseObject interface {
+	VisitGetAuctionItemItemIDResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionItemItemID200JSONResponse struct {
+	// BidCount Total number of bids.
+	BidCount int `json:"bidCount"`
+
+	// BidIncrement Minimum increment rule for the next bid.
+	//   - fixed: every bid must raise the price by at least `step`.
+	//   - percent: every bid must raise the price by at least `percent` percent of the current price (rounded up).
+	//   - tiered: the `step` of the last tier whose `from` is not above the current price applies. Tiers must start from 0 and be sorted by `from`.
+	BidIncrement *BidIncrement `json:"bidIncrement,omitempty"`
+
+	// BidRecords The latest bids, newest first. Use `GET /auction/item/{itemID}/bids` for the full history.
+	BidRecords []BidRecord `json:"bidRecords"`
+
+	// BidderCount Number of unique bidders.
+	BidderCount int `json:"bidderCount"`
+
+	// BuyNowPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	BuyNowPrice *Money    `json:"buyNowPrice,omitempty"`
+	Carousels   []string  `json:"carousels"`
+	Category    *Category `json:"category,omitempty"`
+
+	// ClearingPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	ClearingPrice *Money `json:"clearingPrice,omitempty"`
+
+	// Currency ISO 4217 currency code of all amounts of an auction.
+	Currency Currency `json:"currency"`
+
+	// CurrentPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	CurrentPrice *Money `json:"currentPrice,omitempty"`
+	Description  string `json:"description"`
+
+	// Dutch The price starts at the starting price and drops by `decrement` every `interval` seconds until it reaches `floorPrice`.
+	Dutch   *DutchSchedule `json:"dutch,omitempty"`
+	EndTime time.Time      `json:"endTime"`
+
+	// FinalPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	FinalPrice *Money `json:"finalPrice,omitempty"`
+	Quantity   uint32 `json:"quantity"`
+
+	// ReserveMet Whether the current bid has reached the hidden reserve price. Omitted when the auction has no reserve price.
+	ReserveMet *bool `json:"reserveMet,omitempty"`
+
+	// SoftClose A bid placed within `window` seconds before the end time pushes the end time to `extension` seconds after the bid.
+	SoftClose *SoftClose `json:"softClose,omitempty"`
+
+	// StartPrice Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	StartPrice Money         `json:"startPrice"`
+	StartTime  time.Time     `json:"startTime"`
+	Status     AuctionStatus `json:"status"`
+	Tags       []string      `json:"tags"`
+	Title      string        `json:"title"`
+
+	// Type Auction mode.
+	//   - english: buyers bid the price up, the highest bid wins.
+	//   - dutch: the price drops on a fixed schedule, the first buyer to accept wins.
+	//   - sealed_first: bids are hidden until the auction ends, the highest bidder pays their own bid.
+	//   - sealed_second: bids are hidden until the auction ends, the highest bidder pays the second-highest bid (Vickrey).
+	Type       AuctionType `json:"type"`
+	WinningBid *BidEvent   `json:"winningBid,omitempty"`
+
+	// WinningBids Bids currently allocated units in a multi-quantity auction, ranked by amount. Omitted for single-unit auctions.
+	WinningBids *[]WinningBid `json:"winningBids,omitempty"`
+}
+
+func (response GetAuctionItemItemID200JSONResponse) VisitGetAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemID404Response struct {
+}
+
+func (response GetAuctionItemItemID404Response) VisitGetAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PatchAuctionItemItemIDRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PatchAuctionItemItemIDParams
+	Body   *PatchAuctionItemItemIDJSONRequestBody
+}
+
+type PatchAuctionItemItemIDResponseObject interface {
+	VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error
+}
+
+type PatchAuctionItemItemID200Response struct {
+}
+
+func (response PatchAuctionItemItemID200Response) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PatchAuctionItemItemID400JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PatchAuctionItemItemID400JSONResponse) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAuctionItemItemID401Response struct {
+}
+
+func (response PatchAuctionItemItemID401Response) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PatchAuctionItemItemID403Response struct {
+}
+
+func (response PatchAuctionItemItemID403Response) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PatchAuctionItemItemID404Response struct {
+}
+
+func (response PatchAuctionItemItemID404Response) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PatchAuctionItemItemID409JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PatchAuctionItemItemID409JSONResponse) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAuctionItemItemID410Response struct {
+}
+
+func (response PatchAuctionItemItemID410Response) VisitPatchAuctionItemItemIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(410)
+	return nil
+}
+
+type PostAuctionItemItemIDAcceptRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDAcceptParams
+}
+
+type PostAuctionItemItemIDAcceptResponseObject interface {
+	VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDAccept200JSONResponse struct {
+	// Price Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	Price Money `json:"price"`
+}
+
+func (response PostAuctionItemItemIDAccept200JSONResponse) VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDAccept401Response struct {
+}
+
+func (response PostAuctionItemItemIDAccept401Response) VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDAccept403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDAccept403JSONResponse) VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDAccept404Response struct {
+}
+
+func (response PostAuctionItemItemIDAccept404Response) VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDAccept409JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDAccept409JSONResponse) VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDAccept410Response struct {
+}
+
+func (response PostAuctionItemItemIDAccept410Response) VisitPostAuctionItemItemIDAcceptResponse(w http.ResponseWriter) error {
+	w.WriteHeader(410)
+	return nil
+}
+
+type GetAuctionItemItemIDBidsRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params GetAuctionItemItemIDBidsParams
+}
+
+type GetAuctionItemItemIDBidsResponseObject interface {
+	VisitGetAuctionItemItemIDBidsResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionItemItemIDBids200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []BidRecord `json:"items"`
+}
+
+func (response GetAuctionItemItemIDBids200JSONResponse) VisitGetAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDBids400JSONResponse ApiResponse
+
+func (response GetAuctionItemItemIDBids400JSONResponse) VisitGetAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDBids404Response struct {
+}
+
+func (response GetAuctionItemItemIDBids404Response) VisitGetAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDBidsRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDBidsParams
+	Body   *PostAuctionItemItemIDBidsJSONRequestBody
+}
+
+type PostAuctionItemItemIDBidsResponseObject interface {
+	VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDBids200Response struct {
+}
+
+func (response PostAuctionItemItemIDBids200Response) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuctionItemItemIDBids400JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+
+	// MinimumBid Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+	MinimumBid *Money `json:"minimumBid,omitempty"`
+}
+
+func (response PostAuctionItemItemIDBids400JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDBids401Response struct {
+}
+
+func (response PostAuctionItemItemIDBids401Response) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDBids403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDBids403JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDBids404Response struct {
+}
+
+func (response PostAuctionItemItemIDBids404Response) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDBids410JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDBids410JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDBids422JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDBids422JSONResponse) VisitPostAuctionItemItemIDBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDBuyNowRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDBuyNowParams
+}
+
+type PostAuctionItemItemIDBuyNowResponseObject interface {
+	VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDBuyNow200Response struct {
+}
+
+func (response PostAuctionItemItemIDBuyNow200Response) VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostAuctionItemItemIDBuyNow401Response struct {
+}
+
+func (response PostAuctionItemItemIDBuyNow401Response) VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDBuyNow403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDBuyNow403JSONResponse) VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDBuyNow404Response struct {
+}
+
+func (response PostAuctionItemItemIDBuyNow404Response) VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDBuyNow409JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDBuyNow409JSONResponse) VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDBuyNow410Response struct {
+}
+
+func (response PostAuctionItemItemIDBuyNow410Response) VisitPostAuctionItemItemIDBuyNowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(410)
+	return nil
+}
+
+type GetAuctionItemItemIDEventsRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+}
+
+type GetAuctionItemItemIDEventsResponseObject interface {
+	VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionItemItemIDEvents200Response struct {
+}
+
+func (response GetAuctionItemItemIDEvents200Response) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetAuctionItemItemIDEvents403JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetAuctionItemItemIDEvents403JSONResponse) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDEvents404Response struct {
+}
+
+func (response GetAuctionItemItemIDEvents404Response) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetAuctionItemItemIDEvents410JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response GetAuctionItemItemIDEvents410JSONResponse) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(410)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDFeedbackRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+}
+
+type GetAuctionItemItemIDFeedbackResponseObject interface {
+	VisitGetAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionItemItemIDFeedback200JSONResponse []Feedback
+
+func (response GetAuctionItemItemIDFeedback200JSONResponse) VisitGetAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDFeedback404Response struct {
+}
+
+func (response GetAuctionItemItemIDFeedback404Response) VisitGetAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDFeedbackRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDFeedbackParams
+	Body   *PostAuctionItemItemIDFeedbackJSONRequestBody
+}
+
+type PostAuctionItemItemIDFeedbackResponseObject interface {
+	VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDFeedback201JSONResponse Feedback
+
+func (response PostAuctionItemItemIDFeedback201JSONResponse) VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDFeedback400JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDFeedback400JSONResponse) VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDFeedback401Response struct {
+}
+
+func (response PostAuctionItemItemIDFeedback401Response) VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDFeedback403Response struct {
+}
+
+func (response PostAuctionItemItemIDFeedback403Response) VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostAuctionItemItemIDFeedback404Response struct {
+}
+
+func (response PostAuctionItemItemIDFeedback404Response) VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDFeedback409JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDFeedback409JSONResponse) VisitPostAuctionItemItemIDFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDQuestionsRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params GetAuctionItemItemIDQuestionsParams
+}
+
+type GetAuctionItemItemIDQuestionsResponseObject interface {
+	VisitGetAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionItemItemIDQuestions200JSONResponse []Question
+
+func (response GetAuctionItemItemIDQuestions200JSONResponse) VisitGetAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItemItemIDQuestions404Response struct {
+}
+
+func (response GetAuctionItemItemIDQuestions404Response) VisitGetAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestionsRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDQuestionsParams
+	Body   *PostAuctionItemItemIDQuestionsJSONRequestBody
+}
+
+type PostAuctionItemItemIDQuestionsResponseObject interface {
+	VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDQuestions201JSONResponse Question
+
+func (response PostAuctionItemItemIDQuestions201JSONResponse) VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDQuestions400JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDQuestions400JSONResponse) VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDQuestions401Response struct {
+}
+
+func (response PostAuctionItemItemIDQuestions401Response) VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestions403Response struct {
+}
+
+func (response PostAuctionItemItemIDQuestions403Response) VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestions404Response struct {
+}
+
+func (response PostAuctionItemItemIDQuestions404Response) VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestions409JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDQuestions409JSONResponse) VisitPostAuctionItemItemIDQuestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswerRequestObject struct {
+	ItemID     openapi_types.UUID `json:"itemID"`
+	QuestionID openapi_types.UUID `json:"questionID"`
+	Params     PostAuctionItemItemIDQuestionsQuestionIDAnswerParams
+	Body       *PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONRequestBody
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswerResponseObject interface {
+	VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswer200JSONResponse Question
+
+func (response PostAuctionItemItemIDQuestionsQuestionIDAnswer200JSONResponse) VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswer400JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDQuestionsQuestionIDAnswer400JSONResponse) VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswer401Response struct {
+}
+
+func (response PostAuctionItemItemIDQuestionsQuestionIDAnswer401Response) VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswer403Response struct {
+}
+
+func (response PostAuctionItemItemIDQuestionsQuestionIDAnswer403Response) VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswer404Response struct {
+}
+
+func (response PostAuctionItemItemIDQuestionsQuestionIDAnswer404Response) VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDQuestionsQuestionIDAnswer409JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDQuestionsQuestionIDAnswer409JSONResponse) VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDRelistRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDRelistParams
+	Body   *PostAuctionItemItemIDRelistJSONRequestBody
+}
+
+type PostAuctionItemItemIDRelistResponseObject interface {
+	VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDRelist201ResponseHeaders struct {
+	Location string
+}
+
+type PostAuctionItemItemIDRelist201Response struct {
+	Headers PostAuctionItemItemIDRelist201ResponseHeaders
+}
+
+func (response PostAuctionItemItemIDRelist201Response) VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", response.Headers.Location)
+
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostAuctionItemItemIDRelist400JSONResponse struct {
+	Message *string `json:"message,omitempty"`
+}
+
+func (response PostAuctionItemItemIDRelist400JSONResponse) VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDRelist401Response struct {
+}
+
+func (response PostAuctionItemItemIDRelist401Response) VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDRelist403Response struct {
+}
+
+func (response PostAuctionItemItemIDRelist403Response) VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostAuctionItemItemIDRelist404Response struct {
+}
+
+func (response PostAuctionItemItemIDRelist404Response) VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDRelist409Response struct {
+}
+
+func (response PostAuctionItemItemIDRelist409Response) VisitPostAuctionItemItemIDRelistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type PostAuctionItemItemIDReportRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostAuctionItemItemIDReportParams
+	Body   *PostAuctionItemItemIDReportJSONRequestBody
+}
+
+type PostAuctionItemItemIDReportResponseObject interface {
+	VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionItemItemIDReport201JSONResponse Report
+
+func (response PostAuctionItemItemIDReport201JSONResponse) VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDReport400JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDReport400JSONResponse) VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionItemItemIDReport401Response struct {
+}
+
+func (response PostAuctionItemItemIDReport401Response) VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionItemItemIDReport403Response struct {
+}
+
+func (response PostAuctionItemItemIDReport403Response) VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostAuctionItemItemIDReport404Response struct {
+}
+
+func (response PostAuctionItemItemIDReport404Response) VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostAuctionItemItemIDReport409JSONResponse ApiResponse
+
+func (response PostAuctionItemItemIDReport409JSONResponse) VisitPostAuctionItemItemIDReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAuctionItemItemIDWatchRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params DeleteAuctionItemItemIDWatchParams
+}
+
+type DeleteAuctionItemItemIDWatchResponseObject interface {
+	VisitDeleteAuctionItemItemIDWatchResponse(w http.ResponseWriter) error
+}
+
+type DeleteAuctionItemItemIDWatch204Response struct {
+}
+
+func (response DeleteAuctionItemItemIDWatch204Response) VisitDeleteAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAuctionItemItemIDWatch401Response struct {
+}
+
+func (response DeleteAuctionItemItemIDWatch401Response) VisitDeleteAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutAuctionItemItemIDWatchRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PutAuctionItemItemIDWatchParams
+}
+
+type PutAuctionItemItemIDWatchResponseObject interface {
+	VisitPutAuctionItemItemIDWatchResponse(w http.ResponseWriter) error
+}
+
+type PutAuctionItemItemIDWatch204Response struct {
+}
+
+func (response PutAuctionItemItemIDWatch204Response) VisitPutAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutAuctionItemItemIDWatch401Response struct {
+}
+
+func (response PutAuctionItemItemIDWatch401Response) VisitPutAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutAuctionItemItemIDWatch404Response struct {
+}
+
+func (response PutAuctionItemItemIDWatch404Response) VisitPutAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PutAuctionItemItemIDWatch409JSONResponse ApiResponse
+
+func (response PutAuctionItemItemIDWatch409JSONResponse) VisitPutAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutAuctionItemItemIDWatch410Response struct {
+}
+
+func (response PutAuctionItemItemIDWatch410Response) VisitPutAuctionItemItemIDWatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(410)
+	return nil
+}
+
+type GetAuctionItemsRequestObject struct {
+	Params GetAuctionItemsParams
+}
+
+type GetAuctionItemsResponseObject interface {
+	VisitGetAuctionItemsResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionItems200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []AuctionListItem `json:"items"`
+}
+
+func (response GetAuctionItems200JSONResponse) VisitGetAuctionItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItems400JSONResponse ApiResponse
+
+func (response GetAuctionItems400JSONResponse) VisitGetAuctionItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionItems404Response struct {
+}
+
+func (response GetAuctionItems404Response) VisitGetAuctionItemsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetAuctionSuggestRequestObject struct {
+	Params GetAuctionSuggestParams
+}
+
+type GetAuctionSuggestResponseObject interface {
+	VisitGetAuctionSuggestResponse(w http.ResponseWriter) error
+}
+
+type GetAuctionSuggest200JSONResponse struct {
+	Queries []string `json:"queries"`
+	Titles  []string `json:"titles"`
+}
+
+func (response GetAuctionSuggest200JSONResponse) VisitGetAuctionSuggestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuctionSuggest400JSONResponse ApiResponse
+
+func (response GetAuctionSuggest400JSONResponse) VisitGetAuctionSuggestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionSuggestRebuildRequestObject struct {
+	Params PostAuctionSuggestRebuildParams
+}
+
+type PostAuctionSuggestRebuildResponseObject interface {
+	VisitPostAuctionSuggestRebuildResponse(w http.ResponseWriter) error
+}
+
+type PostAuctionSuggestRebuild200JSONResponse struct {
+	// Indexed Number of auction items indexed.
+	Indexed int `json:"indexed"`
+}
+
+func (response PostAuctionSuggestRebuild200JSONResponse) VisitPostAuctionSuggestRebuildResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuctionSuggestRebuild401Response struct {
+}
+
+func (response PostAuctionSuggestRebuild401Response) VisitPostAuctionSuggestRebuildResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostAuctionSuggestRebuild403Response struct {
+}
+
+func (response PostAuctionSuggestRebuild403Response) VisitPostAuctionSuggestRebuildResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetAuthCallbackRequestObject struct {
+	Params GetAuthCallbackParams
+}
+
+type GetAuthCallbackResponseObject interface {
+	VisitGetAuthCallbackResponse(w http.ResponseWriter) error
+}
+
+type GetAuthCallback200ResponseHeaders struct {
+	Location                                      string
+	SetCookieAccessTokenHttpOnlySecureMaxAge10800 string
+	SetCookieUsernameMaxAge10800                  string
+	UnsetCookieRequestNonceHttpOnlySecure         string
+	UnsetCookieRequestStateHttpOnlySecure         string
+}
+
+type GetAuthCallback200Response struct {
+	Headers GetAuthCallback200ResponseHeaders
+}
+
+func (response GetAuthCallback200Response) VisitGetAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", response.Headers.Location)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "accessToken",
+		Value:    response.Headers.SetCookieAccessTokenHttpOnlySecureMaxAge10800,
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   10800,
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "username",
+		Value:    response.Headers.SetCookieUsernameMaxAge10800,
+		Secure:   false,
+		HttpOnly: false,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   10800,
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "requestNonce",
+		Value:    "", // 清空 cookie
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Unix(0, 0),
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "requestState",
+		Value:    "", // 清空 cookie
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Unix(0, 0),
+	})
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetAuthCallback403Response struct {
+}
+
+func (response GetAuthCallback403Response) VisitGetAuthCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetAuthLoginRequestObject struct {
+	Params GetAuthLoginParams
+}
+
+type GetAuthLoginResponseObject interface {
+	VisitGetAuthLoginResponse(w http.ResponseWriter) error
+}
+
+type GetAuthLogin200ResponseHeaders struct {
+	Location                                     string
+	SetCookieRequestNonceHttpOnlySecureMaxAge120 string
+	SetCookieRequestStateHttpOnlySecureMaxAge120 string
+}
+
+type GetAuthLogin200Response struct {
+	Headers GetAuthLogin200ResponseHeaders
+}
+
+func (response GetAuthLogin200Response) VisitGetAuthLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", response.Headers.Location)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "requestNonce",
+		Value:    response.Headers.SetCookieRequestNonceHttpOnlySecureMaxAge120,
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   120,
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "requestState",
+		Value:    response.Headers.SetCookieRequestStateHttpOnlySecureMaxAge120,
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   120,
+	})
+	w.WriteHeader(200)
+	return nil
+}
+
+type GetAuthLogoutRequestObject struct {
+	Params GetAuthLogoutParams
+}
+
+type GetAuthLogoutResponseObject interface {
+	VisitGetAuthLogoutResponse(w http.ResponseWriter) error
+}
+
+type GetAuthLogout200ResponseHeaders struct {
+	UnsetCookieAccessTokenHttpOnlySecure string
+	UnsetCookieUsernameHttpOnlySecure    string
+}
+
+type GetAuthLogout200Response struct {
+	Headers GetAuthLogout200ResponseHeaders
+}
+
+func (response GetAuthLogout200Response) VisitGetAuthLogoutResponse(w http.ResponseWriter) error {
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "accessToken",
+		Value:    "", // 清空 cookie
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Unix(0, 0),
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     "username",
+		Value:    "", // 清空 cookie
+		Secure:   true,
+		HttpOnly: true,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Unix(0, 0),
+	})
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostImageRequestObject struct {
+	Params PostImageParams
+	Body   io.Reader
+}
+
+type PostImageResponseObject interface {
+	VisitPostImageResponse(w http.ResponseWriter) error
+}
+
+type PostImage201ResponseHeaders struct {
+	Location string
+}
+
+type PostImage201Response struct {
+	Headers PostImage201ResponseHeaders
+}
+
+func (response PostImage201Response) VisitPostImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", response.Headers.Location)
+
+	w.WriteHeader(201)
+	return nil
+}
+
+type PostImage400JSONResponse ApiResponse
+
+func (response PostImage400JSONResponse) VisitPostImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImage401Response struct {
+}
+
+func (response PostImage401Response) VisitPostImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostImage429Response struct {
+}
+
+func (response PostImage429Response) VisitPostImageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type GetMeAuctionsRequestObject struct {
+	Params GetMeAuctionsParams
+}
+
+type GetMeAuctionsResponseObject interface {
+	VisitGetMeAuctionsResponse(w http.ResponseWriter) error
+}
+
+type GetMeAuctions200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []AuctionListItem `json:"items"`
+}
+
+func (response GetMeAuctions200JSONResponse) VisitGetMeAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeAuctions400JSONResponse ApiResponse
+
+func (response GetMeAuctions400JSONResponse) VisitGetMeAuctionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeAuctions401Response struct {
+}
+
+func (response GetMeAuctions401Response) VisitGetMeAuctionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMeBidsRequestObject struct {
+	Params GetMeBidsParams
+}
+
+type GetMeBidsResponseObject interface {
+	VisitGetMeBidsResponse(w http.ResponseWriter) error
+}
+
+type GetMeBids200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []MyBidItem `json:"items"`
+}
+
+func (response GetMeBids200JSONResponse) VisitGetMeBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeBids400JSONResponse ApiResponse
+
+func (response GetMeBids400JSONResponse) VisitGetMeBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeBids401Response struct {
+}
+
+func (response GetMeBids401Response) VisitGetMeBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMeNotificationPreferencesRequestObject struct {
+	Params GetMeNotificationPreferencesParams
+}
+
+type GetMeNotificationPreferencesResponseObject interface {
+	VisitGetMeNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetMeNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response GetMeNotificationPreferences200JSONResponse) VisitGetMeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeNotificationPreferences401Response struct {
+}
+
+func (response GetMeNotificationPreferences401Response) VisitGetMeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutMeNotificationPreferencesRequestObject struct {
+	Params PutMeNotificationPreferencesParams
+	Body   *PutMeNotificationPreferencesJSONRequestBody
+}
+
+type PutMeNotificationPreferencesResponseObject interface {
+	VisitPutMeNotificationPreferencesResponse(w http.ResponseWriter) error
+}
+
+type PutMeNotificationPreferences200JSONResponse NotificationPreferences
+
+func (response PutMeNotificationPreferences200JSONResponse) VisitPutMeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMeNotificationPreferences400JSONResponse ApiResponse
+
+func (response PutMeNotificationPreferences400JSONResponse) VisitPutMeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutMeNotificationPreferences401Response struct {
+}
+
+func (response PutMeNotificationPreferences401Response) VisitPutMeNotificationPreferencesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMeNotificationsRequestObject struct {
+	Params GetMeNotificationsParams
+}
+
+type GetMeNotificationsResponseObject interface {
+	VisitGetMeNotificationsResponse(w http.ResponseWriter) error
+}
+
+type GetMeNotifications200JSONResponse struct {
+	Count int            `json:"count"`
+	Items []Notification `json:"items"`
+
+	// Unread Total number of unread notifications.
+	Unread int `json:"unread"`
+}
+
+func (response GetMeNotifications200JSONResponse) VisitGetMeNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeNotifications400JSONResponse ApiResponse
+
+func (response GetMeNotifications400JSONResponse) VisitGetMeNotificationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeNotifications401Response struct {
+}
+
+func (response GetMeNotifications401Response) VisitGetMeNotificationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutMeNotificationsNotificationIDReadRequestObject struct {
+	NotificationID openapi_types.UUID `json:"notificationID"`
+	Params         PutMeNotificationsNotificationIDReadParams
+}
+
+type PutMeNotificationsNotificationIDReadResponseObject interface {
+	VisitPutMeNotificationsNotificationIDReadResponse(w http.ResponseWriter) error
+}
+
+type PutMeNotificationsNotificationIDRead204Response struct {
+}
+
+func (response PutMeNotificationsNotificationIDRead204Response) VisitPutMeNotificationsNotificationIDReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutMeNotificationsNotificationIDRead401Response struct {
+}
+
+func (response PutMeNotificationsNotificationIDRead401Response) VisitPutMeNotificationsNotificationIDReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PutMeNotificationsNotificationIDRead404Response struct {
+}
+
+func (response PutMeNotificationsNotificationIDRead404Response) VisitPutMeNotificationsNotificationIDReadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetMeWatchlistRequestObject struct {
+	Params GetMeWatchlistParams
+}
+
+type GetMeWatchlistResponseObject interface {
+	VisitGetMeWatchlistResponse(w http.ResponseWriter) error
+}
+
+type GetMeWatchlist200JSONResponse []WatchlistItem
+
+func (response GetMeWatchlist200JSONResponse) VisitGetMeWatchlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeWatchlist400JSONResponse ApiResponse
+
+func (response GetMeWatchlist400JSONResponse) VisitGetMeWatchlistResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeWatchlist401Response struct {
+}
+
+func (response GetMeWatchlist401Response) VisitGetMeWatchlistResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMeWebhooksRequestObject struct {
+	Params GetMeWebhooksParams
+}
+
+type GetMeWebhooksResponseObject interface {
+	VisitGetMeWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetMeWebhooks200JSONResponse []WebhookEndpoint
+
+func (response GetMeWebhooks200JSONResponse) VisitGetMeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeWebhooks401Response struct {
+}
+
+func (response GetMeWebhooks401Response) VisitGetMeWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostMeWebhooksRequestObject struct {
+	Params PostMeWebhooksParams
+	Body   *PostMeWebhooksJSONRequestBody
+}
+
+type PostMeWebhooksResponseObject interface {
+	VisitPostMeWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostMeWebhooks201JSONResponse struct {
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description string             `json:"description"`
+	Events      []WebhookEventType `json:"events"`
+	Id          openapi_types.UUID `json:"id"`
+
+	// Secret Secret for verifying the signature of webhook requests.
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+func (response PostMeWebhooks201JSONResponse) VisitPostMeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMeWebhooks400JSONResponse ApiResponse
+
+func (response PostMeWebhooks400JSONResponse) VisitPostMeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMeWebhooks401Response struct {
+}
+
+func (response PostMeWebhooks401Response) VisitPostMeWebhooksResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostMeWebhooks409JSONResponse ApiResponse
+
+func (response PostMeWebhooks409JSONResponse) VisitPostMeWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMeWebhooksWebhookIDRequestObject struct {
+	WebhookID openapi_types.UUID `json:"webhookID"`
+	Params    DeleteMeWebhooksWebhookIDParams
+}
+
+type DeleteMeWebhooksWebhookIDResponseObject interface {
+	VisitDeleteMeWebhooksWebhookIDResponse(w http.ResponseWriter) error
+}
+
+type DeleteMeWebhooksWebhookID204Response struct {
+}
+
+func (response DeleteMeWebhooksWebhookID204Response) VisitDeleteMeWebhooksWebhookIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteMeWebhooksWebhookID401Response struct {
+}
+
+func (response DeleteMeWebhooksWebhookID401Response) VisitDeleteMeWebhooksWebhookIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteMeWebhooksWebhookID404Response struct {
+}
+
+func (response DeleteMeWebhooksWebhookID404Response) VisitDeleteMeWebhooksWebhookIDResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetMeWebhooksWebhookIDDeliveriesRequestObject struct {
+	WebhookID openapi_types.UUID `json:"webhookID"`
+	Params    GetMeWebhooksWebhookIDDeliveriesParams
+}
+
+type GetMeWebhooksWebhookIDDeliveriesResponseObject interface {
+	VisitGetMeWebhooksWebhookIDDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetMeWebhooksWebhookIDDeliveries200JSONResponse struct {
+	Count int               `json:"count"`
+	Items []WebhookDelivery `json:"items"`
+}
+
+func (response GetMeWebhooksWebhookIDDeliveries200JSONResponse) VisitGetMeWebhooksWebhookIDDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeWebhooksWebhookIDDeliveries400JSONResponse ApiResponse
+
+func (response GetMeWebhooksWebhookIDDeliveries400JSONResponse) VisitGetMeWebhooksWebhookIDDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeWebhooksWebhookIDDeliveries401Response struct {
+}
+
+func (response GetMeWebhooksWebhookIDDeliveries401Response) VisitGetMeWebhooksWebhookIDDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetMeWebhooksWebhookIDDeliveries404Response struct {
+}
+
+func (response GetMeWebhooksWebhookIDDeliveries404Response) VisitGetMeWebhooksWebhookIDDeliveriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverRequestObject struct {
+	WebhookID  openapi_types.UUID `json:"webhookID"`
+	DeliveryID openapi_types.UUID `json:"deliveryID"`
+	Params     PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverParams
+}
+
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponseObject interface {
+	VisitPostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponse(w http.ResponseWriter) error
+}
+
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver202JSONResponse WebhookDelivery
+
+func (response PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver202JSONResponse) VisitPostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver401Response struct {
+}
+
+func (response PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver401Response) VisitPostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver404Response struct {
+}
+
+func (response PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver404Response) VisitPostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver409JSONResponse ApiResponse
+
+func (response PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver409JSONResponse) VisitPostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModerationAuctionItemItemIDHideRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostModerationAuctionItemItemIDHideParams
+	Body   *PostModerationAuctionItemItemIDHideJSONRequestBody
+}
+
+type PostModerationAuctionItemItemIDHideResponseObject interface {
+	VisitPostModerationAuctionItemItemIDHideResponse(w http.ResponseWriter) error
+}
+
+type PostModerationAuctionItemItemIDHide204Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDHide204Response) VisitPostModerationAuctionItemItemIDHideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDHide400JSONResponse ApiResponse
+
+func (response PostModerationAuctionItemItemIDHide400JSONResponse) VisitPostModerationAuctionItemItemIDHideResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModerationAuctionItemItemIDHide401Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDHide401Response) VisitPostModerationAuctionItemItemIDHideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDHide403Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDHide403Response) VisitPostModerationAuctionItemItemIDHideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDHide404Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDHide404Response) VisitPostModerationAuctionItemItemIDHideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDVoidBidsRequestObject struct {
+	ItemID openapi_types.UUID `json:"itemID"`
+	Params PostModerationAuctionItemItemIDVoidBidsParams
+}
+
+type PostModerationAuctionItemItemIDVoidBidsResponseObject interface {
+	VisitPostModerationAuctionItemItemIDVoidBidsResponse(w http.ResponseWriter) error
+}
+
+type PostModerationAuctionItemItemIDVoidBids200JSONResponse struct {
+	// Voided Number of voided bids.
+	Voided int `json:"voided"`
+}
+
+func (response PostModerationAuctionItemItemIDVoidBids200JSONResponse) VisitPostModerationAuctionItemItemIDVoidBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModerationAuctionItemItemIDVoidBids401Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDVoidBids401Response) VisitPostModerationAuctionItemItemIDVoidBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDVoidBids403Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDVoidBids403Response) VisitPostModerationAuctionItemItemIDVoidBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDVoidBids404Response struct {
+}
+
+func (response PostModerationAuctionItemItemIDVoidBids404Response) VisitPostModerationAuctionItemItemIDVoidBidsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostModerationAuctionItemItemIDVoidBids409JSONResponse ApiResponse
+
+func (response PostModerationAuctionItemItemIDVoidBids409JSONResponse) VisitPostModerationAuctionItemItemIDVoidBidsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationCasesRequestObject struct {
+	Params GetModerationCasesParams
+}
+
+type GetModerationCasesResponseObject interface {
+	VisitGetModerationCasesResponse(w http.ResponseWriter) error
+}
+
+type GetModerationCases200JSONResponse struct {
+	Count int              `json:"count"`
+	Items []ModerationCase `json:"items"`
+}
+
+func (response GetModerationCases200JSONResponse) VisitGetModerationCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationCases400JSONResponse ApiResponse
+
+func (response GetModerationCases400JSONResponse) VisitGetModerationCasesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationCases401Response struct {
+}
+
+func (response GetModerationCases401Response) VisitGetModerationCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetModerationCases403Response struct {
+}
+
+func (response GetModerationCases403Response) VisitGetModerationCasesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationCasesCaseIDDismissRequestObject struct {
+	CaseID openapi_types.UUID `json:"caseID"`
+	Params PostModerationCasesCaseIDDismissParams
+	Body   *PostModerationCasesCaseIDDismissJSONRequestBody
+}
+
+type PostModerationCasesCaseIDDismissResponseObject interface {
+	VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error
+}
+
+type PostModerationCasesCaseIDDismiss204Response struct {
+}
+
+func (response PostModerationCasesCaseIDDismiss204Response) VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostModerationCasesCaseIDDismiss400JSONResponse ApiResponse
+
+func (response PostModerationCasesCaseIDDismiss400JSONResponse) VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModerationCasesCaseIDDismiss401Response struct {
+}
+
+func (response PostModerationCasesCaseIDDismiss401Response) VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostModerationCasesCaseIDDismiss403Response struct {
+}
+
+func (response PostModerationCasesCaseIDDismiss403Response) VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationCasesCaseIDDismiss404Response struct {
+}
+
+func (response PostModerationCasesCaseIDDismiss404Response) VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostModerationCasesCaseIDDismiss409JSONResponse ApiResponse
+
+func (response PostModerationCasesCaseIDDismiss409JSONResponse) VisitPostModerationCasesCaseIDDismissResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationShillFlagsRequestObject struct {
+	Params GetModerationShillFlagsParams
+}
+
+type GetModerationShillFlagsResponseObject interface {
+	VisitGetModerationShillFlagsResponse(w http.ResponseWriter) error
+}
+
+type GetModerationShillFlags200JSONResponse struct {
+	Count int         `json:"count"`
+	Items []ShillFlag `json:"items"`
+}
+
+func (response GetModerationShillFlags200JSONResponse) VisitGetModerationShillFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationShillFlags400JSONResponse ApiResponse
+
+func (response GetModerationShillFlags400JSONResponse) VisitGetModerationShillFlagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetModerationShillFlags401Response struct {
+}
+
+func (response GetModerationShillFlags401Response) VisitGetModerationShillFlagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetModerationShillFlags403Response struct {
+}
+
+func (response GetModerationShillFlags403Response) VisitGetModerationShillFlagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationShillFlagsFlagIDReviewRequestObject struct {
+	FlagID openapi_types.UUID `json:"flagID"`
+	Params PostModerationShillFlagsFlagIDReviewParams
+}
+
+type PostModerationShillFlagsFlagIDReviewResponseObject interface {
+	VisitPostModerationShillFlagsFlagIDReviewResponse(w http.ResponseWriter) error
+}
+
+type PostModerationShillFlagsFlagIDReview204Response struct {
+}
+
+func (response PostModerationShillFlagsFlagIDReview204Response) VisitPostModerationShillFlagsFlagIDReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostModerationShillFlagsFlagIDReview401Response struct {
+}
+
+func (response PostModerationShillFlagsFlagIDReview401Response) VisitPostModerationShillFlagsFlagIDReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostModerationShillFlagsFlagIDReview403Response struct {
+}
+
+func (response PostModerationShillFlagsFlagIDReview403Response) VisitPostModerationShillFlagsFlagIDReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationShillFlagsFlagIDReview404Response struct {
+}
+
+func (response PostModerationShillFlagsFlagIDReview404Response) VisitPostModerationShillFlagsFlagIDReviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostModerationShillFlagsFlagIDReview409JSONResponse ApiResponse
+
+func (response PostModerationShillFlagsFlagIDReview409JSONResponse) VisitPostModerationShillFlagsFlagIDReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteModerationUsersUsernameBanRequestObject struct {
+	Username string `json:"username"`
+	Params   DeleteModerationUsersUsernameBanParams
+}
+
+type DeleteModerationUsersUsernameBanResponseObject interface {
+	VisitDeleteModerationUsersUsernameBanResponse(w http.ResponseWriter) error
+}
+
+type DeleteModerationUsersUsernameBan204Response struct {
+}
+
+func (response DeleteModerationUsersUsernameBan204Response) VisitDeleteModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteModerationUsersUsernameBan401Response struct {
+}
+
+func (response DeleteModerationUsersUsernameBan401Response) VisitDeleteModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteModerationUsersUsernameBan403Response struct {
+}
+
+func (response DeleteModerationUsersUsernameBan403Response) VisitDeleteModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteModerationUsersUsernameBan404Response struct {
+}
+
+func (response DeleteModerationUsersUsernameBan404Response) VisitDeleteModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PostModerationUsersUsernameBanRequestObject struct {
+	Username string `json:"username"`
+	Params   PostModerationUsersUsernameBanParams
+	Body     *PostModerationUsersUsernameBanJSONRequestBody
+}
+
+type PostModerationUsersUsernameBanResponseObject interface {
+	VisitPostModerationUsersUsernameBanResponse(w http.ResponseWriter) error
+}
+
+type PostModerationUsersUsernameBan204Response struct {
+}
+
+func (response PostModerationUsersUsernameBan204Response) VisitPostModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostModerationUsersUsernameBan400JSONResponse ApiResponse
+
+func (response PostModerationUsersUsernameBan400JSONResponse) VisitPostModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostModerationUsersUsernameBan401Response struct {
+}
+
+func (response PostModerationUsersUsernameBan401Response) VisitPostModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostModerationUsersUsernameBan403Response struct {
+}
+
+func (response PostModerationUsersUsernameBan403Response) VisitPostModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PostModerationUsersUsernameBan404Response struct {
+}
+
+func (response PostModerationUsersUsernameBan404Response) VisitPostModerationUsersUsernameBanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetUsersUsernameFeedbackRequestObject struct {
+	Username string `json:"username"`
+	Params   GetUsersUsernameFeedbackParams
+}
+
+type GetUsersUsernameFeedbackResponseObject interface {
+	VisitGetUsersUsernameFeedbackResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUsernameFeedback200JSONResponse struct {
+	Count int        `json:"count"`
+	Items []Feedback `json:"items"`
+
+	// Reputation Aggregated ratings a user received from trading partners.
+	Reputation Reputation `json:"reputation"`
+}
+
+func (response GetUsersUsernameFeedback200JSONResponse) VisitGetUsersUsernameFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUsernameFeedback400JSONResponse ApiResponse
+
+func (response GetUsersUsernameFeedback400JSONResponse) VisitGetUsersUsernameFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUsernameFeedback404Response struct {
+}
+
+func (response GetUsersUsernameFeedback404Response) VisitGetUsersUsernameFeedbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List categories
+	// (GET /auction/categories)
+	GetAuctionCategories(ctx context.Context, request GetAuctionCategoriesRequestObject) (GetAuctionCategoriesResponseObject, error)
+	// Add a new category
+	// (POST /auction/category)
+	PostAuctionCategory(ctx context.Context, request PostAuctionCategoryRequestObject) (PostAuctionCategoryResponseObject, error)
+	// Delete a category
+	// (DELETE /auction/category/{categoryID})
+	DeleteAuctionCategoryCategoryID(ctx context.Context, request DeleteAuctionCategoryCategoryIDRequestObject) (DeleteAuctionCategoryCategoryIDResponseObject, error)
+	// Edit a category
+	// (PATCH /auction/category/{categoryID})
+	PatchAuctionCategoryCategoryID(ctx context.Context, request PatchAuctionCategoryCategoryIDRequestObject) (PatchAuctionCategoryCategoryIDResponseObject, error)
+	// Add a new auction item
+	// (POST /auction/item)
+	PostAuctionItem(ctx context.Context, request PostAuctionItemRequestObject) (PostAuctionItemResponseObject, error)
+	// Cancel an auction item
+	// (DELETE /auction/item/{itemID})
+	DeleteAuctionItemItemID(ctx context.Context, request DeleteAuctionItemItemIDRequestObject) (DeleteAuctionItemItemIDResponseObject, error)
+	// Get auction item details
+	// (GET /auction/item/{itemID})
+	GetAuctionItemItemID(ctx context.Context, request GetAuctionItemItemIDRequestObject) (GetAuctionItemItemIDResponseObject, error)
+	// Edit an auction item
+	// (PATCH /auction/item/{itemID})
+	PatchAuctionItemItemID(ctx context.Context, request PatchAuctionItemItemIDRequestObject) (PatchAuctionItemItemIDResponseObject, error)
+	// Accept the current price of a dutch auction
+	// (POST /auction/item/{itemID}/accept)
+	PostAuctionItemItemIDAccept(ctx context.Context, request PostAuctionItemItemIDAcceptRequestObject) (PostAuctionItemItemIDAcceptResponseObject, error)
+	// List bids of an auction item
+	// (GET /auction/item/{itemID}/bids)
+	GetAuctionItemItemIDBids(ctx context.Context, request GetAuctionItemItemIDBidsRequestObject) (GetAuctionItemItemIDBidsResponseObject, error)
+	// Place a bid on an auction item
+	// (POST /auction/item/{itemID}/bids)
+	PostAuctionItemItemIDBids(ctx context.Context, request PostAuctionItemItemIDBidsRequestObject) (PostAuctionItemItemIDBidsResponseObject, error)
+	// Buy an auction item at its buy-now price
+	// (POST /auction/item/{itemID}/buy-now)
+	PostAuctionItemItemIDBuyNow(ctx context.Context, request PostAuctionItemItemIDBuyNowRequestObject) (PostAuctionItemItemIDBuyNowResponseObject, error)
+	// Track auction item events
+	// (GET /auction/item/{itemID}/events)
+	GetAuctionItemItemIDEvents(ctx context.Context, request GetAuctionItemItemIDEventsRequestObject) (GetAuctionItemItemIDEventsResponseObject, error)
+	// List feedback of an auction item
+	// (GET /auction/item/{itemID}/feedback)
+	GetAuctionItemItemIDFeedback(ctx context.Context, request GetAuctionItemItemIDFeedbackRequestObject) (GetAuctionItemItemIDFeedbackResponseObject, error)
+	// Leave feedback on an auction item
+	// (POST /auction/item/{itemID}/feedback)
+	PostAuctionItemItemIDFeedback(ctx context.Context, request PostAuctionItemItemIDFeedbackRequestObject) (PostAuctionItemItemIDFeedbackResponseObject, error)
+	// List questions of an auction item
+	// (GET /auction/item/{itemID}/questions)
+	GetAuctionItemItemIDQuestions(ctx context.Context, request GetAuctionItemItemIDQuestionsRequestObject) (GetAuctionItemItemIDQuestionsResponseObject, error)
+	// Ask a question about an auction item
+	// (POST /auction/item/{itemID}/questions)
+	PostAuctionItemItemIDQuestions(ctx context.Context, request PostAuctionItemItemIDQuestionsRequestObject) (PostAuctionItemItemIDQuestionsResponseObject, error)
+	// Answer a question about an auction item
+	// (POST /auction/item/{itemID}/questions/{questionID}/answer)
+	PostAuctionItemItemIDQuestionsQuestionIDAnswer(ctx context.Context, request PostAuctionItemItemIDQuestionsQuestionIDAnswerRequestObject) (PostAuctionItemItemIDQuestionsQuestionIDAnswerResponseObject, error)
+	// Relist an unsold auction item
+	// (POST /auction/item/{itemID}/relist)
+	PostAuctionItemItemIDRelist(ctx context.Context, request PostAuctionItemItemIDRelistRequestObject) (PostAuctionItemItemIDRelistResponseObject, error)
+	// Report an auction item
+	// (POST /auction/item/{itemID}/report)
+	PostAuctionItemItemIDReport(ctx context.Context, request PostAuctionItemItemIDReportRequestObject) (PostAuctionItemItemIDReportResponseObject, error)
+	// Unwatch an auction item
+	// (DELETE /auction/item/{itemID}/watch)
+	DeleteAuctionItemItemIDWatch(ctx context.Context, request DeleteAuctionItemItemIDWatchRequestObject) (DeleteAuctionItemItemIDWatchResponseObject, error)
+	// Watch an auction item
+	// (PUT /auction/item/{itemID}/watch)
+	PutAuctionItemItemIDWatch(ctx context.Context, request PutAuctionItemItemIDWatchRequestObject) (PutAuctionItemItemIDWatchResponseObject, error)
+	// List auction items
+	// (GET /auction/items)
+	GetAuctionItems(ctx context.Context, request GetAuctionItemsRequestObject) (GetAuctionItemsResponseObject, error)
+	// Get search suggestions
+	// (GET /auction/suggest)
+	GetAuctionSuggest(ctx context.Context, request GetAuctionSuggestRequestObject) (GetAuctionSuggestResponseObject, error)
+	// Rebuild the suggestion index
+	// (POST /auction/suggest/rebuild)
+	PostAuctionSuggestRebuild(ctx context.Context, request PostAuctionSuggestRebuildRequestObject) (PostAuctionSuggestRebuildResponseObject, error)
+	// Exchange authorization code
+	// (GET /auth/callback)
+	GetAuthCallback(ctx context.Context, request GetAuthCallbackRequestObject) (GetAuthCallbackResponseObject, error)
+	// Obtain authentication url
+	// (GET /auth/login)
+	GetAuthLogin(ctx context.Context, request GetAuthLoginRequestObject) (GetAuthLoginResponseObject, error)
+	// Revoke authentication token
+	// (GET /auth/logout)
+	GetAuthLogout(ctx context.Context, request GetAuthLogoutRequestObject) (GetAuthLogoutResponseObject, error)
+	// Upload an image
+	// (POST /image)
+	PostImage(ctx context.Context, request PostImageRequestObject) (PostImageResponseObject, error)
+	// List my auction items
+	// (GET /me/auctions)
+	GetMeAuctions(ctx context.Context, request GetMeAuctionsRequestObject) (GetMeAuctionsResponseObject, error)
+	// List auctions I have bid on
+	// (GET /me/bids)
+	GetMeBids(ctx context.Context, request GetMeBidsRequestObject) (GetMeBidsResponseObject, error)
+	// Get my notification preferences
+	// (GET /me/notification-preferences)
+	GetMeNotificationPreferences(ctx context.Context, request GetMeNotificationPreferencesRequestObject) (GetMeNotificationPreferencesResponseObject, error)
+	// Update my notification preferences
+	// (PUT /me/notification-preferences)
+	PutMeNotificationPreferences(ctx context.Context, request PutMeNotificationPreferencesRequestObject) (PutMeNotificationPreferencesResponseObject, error)
+	// List my notifications
+	// (GET /me/notifications)
+	GetMeNotifications(ctx context.Context, request GetMeNotificationsRequestObject) (GetMeNotificationsResponseObject, error)
+	// Mark a notification as read
+	// (PUT /me/notifications/{notificationID}/read)
+	PutMeNotificationsNotificationIDRead(ctx context.Context, request PutMeNotificationsNotificationIDReadRequestObject) (PutMeNotificationsNotificationIDReadResponseObject, error)
+	// List watched auction items
+	// (GET /me/watchlist)
+	GetMeWatchlist(ctx context.Context, request GetMeWatchlistRequestObject) (GetMeWatchlistResponseObject, error)
+	// List my webhooks
+	// (GET /me/webhooks)
+	GetMeWebhooks(ctx context.Context, request GetMeWebhooksRequestObject) (GetMeWebhooksResponseObject, error)
+	// Register a webhook
+	// (POST /me/webhooks)
+	PostMeWebhooks(ctx context.Context, request PostMeWebhooksRequestObject) (PostMeWebhooksResponseObject, error)
+	// Delete a webhook
+	// (DELETE /me/webhooks/{webhookID})
+	DeleteMeWebhooksWebhookID(ctx context.Context, request DeleteMeWebhooksWebhookIDRequestObject) (DeleteMeWebhooksWebhookIDResponseObject, error)
+	// List deliveries of a webhook
+	// (GET /me/webhooks/{webhookID}/deliveries)
+	GetMeWebhooksWebhookIDDeliveries(ctx context.Context, request GetMeWebhooksWebhookIDDeliveriesRequestObject) (GetMeWebhooksWebhookIDDeliveriesResponseObject, error)
+	// Redeliver a failed delivery
+	// (POST /me/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver)
+	PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(ctx context.Context, request PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverRequestObject) (PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponseObject, error)
+	// Hide an auction item
+	// (POST /moderation/auction/item/{itemID}/hide)
+	PostModerationAuctionItemItemIDHide(ctx context.Context, request PostModerationAuctionItemItemIDHideRequestObject) (PostModerationAuctionItemItemIDHideResponseObject, error)
+	// Void the bids of a hidden auction item
+	// (POST /moderation/auction/item/{itemID}/void-bids)
+	PostModerationAuctionItemItemIDVoidBids(ctx context.Context, request PostModerationAuctionItemItemIDVoidBidsRequestObject) (PostModerationAuctionItemItemIDVoidBidsResponseObject, error)
+	// List moderation cases
+	// (GET /moderation/cases)
+	GetModerationCases(ctx context.Context, request GetModerationCasesRequestObject) (GetModerationCasesResponseObject, error)
+	// Dismiss a moderation case
+	// (POST /moderation/cases/{caseID}/dismiss)
+	PostModerationCasesCaseIDDismiss(ctx context.Context, request PostModerationCasesCaseIDDismissRequestObject) (PostModerationCasesCaseIDDismissResponseObject, error)
+	// List shill bidding flags
+	// (GET /moderation/shill-flags)
+	GetModerationShillFlags(ctx context.Context, request GetModerationShillFlagsRequestObject) (GetModerationShillFlagsResponseObject, error)
+	// Mark a shill bidding flag as reviewed
+	// (POST /moderation/shill-flags/{flagID}/review)
+	PostModerationShillFlagsFlagIDReview(ctx context.Context, request PostModerationShillFlagsFlagIDReviewRequestObject) (PostModerationShillFlagsFlagIDReviewResponseObject, error)
+	// Lift the ban of a user
+	// (DELETE /moderation/users/{username}/ban)
+	DeleteModerationUsersUsernameBan(ctx context.Context, request DeleteModerationUsersUsernameBanRequestObject) (DeleteModerationUsersUsernameBanResponseObject, error)
+	// Ban a user
+	// (POST /moderation/users/{username}/ban)
+	PostModerationUsersUsernameBan(ctx context.Context, request PostModerationUsersUsernameBanRequestObject) (PostModerationUsersUsernameBanResponseObject, error)
+	// List feedback received by a user
+	// (GET /users/{username}/feedback)
+	GetUsersUsernameFeedback(ctx context.Context, request GetUsersUsernameFeedbackRequestObject) (GetUsersUsernameFeedbackResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAuctionCategories operation middleware
+func (sh *strictHandler) GetAuctionCategories(ctx *gin.Context) {
+	var request GetAuctionCategoriesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionCategories(ctx, request.(GetAuctionCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionCategories")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionCategoriesResponseObject); ok {
+		if err := validResponse.VisitGetAuctionCategoriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionCategory operation middleware
+func (sh *strictHandler) PostAuctionCategory(ctx *gin.Context, params PostAuctionCategoryParams) {
+	var request PostAuctionCategoryRequestObject
+
+	request.Params = params
+
+	var body PostAuctionCategoryJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionCategory(ctx, request.(PostAuctionCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionCategory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionCategoryResponseObject); ok {
+		if err := validResponse.VisitPostAuctionCategoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAuctionCategoryCategoryID operation middleware
+func (sh *strictHandler) DeleteAuctionCategoryCategoryID(ctx *gin.Context, categoryID openapi_types.UUID, params DeleteAuctionCategoryCategoryIDParams) {
+	var request DeleteAuctionCategoryCategoryIDRequestObject
+
+	request.CategoryID = categoryID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAuctionCategoryCategoryID(ctx, request.(DeleteAuctionCategoryCategoryIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAuctionCategoryCategoryID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAuctionCategoryCategoryIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAuctionCategoryCategoryIDResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAuctionCategoryCategoryID operation middleware
+func (sh *strictHandler) PatchAuctionCategoryCategoryID(ctx *gin.Context, categoryID openapi_types.UUID, params PatchAuctionCategoryCategoryIDParams) {
+	var request PatchAuctionCategoryCategoryIDRequestObject
+
+	request.CategoryID = categoryID
+	request.Params = params
+
+	var body PatchAuctionCategoryCategoryIDJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAuctionCategoryCategoryID(ctx, request.(PatchAuctionCategoryCategoryIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAuctionCategoryCategoryID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchAuctionCategoryCategoryIDResponseObject); ok {
+		if err := validResponse.VisitPatchAuctionCategoryCategoryIDResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItem operation middleware
+func (sh *strictHandler) PostAuctionItem(ctx *gin.Context, params PostAuctionItemParams) {
+	var request PostAuctionItemRequestObject
+
+	request.Params = params
+
+	var body PostAuctionItemJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItem(ctx, request.(PostAuctionItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItem")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAuctionItemItemID operation middleware
+func (sh *strictHandler) DeleteAuctionItemItemID(ctx *gin.Context, itemID openapi_types.UUID, params DeleteAuctionItemItemIDParams) {
+	var request DeleteAuctionItemItemIDRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAuctionItemItemID(ctx, request.(DeleteAuctionItemItemIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAuctionItemItemID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAuctionItemItemIDResponseObject); ok {
+		if err := validResponse.VisitDeleteAuctionItemItemIDResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionItemItemID operation middleware
+func (sh *strictHandler) GetAuctionItemItemID(ctx *gin.Context, itemID openapi_types.UUID, params GetAuctionItemItemIDParams) {
+	var request GetAuctionItemItemIDRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionItemItemID(ctx, request.(GetAuctionItemItemIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionItemItemID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionItemItemIDResponseObject); ok {
+		if err := validResponse.VisitGetAuctionItemItemIDResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchAuctionItemItemID operation middleware
+func (sh *strictHandler) PatchAuctionItemItemID(ctx *gin.Context, itemID openapi_types.UUID, params PatchAuctionItemItemIDParams) {
+	var request PatchAuctionItemItemIDRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	var body PatchAuctionItemItemIDJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAuctionItemItemID(ctx, request.(PatchAuctionItemItemIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAuctionItemItemID")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchAuctionItemItemIDResponseObject); ok {
+		if err := validResponse.VisitPatchAuctionItemItemIDResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDAccept operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDAccept(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDAcceptParams) {
+	var request PostAuctionItemItemIDAcceptRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDAccept(ctx, request.(PostAuctionItemItemIDAcceptRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDAccept")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDAcceptResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDAcceptResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionItemItemIDBids operation middleware
+func (sh *strictHandler) GetAuctionItemItemIDBids(ctx *gin.Context, itemID openapi_types.UUID, params GetAuctionItemItemIDBidsParams) {
+	var request GetAuctionItemItemIDBidsRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionItemItemIDBids(ctx, request.(GetAuctionItemItemIDBidsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionItemItemIDBids")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionItemItemIDBidsResponseObject); ok {
+		if err := validResponse.VisitGetAuctionItemItemIDBidsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDBids operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDBids(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDBidsParams) {
+	var request PostAuctionItemItemIDBidsRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	var body PostAuctionItemItemIDBidsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDBids(ctx, request.(PostAuctionItemItemIDBidsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDBids")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDBidsResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDBidsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDBuyNow operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDBuyNow(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDBuyNowParams) {
+	var request PostAuctionItemItemIDBuyNowRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDBuyNow(ctx, request.(PostAuctionItemItemIDBuyNowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDBuyNow")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDBuyNowResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDBuyNowResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionItemItemIDEvents operation middleware
+func (sh *strictHandler) GetAuctionItemItemIDEvents(ctx *gin.Context, itemID openapi_types.UUID) {
+	var request GetAuctionItemItemIDEventsRequestObject
+
+	request.ItemID = itemID
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionItemItemIDEvents(ctx, request.(GetAuctionItemItemIDEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionItemItemIDEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionItemItemIDEventsResponseObject); ok {
+		if err := validResponse.VisitGetAuctionItemItemIDEventsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionItemItemIDFeedback operation middleware
+func (sh *strictHandler) GetAuctionItemItemIDFeedback(ctx *gin.Context, itemID openapi_types.UUID) {
+	var request GetAuctionItemItemIDFeedbackRequestObject
+
+	request.ItemID = itemID
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionItemItemIDFeedback(ctx, request.(GetAuctionItemItemIDFeedbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionItemItemIDFeedback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionItemItemIDFeedbackResponseObject); ok {
+		if err := validResponse.VisitGetAuctionItemItemIDFeedbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDFeedback operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDFeedback(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDFeedbackParams) {
+	var request PostAuctionItemItemIDFeedbackRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	var body PostAuctionItemItemIDFeedbackJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDFeedback(ctx, request.(PostAuctionItemItemIDFeedbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDFeedback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDFeedbackResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDFeedbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionItemItemIDQuestions operation middleware
+func (sh *strictHandler) GetAuctionItemItemIDQuestions(ctx *gin.Context, itemID openapi_types.UUID, params GetAuctionItemItemIDQuestionsParams) {
+	var request GetAuctionItemItemIDQuestionsRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionItemItemIDQuestions(ctx, request.(GetAuctionItemItemIDQuestionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionItemItemIDQuestions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionItemItemIDQuestionsResponseObject); ok {
+		if err := validResponse.VisitGetAuctionItemItemIDQuestionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDQuestions operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDQuestions(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDQuestionsParams) {
+	var request PostAuctionItemItemIDQuestionsRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	var body PostAuctionItemItemIDQuestionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDQuestions(ctx, request.(PostAuctionItemItemIDQuestionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDQuestions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDQuestionsResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDQuestionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDQuestionsQuestionIDAnswer operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDQuestionsQuestionIDAnswer(ctx *gin.Context, itemID openapi_types.UUID, questionID openapi_types.UUID, params PostAuctionItemItemIDQuestionsQuestionIDAnswerParams) {
+	var request PostAuctionItemItemIDQuestionsQuestionIDAnswerRequestObject
+
+	request.ItemID = itemID
+	request.QuestionID = questionID
+	request.Params = params
+
+	var body PostAuctionItemItemIDQuestionsQuestionIDAnswerJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDQuestionsQuestionIDAnswer(ctx, request.(PostAuctionItemItemIDQuestionsQuestionIDAnswerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDQuestionsQuestionIDAnswer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDQuestionsQuestionIDAnswerResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDQuestionsQuestionIDAnswerResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDRelist operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDRelist(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDRelistParams) {
+	var request PostAuctionItemItemIDRelistRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	var body PostAuctionItemItemIDRelistJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDRelist(ctx, request.(PostAuctionItemItemIDRelistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDRelist")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDRelistResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDRelistResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionItemItemIDReport operation middleware
+func (sh *strictHandler) PostAuctionItemItemIDReport(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDReportParams) {
+	var request PostAuctionItemItemIDReportRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	var body PostAuctionItemItemIDReportJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionItemItemIDReport(ctx, request.(PostAuctionItemItemIDReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionItemItemIDReport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionItemItemIDReportResponseObject); ok {
+		if err := validResponse.VisitPostAuctionItemItemIDReportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAuctionItemItemIDWatch operation middleware
+func (sh *strictHandler) DeleteAuctionItemItemIDWatch(ctx *gin.Context, itemID openapi_types.UUID, params DeleteAuctionItemItemIDWatchParams) {
+	var request DeleteAuctionItemItemIDWatchRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAuctionItemItemIDWatch(ctx, request.(DeleteAuctionItemItemIDWatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAuctionItemItemIDWatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAuctionItemItemIDWatchResponseObject); ok {
+		if err := validResponse.VisitDeleteAuctionItemItemIDWatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutAuctionItemItemIDWatch operation middleware
+func (sh *strictHandler) PutAuctionItemItemIDWatch(ctx *gin.Context, itemID openapi_types.UUID, params PutAuctionItemItemIDWatchParams) {
+	var request PutAuctionItemItemIDWatchRequestObject
+
+	request.ItemID = itemID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutAuctionItemItemIDWatch(ctx, request.(PutAuctionItemItemIDWatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutAuctionItemItemIDWatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutAuctionItemItemIDWatchResponseObject); ok {
+		if err := validResponse.VisitPutAuctionItemItemIDWatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionItems operation middleware
+func (sh *strictHandler) GetAuctionItems(ctx *gin.Context, params GetAuctionItemsParams) {
+	var request GetAuctionItemsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionItems(ctx, request.(GetAuctionItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionItems")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionItemsResponseObject); ok {
+		if err := validResponse.VisitGetAuctionItemsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuctionSuggest operation middleware
+func (sh *strictHandler) GetAuctionSuggest(ctx *gin.Context, params GetAuctionSuggestParams) {
+	var request GetAuctionSuggestRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuctionSuggest(ctx, request.(GetAuctionSuggestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuctionSuggest")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuctionSuggestResponseObject); ok {
+		if err := validResponse.VisitGetAuctionSuggestResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuctionSuggestRebuild operation middleware
+func (sh *strictHandler) PostAuctionSuggestRebuild(ctx *gin.Context, params PostAuctionSuggestRebuildParams) {
+	var request PostAuctionSuggestRebuildRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuctionSuggestRebuild(ctx, request.(PostAuctionSuggestRebuildRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuctionSuggestRebuild")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostAuctionSuggestRebuildResponseObject); ok {
+		if err := validResponse.VisitPostAuctionSuggestRebuildResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthCallback operation middleware
+func (sh *strictHandler) GetAuthCallback(ctx *gin.Context, params GetAuthCallbackParams) {
+	var request GetAuthCallbackRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthCallback(ctx, request.(GetAuthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthCallback")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuthCallbackResponseObject); ok {
+		if err := validResponse.VisitGetAuthCallbackResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthLogin operation middleware
+func (sh *strictHandler) GetAuthLogin(ctx *gin.Context, params GetAuthLoginParams) {
+	var request GetAuthLoginRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthLogin(ctx, request.(GetAuthLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuthLoginResponseObject); ok {
+		if err := validResponse.VisitGetAuthLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthLogout operation middleware
+func (sh *strictHandler) GetAuthLogout(ctx *gin.Context, params GetAuthLogoutParams) {
+	var request GetAuthLogoutRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthLogout(ctx, request.(GetAuthLogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthLogout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuthLogoutResponseObject); ok {
+		if err := validResponse.VisitGetAuthLogoutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostImage operation middleware
+func (sh *strictHandler) PostImage(ctx *gin.Context, params PostImageParams) {
+	var request PostImageRequestObject
+
+	request.Params = params
+
+	request.Body = ctx.Request.Body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImage(ctx, request.(PostImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImage")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostImageResponseObject); ok {
+		if err := validResponse.VisitPostImageResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuctionItemItemIDEvents410JSONResponse struct {
-	Message *string `json:"message,omitempty"`
-}
+// GetMeAuctions operation middleware
+func (sh *strictHandler) GetMeAuctions(ctx *gin.Context, params GetMeAuctionsParams) {
+	var request GetMeAuctionsRequestObject
 
-func (response GetAuctionItemItemIDEvents410JSONResponse) VisitGetAuctionItemItemIDEventsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(410)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeAuctions(ctx, request.(GetMeAuctionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeAuctions")
+	}
 
-type GetAuctionItemsRequestObject struct {
-	Params GetAuctionItemsParams
-}
+	response, err := handler(ctx, request)
 
-type GetAuctionItemsResponseObject interface {
-	VisitGetAuctionItemsResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeAuctionsResponseObject); ok {
+		if err := validResponse.VisitGetMeAuctionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuctionItems200JSONResponse struct {
-	Count int `json:"count"`
-	Items []struct {
-		CurrentBid uint32             `json:"currentBid"`
-		EndTime    time.Time          `json:"endTime"`
-		Id         openapi_types.UUID `json:"id"`
-		IsEnded    bool               `json:"isEnded"`
-		StartTime  time.Time          `json:"startTime"`
-		Title      string             `json:"title"`
-	} `json:"items"`
-}
+// GetMeBids operation middleware
+func (sh *strictHandler) GetMeBids(ctx *gin.Context, params GetMeBidsParams) {
+	var request GetMeBidsRequestObject
 
-func (response GetAuctionItems200JSONResponse) VisitGetAuctionItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeBids(ctx, request.(GetMeBidsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeBids")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeBidsResponseObject); ok {
+		if err := validResponse.VisitGetMeBidsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuctionItems400JSONResponse ApiResponse
+// GetMeNotificationPreferences operation middleware
+func (sh *strictHandler) GetMeNotificationPreferences(ctx *gin.Context, params GetMeNotificationPreferencesParams) {
+	var request GetMeNotificationPreferencesRequestObject
 
-func (response GetAuctionItems400JSONResponse) VisitGetAuctionItemsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeNotificationPreferences(ctx, request.(GetMeNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeNotificationPreferences")
+	}
 
-type GetAuctionItems404Response struct {
-}
+	response, err := handler(ctx, request)
 
-func (response GetAuctionItems404Response) VisitGetAuctionItemsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeNotificationPreferencesResponseObject); ok {
+		if err := validResponse.VisitGetMeNotificationPreferencesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuthCallbackRequestObject struct {
-	Params GetAuthCallbackParams
-}
+// PutMeNotificationPreferences operation middleware
+func (sh *strictHandler) PutMeNotificationPreferences(ctx *gin.Context, params PutMeNotificationPreferencesParams) {
+	var request PutMeNotificationPreferencesRequestObject
 
-type GetAuthCallbackResponseObject interface {
-	VisitGetAuthCallbackResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetAuthCallback200ResponseHeaders struct {
-	Location                                      string
-	SetCookieAccessTokenHttpOnlySecureMaxAge10800 string
-	SetCookieUsernameMaxAge10800                  string
-	UnsetCookieRequestNonceHttpOnlySecure         string
-	UnsetCookieRequestStateHttpOnlySecure         string
-}
+	var body PutMeNotificationPreferencesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type GetAuthCallback200Response struct {
-	Headers GetAuthCallback200ResponseHeaders
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMeNotificationPreferences(ctx, request.(PutMeNotificationPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMeNotificationPreferences")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutMeNotificationPreferencesResponseObject); ok {
+		if err := validResponse.VisitPutMeNotificationPreferencesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAuthCallback200Response) VisitGetAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", response.Headers.Location)
+// GetMeNotifications operation middleware
+func (sh *strictHandler) GetMeNotifications(ctx *gin.Context, params GetMeNotificationsParams) {
+	var request GetMeNotificationsRequestObject
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "accessToken",
-		Value:    response.Headers.SetCookieAccessTokenHttpOnlySecureMaxAge10800,
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   10800,
-	})
+	request.Params = params
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "username",
-		Value:    response.Headers.SetCookieUsernameMaxAge10800,
-		Secure:   false,
-		HttpOnly: false,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   10800,
-	})
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeNotifications(ctx, request.(GetMeNotificationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeNotifications")
+	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "requestNonce",
-		Value:    "", // 清空 cookie
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		Expires:  time.Unix(0, 0),
-	})
+	response, err := handler(ctx, request)
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "requestState",
-		Value:    "", // 清空 cookie
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		Expires:  time.Unix(0, 0),
-	})
-	w.WriteHeader(200)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeNotificationsResponseObject); ok {
+		if err := validResponse.VisitGetMeNotificationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuthLoginRequestObject struct {
-	Params GetAuthLoginParams
-}
+// PutMeNotificationsNotificationIDRead operation middleware
+func (sh *strictHandler) PutMeNotificationsNotificationIDRead(ctx *gin.Context, notificationID openapi_types.UUID, params PutMeNotificationsNotificationIDReadParams) {
+	var request PutMeNotificationsNotificationIDReadRequestObject
 
-type GetAuthLoginResponseObject interface {
-	VisitGetAuthLoginResponse(w http.ResponseWriter) error
-}
+	request.NotificationID = notificationID
+	request.Params = params
 
-type GetAuthLogin200ResponseHeaders struct {
-	Location                                     string
-	SetCookieRequestNonceHttpOnlySecureMaxAge120 string
-	SetCookieRequestStateHttpOnlySecureMaxAge120 string
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMeNotificationsNotificationIDRead(ctx, request.(PutMeNotificationsNotificationIDReadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMeNotificationsNotificationIDRead")
+	}
 
-type GetAuthLogin200Response struct {
-	Headers GetAuthLogin200ResponseHeaders
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutMeNotificationsNotificationIDReadResponseObject); ok {
+		if err := validResponse.VisitPutMeNotificationsNotificationIDReadResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response GetAuthLogin200Response) VisitGetAuthLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", response.Headers.Location)
+// GetMeWatchlist operation middleware
+func (sh *strictHandler) GetMeWatchlist(ctx *gin.Context, params GetMeWatchlistParams) {
+	var request GetMeWatchlistRequestObject
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "requestNonce",
-		Value:    response.Headers.SetCookieRequestNonceHttpOnlySecureMaxAge120,
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   120,
-	})
+	request.Params = params
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "requestState",
-		Value:    response.Headers.SetCookieRequestStateHttpOnlySecureMaxAge120,
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   120,
-	})
-	w.WriteHeader(200)
-	return nil
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeWatchlist(ctx, request.(GetMeWatchlistRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeWatchlist")
+	}
 
-type GetAuthLogoutRequestObject struct {
-	Params GetAuthLogoutParams
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeWatchlistResponseObject); ok {
+		if err := validResponse.VisitGetMeWatchlistResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuthLogoutResponseObject interface {
-	VisitGetAuthLogoutResponse(w http.ResponseWriter) error
+// GetMeWebhooks operation middleware
+func (sh *strictHandler) GetMeWebhooks(ctx *gin.Context, params GetMeWebhooksParams) {
+	var request GetMeWebhooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeWebhooks(ctx, request.(GetMeWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeWebhooksResponseObject); ok {
+		if err := validResponse.VisitGetMeWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetAuthLogout200ResponseHeaders struct {
-	UnsetCookieAccessTokenHttpOnlySecure string
-	UnsetCookieUsernameHttpOnlySecure    string
-}
+// PostMeWebhooks operation middleware
+func (sh *strictHandler) PostMeWebhooks(ctx *gin.Context, params PostMeWebhooksParams) {
+	var request PostMeWebhooksRequestObject
 
-type GetAuthLogout200Response struct {
-	Headers GetAuthLogout200ResponseHeaders
-}
+	request.Params = params
 
-func (response GetAuthLogout200Response) VisitGetAuthLogoutResponse(w http.ResponseWriter) error {
+	var body PostMeWebhooksJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "accessToken",
-		Value:    "", // 清空 cookie
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		Expires:  time.Unix(0, 0),
-	})
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMeWebhooks(ctx, request.(PostMeWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMeWebhooks")
+	}
 
-	http.SetCookie(w, &http.Cookie{
-		Name:     "username",
-		Value:    "", // 清空 cookie
-		Secure:   true,
-		HttpOnly: true,
-		Path:     "/",
-		SameSite: http.SameSiteStrictMode,
-		Expires:  time.Unix(0, 0),
-	})
-	w.WriteHeader(200)
-	return nil
-}
+	response, err := handler(ctx, request)
 
-type PostImageRequestObject struct {
-	Params PostImageParams
-	Body   io.Reader
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMeWebhooksResponseObject); ok {
+		if err := validResponse.VisitPostMeWebhooksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostImageResponseObject interface {
-	VisitPostImageResponse(w http.ResponseWriter) error
-}
+// DeleteMeWebhooksWebhookID operation middleware
+func (sh *strictHandler) DeleteMeWebhooksWebhookID(ctx *gin.Context, webhookID openapi_types.UUID, params DeleteMeWebhooksWebhookIDParams) {
+	var request DeleteMeWebhooksWebhookIDRequestObject
 
-type PostImage201ResponseHeaders struct {
-	Location string
-}
+	request.WebhookID = webhookID
+	request.Params = params
 
-type PostImage201Response struct {
-	Headers PostImage201ResponseHeaders
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMeWebhooksWebhookID(ctx, request.(DeleteMeWebhooksWebhookIDRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMeWebhooksWebhookID")
+	}
 
-func (response PostImage201Response) VisitPostImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Location", response.Headers.Location)
+	response, err := handler(ctx, request)
 
-	w.WriteHeader(201)
-	return nil
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteMeWebhooksWebhookIDResponseObject); ok {
+		if err := validResponse.VisitDeleteMeWebhooksWebhookIDResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostImage400JSONResponse ApiResponse
-
-func (response PostImage400JSONResponse) VisitPostImageResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetMeWebhooksWebhookIDDeliveries operation middleware
+func (sh *strictHandler) GetMeWebhooksWebhookIDDeliveries(ctx *gin.Context, webhookID openapi_types.UUID, params GetMeWebhooksWebhookIDDeliveriesParams) {
+	var request GetMeWebhooksWebhookIDDeliveriesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.WebhookID = webhookID
+	request.Params = params
 
-type PostImage401Response struct {
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMeWebhooksWebhookIDDeliveries(ctx, request.(GetMeWebhooksWebhookIDDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMeWebhooksWebhookIDDeliveries")
+	}
 
-func (response PostImage401Response) VisitPostImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	response, err := handler(ctx, request)
 
-type PostImage429Response struct {
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMeWebhooksWebhookIDDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetMeWebhooksWebhookIDDeliveriesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response PostImage429Response) VisitPostImageResponse(w http.ResponseWriter) error {
-	w.WriteHeader(429)
-	return nil
-}
+// PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver operation middleware
+func (sh *strictHandler) PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(ctx *gin.Context, webhookID openapi_types.UUID, deliveryID openapi_types.UUID, params PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverParams) {
+	var request PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverRequestObject
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Add a new auction item
-	// (POST /auction/item)
-	PostAuctionItem(ctx context.Context, request PostAuctionItemRequestObject) (PostAuctionItemResponseObject, error)
-	// Get auction item details
-	// (GET /auction/item/{itemID})
-	GetAuctionItemItemID(ctx context.Context, request GetAuctionItemItemIDRequestObject) (GetAuctionItemItemIDResponseObject, error)
-	// Place a bid on an auction item
-	// (POST /auction/item/{itemID}/bids)
-	PostAuctionItemItemIDBids(ctx context.Context, request PostAuctionItemItemIDBidsRequestObject) (PostAuctionItemItemIDBidsResponseObject, error)
-	// Track auction item events
-	// (GET /auction/item/{itemID}/events)
-	GetAuctionItemItemIDEvents(ctx context.Context, request GetAuctionItemItemIDEventsRequestObject) (GetAuctionItemItemIDEventsResponseObject, error)
-	// List auction items
-	// (GET /auction/items)
-	GetAuctionItems(ctx context.Context, request GetAuctionItemsRequestObject) (GetAuctionItemsResponseObject, error)
-	// Exchange authorization code
-	// (GET /auth/callback)
-	GetAuthCallback(ctx context.Context, request GetAuthCallbackRequestObject) (GetAuthCallbackResponseObject, error)
-	// Obtain authentication url
-	// (GET /auth/login)
-	GetAuthLogin(ctx context.Context, request GetAuthLoginRequestObject) (GetAuthLoginResponseObject, error)
-	// Revoke authentication token
-	// (GET /auth/logout)
-	GetAuthLogout(ctx context.Context, request GetAuthLogoutRequestObject) (GetAuthLogoutResponseObject, error)
-	// Upload an image
-	// (POST /image)
-	PostImage(ctx context.Context, request PostImageRequestObject) (PostImageResponseObject, error)
-}
+	request.WebhookID = webhookID
+	request.DeliveryID = deliveryID
+	request.Params = params
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(ctx, request.(PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponseObject); ok {
+		if err := validResponse.VisitPostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostAuctionItem operation middleware
-func (sh *strictHandler) PostAuctionItem(ctx *gin.Context, params PostAuctionItemParams) {
-	var request PostAuctionItemRequestObject
+// PostModerationAuctionItemItemIDHide operation middleware
+func (sh *strictHandler) PostModerationAuctionItemItemIDHide(ctx *gin.Context, itemID openapi_types.UUID, params PostModerationAuctionItemItemIDHideParams) {
+	var request PostModerationAuctionItemItemIDHideRequestObject
 
+	request.ItemID = itemID
 	request.Params = params
 
-	var body PostAuctionItemJSONRequestBody
+	var body PostModerationAuctionItemItemIDHideJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1169,10 +7882,10 @@ func (sh *strictHandler) PostAuctionItem(ctx *gin.Context, params PostAuctionIte
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuctionItem(ctx, request.(PostAuctionItemRequestObject))
+		return sh.ssi.PostModerationAuctionItemItemIDHide(ctx, request.(PostModerationAuctionItemItemIDHideRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuctionItem")
+		handler = middleware(handler, "PostModerationAuctionItemItemIDHide")
 	}
 
 	response, err := handler(ctx, request)
@@ -1180,8 +7893,8 @@ func (sh *strictHandler) PostAuctionItem(ctx *gin.Context, params PostAuctionIte
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostAuctionItemResponseObject); ok {
-		if err := validResponse.VisitPostAuctionItemResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostModerationAuctionItemItemIDHideResponseObject); ok {
+		if err := validResponse.VisitPostModerationAuctionItemItemIDHideResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1189,17 +7902,18 @@ func (sh *strictHandler) PostAuctionItem(ctx *gin.Context, params PostAuctionIte
 	}
 }
 
-// GetAuctionItemItemID operation middleware
-func (sh *strictHandler) GetAuctionItemItemID(ctx *gin.Context, itemID openapi_types.UUID) {
-	var request GetAuctionItemItemIDRequestObject
+// PostModerationAuctionItemItemIDVoidBids operation middleware
+func (sh *strictHandler) PostModerationAuctionItemItemIDVoidBids(ctx *gin.Context, itemID openapi_types.UUID, params PostModerationAuctionItemItemIDVoidBidsParams) {
+	var request PostModerationAuctionItemItemIDVoidBidsRequestObject
 
 	request.ItemID = itemID
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuctionItemItemID(ctx, request.(GetAuctionItemItemIDRequestObject))
+		return sh.ssi.PostModerationAuctionItemItemIDVoidBids(ctx, request.(PostModerationAuctionItemItemIDVoidBidsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuctionItemItemID")
+		handler = middleware(handler, "PostModerationAuctionItemItemIDVoidBids")
 	}
 
 	response, err := handler(ctx, request)
@@ -1207,8 +7921,8 @@ func (sh *strictHandler) GetAuctionItemItemID(ctx *gin.Context, itemID openapi_t
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAuctionItemItemIDResponseObject); ok {
-		if err := validResponse.VisitGetAuctionItemItemIDResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostModerationAuctionItemItemIDVoidBidsResponseObject); ok {
+		if err := validResponse.VisitPostModerationAuctionItemItemIDVoidBidsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1216,26 +7930,17 @@ func (sh *strictHandler) GetAuctionItemItemID(ctx *gin.Context, itemID openapi_t
 	}
 }
 
-// PostAuctionItemItemIDBids operation middleware
-func (sh *strictHandler) PostAuctionItemItemIDBids(ctx *gin.Context, itemID openapi_types.UUID, params PostAuctionItemItemIDBidsParams) {
-	var request PostAuctionItemItemIDBidsRequestObject
+// GetModerationCases operation middleware
+func (sh *strictHandler) GetModerationCases(ctx *gin.Context, params GetModerationCasesParams) {
+	var request GetModerationCasesRequestObject
 
-	request.ItemID = itemID
 	request.Params = params
 
-	var body PostAuctionItemItemIDBidsJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostAuctionItemItemIDBids(ctx, request.(PostAuctionItemItemIDBidsRequestObject))
+		return sh.ssi.GetModerationCases(ctx, request.(GetModerationCasesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostAuctionItemItemIDBids")
+		handler = middleware(handler, "GetModerationCases")
 	}
 
 	response, err := handler(ctx, request)
@@ -1243,8 +7948,8 @@ func (sh *strictHandler) PostAuctionItemItemIDBids(ctx *gin.Context, itemID open
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostAuctionItemItemIDBidsResponseObject); ok {
-		if err := validResponse.VisitPostAuctionItemItemIDBidsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetModerationCasesResponseObject); ok {
+		if err := validResponse.VisitGetModerationCasesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1252,17 +7957,26 @@ func (sh *strictHandler) PostAuctionItemItemIDBids(ctx *gin.Context, itemID open
 	}
 }
 
-// GetAuctionItemItemIDEvents operation middleware
-func (sh *strictHandler) GetAuctionItemItemIDEvents(ctx *gin.Context, itemID openapi_types.UUID) {
-	var request GetAuctionItemItemIDEventsRequestObject
+// PostModerationCasesCaseIDDismiss operation middleware
+func (sh *strictHandler) PostModerationCasesCaseIDDismiss(ctx *gin.Context, caseID openapi_types.UUID, params PostModerationCasesCaseIDDismissParams) {
+	var request PostModerationCasesCaseIDDismissRequestObject
 
-	request.ItemID = itemID
+	request.CaseID = caseID
+	request.Params = params
+
+	var body PostModerationCasesCaseIDDismissJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuctionItemItemIDEvents(ctx, request.(GetAuctionItemItemIDEventsRequestObject))
+		return sh.ssi.PostModerationCasesCaseIDDismiss(ctx, request.(PostModerationCasesCaseIDDismissRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuctionItemItemIDEvents")
+		handler = middleware(handler, "PostModerationCasesCaseIDDismiss")
 	}
 
 	response, err := handler(ctx, request)
@@ -1270,8 +7984,8 @@ func (sh *strictHandler) GetAuctionItemItemIDEvents(ctx *gin.Context, itemID ope
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAuctionItemItemIDEventsResponseObject); ok {
-		if err := validResponse.VisitGetAuctionItemItemIDEventsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostModerationCasesCaseIDDismissResponseObject); ok {
+		if err := validResponse.VisitPostModerationCasesCaseIDDismissResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1279,17 +7993,17 @@ func (sh *strictHandler) GetAuctionItemItemIDEvents(ctx *gin.Context, itemID ope
 	}
 }
 
-// GetAuctionItems operation middleware
-func (sh *strictHandler) GetAuctionItems(ctx *gin.Context, params GetAuctionItemsParams) {
-	var request GetAuctionItemsRequestObject
+// GetModerationShillFlags operation middleware
+func (sh *strictHandler) GetModerationShillFlags(ctx *gin.Context, params GetModerationShillFlagsParams) {
+	var request GetModerationShillFlagsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuctionItems(ctx, request.(GetAuctionItemsRequestObject))
+		return sh.ssi.GetModerationShillFlags(ctx, request.(GetModerationShillFlagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuctionItems")
+		handler = middleware(handler, "GetModerationShillFlags")
 	}
 
 	response, err := handler(ctx, request)
@@ -1297,8 +8011,8 @@ func (sh *strictHandler) GetAuctionItems(ctx *gin.Context, params GetAuctionItem
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAuctionItemsResponseObject); ok {
-		if err := validResponse.VisitGetAuctionItemsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetModerationShillFlagsResponseObject); ok {
+		if err := validResponse.VisitGetModerationShillFlagsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1306,17 +8020,18 @@ func (sh *strictHandler) GetAuctionItems(ctx *gin.Context, params GetAuctionItem
 	}
 }
 
-// GetAuthCallback operation middleware
-func (sh *strictHandler) GetAuthCallback(ctx *gin.Context, params GetAuthCallbackParams) {
-	var request GetAuthCallbackRequestObject
+// PostModerationShillFlagsFlagIDReview operation middleware
+func (sh *strictHandler) PostModerationShillFlagsFlagIDReview(ctx *gin.Context, flagID openapi_types.UUID, params PostModerationShillFlagsFlagIDReviewParams) {
+	var request PostModerationShillFlagsFlagIDReviewRequestObject
 
+	request.FlagID = flagID
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthCallback(ctx, request.(GetAuthCallbackRequestObject))
+		return sh.ssi.PostModerationShillFlagsFlagIDReview(ctx, request.(PostModerationShillFlagsFlagIDReviewRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthCallback")
+		handler = middleware(handler, "PostModerationShillFlagsFlagIDReview")
 	}
 
 	response, err := handler(ctx, request)
@@ -1324,8 +8039,8 @@ func (sh *strictHandler) GetAuthCallback(ctx *gin.Context, params GetAuthCallbac
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAuthCallbackResponseObject); ok {
-		if err := validResponse.VisitGetAuthCallbackResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostModerationShillFlagsFlagIDReviewResponseObject); ok {
+		if err := validResponse.VisitPostModerationShillFlagsFlagIDReviewResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1333,17 +8048,18 @@ func (sh *strictHandler) GetAuthCallback(ctx *gin.Context, params GetAuthCallbac
 	}
 }
 
-// GetAuthLogin operation middleware
-func (sh *strictHandler) GetAuthLogin(ctx *gin.Context, params GetAuthLoginParams) {
-	var request GetAuthLoginRequestObject
+// DeleteModerationUsersUsernameBan operation middleware
+func (sh *strictHandler) DeleteModerationUsersUsernameBan(ctx *gin.Context, username string, params DeleteModerationUsersUsernameBanParams) {
+	var request DeleteModerationUsersUsernameBanRequestObject
 
+	request.Username = username
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthLogin(ctx, request.(GetAuthLoginRequestObject))
+		return sh.ssi.DeleteModerationUsersUsernameBan(ctx, request.(DeleteModerationUsersUsernameBanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthLogin")
+		handler = middleware(handler, "DeleteModerationUsersUsernameBan")
 	}
 
 	response, err := handler(ctx, request)
@@ -1351,8 +8067,8 @@ func (sh *strictHandler) GetAuthLogin(ctx *gin.Context, params GetAuthLoginParam
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAuthLoginResponseObject); ok {
-		if err := validResponse.VisitGetAuthLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteModerationUsersUsernameBanResponseObject); ok {
+		if err := validResponse.VisitDeleteModerationUsersUsernameBanResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1360,17 +8076,26 @@ func (sh *strictHandler) GetAuthLogin(ctx *gin.Context, params GetAuthLoginParam
 	}
 }
 
-// GetAuthLogout operation middleware
-func (sh *strictHandler) GetAuthLogout(ctx *gin.Context, params GetAuthLogoutParams) {
-	var request GetAuthLogoutRequestObject
+// PostModerationUsersUsernameBan operation middleware
+func (sh *strictHandler) PostModerationUsersUsernameBan(ctx *gin.Context, username string, params PostModerationUsersUsernameBanParams) {
+	var request PostModerationUsersUsernameBanRequestObject
 
+	request.Username = username
 	request.Params = params
 
+	var body PostModerationUsersUsernameBanJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthLogout(ctx, request.(GetAuthLogoutRequestObject))
+		return sh.ssi.PostModerationUsersUsernameBan(ctx, request.(PostModerationUsersUsernameBanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthLogout")
+		handler = middleware(handler, "PostModerationUsersUsernameBan")
 	}
 
 	response, err := handler(ctx, request)
@@ -1378,8 +8103,8 @@ func (sh *strictHandler) GetAuthLogout(ctx *gin.Context, params GetAuthLogoutPar
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAuthLogoutResponseObject); ok {
-		if err := validResponse.VisitGetAuthLogoutResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostModerationUsersUsernameBanResponseObject); ok {
+		if err := validResponse.VisitPostModerationUsersUsernameBanResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1387,19 +8112,18 @@ func (sh *strictHandler) GetAuthLogout(ctx *gin.Context, params GetAuthLogoutPar
 	}
 }
 
-// PostImage operation middleware
-func (sh *strictHandler) PostImage(ctx *gin.Context, params PostImageParams) {
-	var request PostImageRequestObject
+// GetUsersUsernameFeedback operation middleware
+func (sh *strictHandler) GetUsersUsernameFeedback(ctx *gin.Context, username string, params GetUsersUsernameFeedbackParams) {
+	var request GetUsersUsernameFeedbackRequestObject
 
+	request.Username = username
 	request.Params = params
 
-	request.Body = ctx.Request.Body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostImage(ctx, request.(PostImageRequestObject))
+		return sh.ssi.GetUsersUsernameFeedback(ctx, request.(GetUsersUsernameFeedbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostImage")
+		handler = middleware(handler, "GetUsersUsernameFeedback")
 	}
 
 	response, err := handler(ctx, request)
@@ -1407,8 +8131,8 @@ func (sh *strictHandler) PostImage(ctx *gin.Context, params PostImageParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostImageResponseObject); ok {
-		if err := validResponse.VisitPostImageResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersUsernameFeedbackResponseObject); ok {
+		if err := validResponse.VisitGetUsersUsernameFeedbackResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1419,43 +8143,212 @@ func (sh *strictHandler) PostImage(ctx *gin.Context, params PostImageParams) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xabW/bOBL+KwTvPqq20wR7ty7yIWmDXhbtNqhTXIEiWNDi2OZGIrUk5dqb5r8fhtSr",
-	"JdmynV63xQabbSxT5Lw888yL9EBDFSdKgrSGjh+oCRcQM/fnRSLeg0mUNIAfE60S0FaA+zJU3F2dKR0z",
-	"S8dUSHv6nAbUrhPwH2EOmj4GNAZj2Nytzr40Vgs5p4+PxXI1/R1Ci6svBb9agrTNI6eC105Mu4+0Iq5L",
-	"x5mFZ+5qsClEQFMDul06DX+kQgOn409+VeCkyA64a4iP9wg5U7gbBxNqkVihJB3Ti5trMlOaxEyyuZBz",
-	"wiQnCdNWhCJhFq8ISZgkLA3xFmLWxkI8cGfZCA+5yL65uLmmAV2CNn7rk8FoMEI9VAKSJYKO6elgNDil",
-	"AU2YXTjbDbNth8JC7EyrjG1K+VIDs0AYkfC5kERkcqAvGF655nRMb5SxmUTXuCceplkMFrSh40+bO7Mw",
-	"BGOIVfcgnSHCVGuQlqBZcXeBq0Kl7gX6SDJ0YHbXLd5EgwybKDWsWJw4o6xWq8HK/fh/mv59vPN+BGMv",
-	"FV978EqbQYwlSSRCp9bwd4OiPlTO2cA80yo1ELkPaBVTx6MWbejKLjCt2Ro/1+zy0FwPkt/uBV9jmbYH",
-	"3CLk/EaLsBHGP511xJSD4a4w8ctKNdqDpLzD6hTcBc80zqTPRydNbCLKSOgAyolJHTJmaRStET0LYNwB",
-	"74G+Ud6dzR1uF0Ci7FuiZsQuoNgwB3np++1+dVqcjUZ7wemfGmZ0TP8xLDl3mBHusMq2bvMN7eWSRYIT",
-	"ziwjiVZLwYEPqBOixVgfJEvtQmnxJ3Dio2jgXGXSOGZ6jXTCeUugo6ZsjhGc8w29w/tqBDJ8wP9fv3rE",
-	"g+fQQiTvwWoBSyAcLBORQXMzYhIIxUyEO6jlNVSZxf2+avKLIwwkuJIuRL60Dq92r6aeyNvIogbF0RGM",
-	"MRX8PYRK8zplbMNBkf9aqOOvTED7UMkBjLUn+1R1rAkYVH0S0CwLXToslGKVRqgavZ3K6sCfFMREtI8B",
-	"FiH40Vt5MGRhe9bBcVJZMlOp5JsR+xpsLXLy/faL2eFUeDi2lwCTdBoLSxiZCu4Sde+w3agIfNxeCmfn",
-	"/0vsBj9i0dG76t2IBLzvsNw7aoLiUnCSRCxsZN4DkmBdvX2ag2a0oVxWKRKpz/vmQlx8+s0Ez2t5jHVH",
-	"O8DJGuwezBDQs5PRN5d/wQwB6UsR5CoIUy3s2gX5FJgGfZHaBR1/usOIKKnsBsGUkYyq9T37FyFDWObd",
-	"a2stMrEaWIxHcWyz/Opt1EZSgwsnk6t+tcmVP//bViidaShUUoJXzipUihhnkL9j4CvFQAHyW83C+zqy",
-	"IIfKbnyb3cV1dWtDPgu7IMotYhGZiQiB6OYMRrlubxeaza4mfgJMhwtiQccufvwZbnaBtxcZ9Y8U9LoE",
-	"fV6UlW7YmbknWYNKEqzaiGZyDvscWav4utw/0yquSFPtdVXb9bZxlbFrVxJwgORdfrUxVsnKDmS7vVWp",
-	"l6jfWhXsoYsRFRqZYMV+oIOyCnuXUj07BNV37ZMoD5IfqHrZWnw3ik+UtiTUAtVjnS5V2m5R6h7Wnspm",
-	"LI1shRZApnG1d6vF7s4G7a7FIkpzP9EtD2MmrBzlP6GOLfcfBY+IGeup/vpVPmNKNCyFSg1J2By6rIc3",
-	"FqOOI3oeFCJmKxGnMZFpPAWdt5/YCGFTmmrZ6ULxZx2XhQFPgl4tyKY0V6swSjn4/LgjLPzSK1zZLsOM",
-	"RQaKU6dKRcDkk89sQpX6m5pcWqTl4o+NW0uw9nxQsfesZXPrVlQEVBhvx1KNwlxfc/ySPRvxcdxjtpKL",
-	"edcScvWx1cZB3km5R44dzJiDGtkjprlvhXEtRlltdVe9v6oseltnQm+EqQ+FthWXdjEMWRRNWXjfWVxe",
-	"rcKFy2h50+zH5aHigPwxE1KYhfsSpM2MQ2aR+uwqTQ1caAgtLlVazIUsSK+l8rSLl7k4uypPqzR27/Vj",
-	"jWUWOuc42fxlgos6BjnG/rYqfvqwa7scUslwpxy/4qIOOeSeYlw0fNNZPSoOW9vNPc+qm7xZ1NkjT/ug",
-	"I8TO1HVOMws6Q5x7ZFqVpEuGHIG/pTrq2We3Pt/p1WZfbODxqZ5KVUKHTGGmNC6ZC7nXE6qATsA+e+nQ",
-	"+KUy1PzyH2uTdzJav5hAmGp48Zatnl3M4fxk9O9WJXk+NssmqUJaRdyoB8jC2oQoGa2Jh/2gA+GlKKQi",
-	"ynk2QsXfF6SQi2SCkVyy059GI1qpQH757+0uhVMDGiHxpYd2+dqaZtv1yW85//jx48dOgZsSfpCmlLHK",
-	"DJteaWv7Y7WEVu4h2DEc4RK3yXmbB65WidBgzm8XaUBGJ+QXJsnJz/8akdFo7P4jr9/e9tbUcfGhmjp2",
-	"OVZTt8mTavpYT8hb8mctM1dVqyZoF+ed2fnd1DIhNy2T6qiWfTvT7Ru3+Y5c+31R8K2nJGPSp3wtwHnB",
-	"86+aNYAIeumfHx3GxdvCviTj511U3Bb/R1Kyj//9yLiPiq3xvreKPvCPVNEH/nEqboR6ZzD2DXSV2i1D",
-	"3qW6h1ri3RbWuNVf9xWsRpnnsm1vGfJk2y3Atqew/RhEO3tvpZBaRttSUHUntJoHDkhk1dLpmBy2s5Cq",
-	"qVqUUn31LOqpqo4966mvmJrzkKrHq82gvTViRZw9J2p/aeJDEinGCZPELSQzEUH7CxLXbqMf4GVJFVqw",
-	"z/yjxPpcpADXVEjm6oKGXw57AdCZNnWmfspcX+zofPfDvwQY0LPnP7exoCIxk2uSub/xvuAGxisR4zF9",
-	"5wTOrjWmSpInSuTP3cuXoKuDqyB/RB+4YtZqFt5XF/lnp4MS8Pl4q2XsXDsOo2Yz6vGERjFb7FtjgF3b",
-	"F9o4u1QF9IZ5vHv8XwAAAP//0poqQGYvAAA=",
+	"H4sIAAAAAAAC/+y9fXMbN5Iw/lVQ/P2qklRRlOw4e7e6yh/ySzbe2LFXks97FaeWIAckcRoCDIARxfPq",
+	"uz/VjZfBzGDIGUqWbEdbz3OROXht9Bu6G90fB1O5XEnBhNGD44+DFVV0yQxT+K+TYmq4FK+4Ns+oYXOp",
+	"NvBzxvRU8RV8GhwP3oh8Q7hhS024IGbBNZm6xkQqQsWGyBnhRhNdTNwXzvRoMBxw6P9HwdRmMBwIumSD",
+	"44HvOxgO9HTBlhRmnEm1pGZwPCgKng2GA7NZQVttFBfzwfX1sLLUQikmzFOeNRfrvpEJz4iiYs7ITCoy",
+	"47lhMJTdR+vSyoHjxa2UXDFlOEOYzZRcwn//f8Vmg+PB/3dYAvjQ9tGHr6Vgm8H1cGBkx6bXYc9y8r9s",
+	"aqCzNpscfskYW70Jv0aAeCGyc75kTSicLxihth1hIiOGL1l/cDA3/G5YhOPLqGEHxnaqnaEHRpe2+0Hj",
+	"apoXGXshMpZADPcVwMGyHfuOB4o3n7EZLXIzOJ7RXLOwxomUOaOijqavqDYvDVu+fJ4+oJxqgwshL58D",
+	"BZkFIyvFLrksNFnROWtbX14OvDcNnTGqpovmwn4q8vzAsCtDNLYg8pIpYrjJmSZUZCRqrkfkrFitpDKa",
+	"/FFIwzKyWiiqmR6SsVRjbD8+GBMjiYMpWUuV6dEH8Zqa6SJgIOHCfqZkweeLnM8XMNpYC75aMWNHmlJB",
+	"JoxoqeDbZEPGiuXskoopG48+iBZg2W1UALUdMPz/WghqSa/4slgSUSwnTFmeB2s3kihmCiXazkvDkEk8",
+	"ejSMTo0L8/3j8ty4MGzOVGN9Upnm+uBXMlXcMMVp6zqgazsxX7DNLmbl1gGz/WIZnFQZU7u6Qfs3KnOb",
+	"6U/ZZ4Yq81bxaeJk8Bsg0gq+92dyuhz7i+D5uN/dXB+3tSff12GKL4Tzn9M58hOYJLUh47/H+9l2QGHA",
+	"68ZEequCtOZmgYx8zi+ZIIbO9ZBoxsjYr2HcBnVoW1kgjpjgVwFCVCm6aSwRWHWCTCw3N0wt+6ACMv4d",
+	"zPNlxpYraZiYbn5hCf3xneB/FIxcsA2ZLqRmAng3wGiacyYMLge1SsX+KJg2I3LKjNrA2gI4NV3aESyn",
+	"1fijVHzOBc2JYnolhWaEC20YzYA3r5gCfINRoC21ZEHnlIvRB/EL22hCFSN6KlcsAx6OK3LaY6GZQpmj",
+	"2JIBt2cZLpOSnC85iB/AXlhooWEGimvD1VKS8dmM4TBuQ2Qisw3BDQLysiySVgtGM6ZKgEfAPABoxqBf",
+	"0qtXTMzNYnD8+IcfhoMlF/7fj5qEdO27Wk1/xU8dlJrEPJUZqxBomygaDpZMazpnaUxoUK7Dy6fF5le5",
+	"fnHJhGlOTi8pz+kkj8eMNSoAIlcsGxz/FrX9vX22ZzmjsKSW+abuc5AnnZj6VArDtGHZO8FNggf8GpSC",
+	"Ahp4TMo3ZAoalFVY1lwIQJcJz/SI/MrW+BdZFoAjjBpHFXZ9TqBJMWWEAy7R6YJpMv6joMJws0FGslt7",
+	"GA58h6qOuEXbiCFehVYDDtHoW04ENemW45gBBfc7C22oKXRXXcU2Bux0QrObyHJn5S6Z2yZ6yt3e6rBz",
+	"63Qzb4PPlWFbQMTKa15HERqvwvfeMv8rbi8UCWJBNJ7uVAyf+XZAKpXreacT7bnD4YBnlbbpG89wwHW4",
+	"DdY5CwBJM3XJXrOEPv1+wcyCqYpQAJPCgmpHiRl+W/AsY4K4oSzJjsibJTcgJNYLJoiJtDLoLmSt+WCY",
+	"WJy7+TRX9vP561dkpuh8CWty18aoiZVCS1A1WGavW2St6AqkHBdk/KE4Ovp+uqTqAv9i4xFB/WUFixLG",
+	"rnlsb01jEForJS95xrJRCsA61ka7Hd3e1GvyFrETY7vFA6e1VOw5AZWHFQW3NHJ4ZAlL3EIxyLBayHXV",
+	"i5mZ/Sl75XjyLvZyatGtZblVMtghgKPGWyb098P4qhsOpXlnie7xTsfDCXWJhqCK0XxNN5rkXBur/k2Y",
+	"Ng7RNZlxpc0Iz7NYwkr9dJX7XdXAl8SCsJbB7w34lxsMOOynA/3yEueTOQxeCPfHFMbKc5ZtG+8cf6+T",
+	"uvtIljJjow+CkAPCxDznenFMJsWGKY08ydqN+JSRYjV0XGm+QL2TZ6BzaNc5K8x0cRy1z5RcaQJqMZnx",
+	"K5YRwM6syJkdBmFqZwIFmU6nbGXiATWjOcv+he2OrS5DVWCKhTA8r7A/JjLdWGHGFFnByZoF44rItYBf",
+	"qzNoNpUiu5UpiB3rIIbRt//NpxeKbb6zirk/UwdswNnC3R6jDZf/tCMmzzfoBg2ym/QQj7EGt13tdDcO",
+	"ltW4OhdkWeSGH/ihPMB0Vy2yn/YEF6jk1RU+nLJVYajdwXYIRC3rnAhnGCIYt3DApzx7KaaKLd0ZVKH3",
+	"mgu06HHfhKgiZ+5CyogAM2iJi0gix4RdMrWBn63erijXLKKpyYZQQ3IGxt2xNmw1dt1XTE2ZMP0GcJ3G",
+	"vrcX+F4nsV2+VbJAs3ax+s7NZjhTsFpobJfhu6LZGT6TNdzGyRhMOSjphTSETuQlS0xBV6scnDrknAPf",
+	"wZVbGxN0J0fIoqvWWRzXUlRNQNq9dLuPAJ9mqx7i1Lm2gvVkh+oe0AM21jSu+H+XnB7RYDAMu7BzJvl7",
+	"DWXx6y4sxWXczADZA2C1FeI0boCWhZ6yqVTIumiev5kNjn+rr7WTbt5U2xLzdb13/W6XVkrlhonYFLpO",
+	"OsBAvtFWpIAIFJ4lBnoVGRfzYyc0gNPX9HjjnEkbZlwfd2m0fWCCyA6wkHmm65JpCB5UrgnNczmlQDmW",
+	"j8sZoS0c200lCzPh2TGhQuJNxYk5WJn9FNbg1ybFcUVUrqkmoKR441fUNpcg0Nsaa7lkUjDCwAnmYAC3",
+	"DlkYQommOYNdQY+g/FQFqwPtINyyB8OBXTT8JKEprCApUWM/9R545w1tCdG0onBSLxO+w7f4JXi9ywse",
+	"SAolZfjk3N69cd+tKkVyz6IbeHVVL8/ekCePH/0H8TcbMpUZQ9TJc0KXshAOk0rUjg7h/D24D9+dwf99",
+	"8e50MBz8/e3/JEH+HLSfM6capt0OVkigQNDEGbJ01TODzkNUOEE4ZMzxu7GTh2MuDFOXNB87DU077S62",
+	"fc1yKRUq82j9qp5/GLIzoyyH69zFr3IvY1q5wmigyjJSGPATY9mETi9Sdtul32/j0LzEqNniNVOAap4Z",
+	"Io9aLyTJ2cye2szNNrqJ2QWc092aKgookoamc7gOjq2t2/79KKUiKJnvPEIPxlNo21uhNXI3MJVl4MBG",
+	"uxG9g9PQy10jB24vATDDcMhbtNzK1hrLhF+bS7SxPCzFGTSwbFSu4eqXZAmvZcYUKubPaMqjYO9n2+1q",
+	"sHuQfbZt2gx2+wgHTc9bbElDDK04ZStUYk9Md/QQ0iQg/6s0zFKW83jRDPBYG0WNtHSnmJb5pbMmTqlm",
+	"SbpTuKRnwNG7as22S0IbstsL6hDAY0hknjFtSitKJ+3ZjpTSmf2u+oDQ93m62U1p+8LRYfbO8W27GESj",
+	"/U2ZVVrxFs2t/KBE0mFJjY6mhqVfIUaLBu6WKODQM8U5kmvbokBTsgw9LJidNrpi4pisKUeBj2oRu+Rs",
+	"7T5b76u/j3qdEhkAqImOA9i2GddLrrVv7DZB1kwxNygo4iIDQzotlVNDL3CIiI/BogbDgZ98MByEsZtM",
+	"bTi4OoCOB5cUUUHDCFXwvLHjVX88KUevfnhezoWAFinf+AlqaZ4XL7mQCm8AAdEtqL7RQckbEjaaj8j4",
+	"0ePvn/yA1/ZHj78fPfmBnL9/jmDBD+Tvb/+noolyYf7yJJapf43+F4nXowYvAcDM5YH7FY4/1yO7nejT",
+	"AV+uXGTSipoFRBI8ObSNEddfb+CS63xM5c2xgwsgOKeuh3UpM4nvfDuuiqUnYbnp7pqqUWk5oR+mSVRw",
+	"2r9Kw2d8GmxctWXLbJOUPrcv7S642LnVeLG/QHvcNG3xmvVUm3p4bXCtpfMGoeRWskX3iVf/bEGFYHki",
+	"Kth+gMtrxnIOIY2UiKin4z1cTOTVMeHigK5WlQbod3CGrb+9OCeHS3YYf9fezMeWlOfH9j8Eba/uek2z",
+	"TDGtPa1XBl8phiEjU+ZN62s2WUh5cUz+fvbmV/L2zdm5H8d9Ie9OX3UYK2KHuDn4NywNLtp2oI6sMAHn",
+	"l27AxKcXbo7Ep/d+2vTh6dbT0/HxwY2QAMYAp6ycJAEcBkMLNegqENIQzezfEmzibogy6gcB07xPWiPF",
+	"v7S0NNxJJUphY0I/csaOWx51fdsLvd5Bb7847lI9rl/cqSQprG62wquIAXxG27QL3iotFtEtlWr8zsW8",
+	"btGy31NGvOgMj1tGtf5DaU1nYDcDzUSDIbxA2mWiZr2qWaqiKfYgJQDWGz9g/cN7KRK/vsAJz3C+2oG8",
+	"LWk/YS2ICKwnaqDctHyjGVgPPwfu5n0n2Ji4KUfkRBC2XJkNsXAhii3lJdMxYxxZ7aQS4daMyrHM453K",
+	"U5ER52+/PfsOGaNfhmvfbSHvTl/VF3H05D93xtdU2SksbVjCOiWy/lEwndYMqNDr1P3kBH+v3k6GbiOl",
+	"D/QPNy6iL/ZoidrwH/tcz6i+6N9B9bBA4QSVfdyRCeqP6Dj6GG3sBqP+HrCDElqp43fX5sbhZ8xQnus7",
+	"0gwVo7qTA1Yqc2rbBqtCr3N1XbLtV+kbhMFUTyWsMGxxGCC7RY2sbDRhM4HfrVCzE8T2spWSCz7hBi+B",
+	"U7jQMTVj3KBhjxbwq5zNmNA2QgQFX9KqVnWM11jAfK7YHM131jSoCbVAVmzKOJg+0BNrFIpIsqLKCKZ0",
+	"U6+ZejNSWyiBH98P3DVEQE+lSoWxXDJF58wNa1f5CCTrD+j9OirD5HA7PkbOLQKca/H8mSwmeYQU9jVO",
+	"M/YS1+KOI3niZwue5z/ldJ5YsV2JLvQK47UBKBqao5eNi/kwikznc0FzXXrXDdOGZMywYFet8XgXeLHt",
+	"BDQzJvI36hI6VkEZJeE/y+l87pl0zUfDl2zbEm816nJV6MUpoHHCkrSgKiwk7G69YIqVe1QMdklnBvbL",
+	"AGcghBoNPAh3LogULIraALOHASQCr2EnbBkOvCmpj0jTdMnOUPj22p6ly9KsWDtMsuy+6hYSOyv0ik/x",
+	"3Q80IBNm1owJF5bxaGhdzuhpXsJ37doXuuO8Pp5nO893CNjmAkF371a8b+L7Oo3sKRHgIoI85fuxBm7a",
+	"5vHFmBpTT5JbyJl5lkud4m94jqucTp0fHMJs11xkcl36MidsJh2Ohwe5MDvT1d+MJGN2ZZjQXIqyuyUG",
+	"aAnxSM2rqu/R1Ttgl7eXE9N1HUaTpgHm3/xVokCpnkaS0/4LwJmUh/G7rpquL9c2tAleTY3daybUezFO",
+	"yYdsUbE5jl9mhcAqKQLSwgi+eZ5XmlvPtKHz6gWQig3gV54nF/0eVpx/ioj6fp7qe4mpv+Xg7uHA3s97",
+	"cOkO8eDVaGCMCN8SBR6vIYXozp713FqVEnEp1Bi4qemutDlVjPZ0P7LLDmEPbqEYM4UBx75jV8/prd9B",
+	"wGN1YqHTZ7fQ7YVSMh1hKtjVPoMq5uyCmzezJr8pX+j7VvbJIvCR0LNL7A9MZJ/htTnbwIxBdCVkDTZM",
+	"HBqNyGuu8dEhqs32NQkOiBayWGdPhVJ2Ic8aRm/zWHr8cX/FF7FAPgH/45OL0bwDVXXxTHpbjz+hehyf",
+	"904aiYGqGE2riGJGlQJDF9MpY5l3PjKRrSQXxsHYB7oRSh5fXblDcj1nlOfQDWOv3I7dj23Bb2EyQBts",
+	"2dGAWAPO2zBiHWrRBLVPP7n5SlC/cHtNiKz+DKlySh9bGFb3ON0U66pbvzuyp8KaDjtIjQItefFOwro7",
+	"Ym8I/a8i7VN4DlxBWf/yuRnTRg1NmFwvMRxRCsOEce/L6yAaj0qgpHq/fA7Plx2tcBZ4Db60xm2SC8ZW",
+	"5W8vn3dibz3Yf09XIv7QF0+S6gB8iTiVmxFhves00y914NfqiSIEHWsAld1eD44JtY9yqHYXhhE5s6HF",
+	"4WmL9VcJMyJjWBG6+CkZ+2hn72f0fg4mAreqxOpaC0JlEEHGjQe5jeHcU1S/Uudh1HJmyBTuP8Tq/8Q3",
+	"rFxf2qaL37eOq+ywBE55Z7Obiv/tBujHH3G6pzx764ePf48h0fIpTAo4UHkOfBdPecqQcOemdDe/L/6x",
+	"TgBBqzn2GgNtZwkDy8nbl+jbWVJB55h5QWRo6uRTvrL2RR67AYneaDA5fxAnUUw0kJmDlu4Xe6OZvcOP",
+	"8UBdhiN/VRsTBwqL4e5SE57xnbx9ORgOQE+0e3k0OhodoTd4xQRd8cHx4PvR0ej7wRBDaBC1Dt0iDssA",
+	"c/h5nnoZfIqazCVDFaRsPyIQoG0UYz6DkmKTgufu5c7Yh71jRDWgNR4hsPDB35inhWfl9KUGi0t5fHQ0",
+	"QKMyCiP4E6/g1n14+L/OnJ7IarL18uuD/Jsu6bpyMUAlR+tZkTtd7pLmcIQRBBAfdbFcUrifDSCiKPo8",
+	"GNrcK8e/lY8LfocedeDbu53UCdg/Q3WA0DIzXiEypiLooq2bVl4LbNxj69AG2Ka0Twvc+71KoCO+p7Co",
+	"z2ovDqrn9lbq2sFtBsNK6r/f6hugCERiJBhZgcLiZzIhO8xUygvOymwlttc5dKpkKmFXdLlC5L+6uhpd",
+	"XV2F/yQu7L9bXsG0eeoCozojU5UPd3rZ0e9tRsuzjGozowp23SCLRwks8efutEeiA+7mGwCyzQaDA7yS",
+	"0xZPEOaOc1/DoyY3YHii0pIZTvHElmE3T3qS8VbzTpRrJkGwL8UlzXlGQFOIsgvgIh6l0gfRwiyk4v/H",
+	"MmIRzjX+Pg2bGHPDs0ZRJSXHEti0UCiMf/s4mDCqmDopzGJw/Nvv17/HHOMkywglgq1JlL2yI9M4/Oj/",
+	"evn82q44Z6lo8ef4e8xD/KOqSl5N5CNRBK2+Eauwk9aYxbOw4CbbQD4A8qmZ0RObV8miV37Cz40nVcj5",
+	"yRZytidaJ+e7RmgYYdsqodsMHgq7tn+9K4LHPfhVaAN+VHDy7sDqfhTaIJ40gaJqlXIonDJ0ZNFKalsI",
+	"DSLcOFnuQ9asNIGLm0GFM5LfTlMXPCfv3r18TmxsEQ8xoCD9QRctZ5lSAecyYdgW7ltGYi5dyIOgi4lR",
+	"jPUh8A+iQeJvYccPFP6Zax17aBhHW4i9WGW0hSX92QR9H77Yh+W8yLghtIdGwL1DcPsVAtSMmBVu1e/R",
+	"ydgg4dShlU0Oaxkbv066nNRSf3TNAwEIM8GEhT0zBFIlC+3ia8Mtd7vq3bRiB07bzYS6jzd5l23eJrvZ",
+	"MWD1Tfg+7uaqJaxMiNxmFeMZE4ZPae7sY5iNwCekB387BIaT1+ksNzarIqFAsIXgsEL7LN3mCsrlmmkT",
+	"52fsamlzCbH6oYqOg0m2J072DffOtYZP8PstzySz3P6kGDtAwMH3EYFcuGhJM4ovl+5NHgBSTalm2ZBk",
+	"hSVWZpvZSOus8rp0Jzm0BwZ0cQfE2bUayVhcTEB7esQ9L/nAkT/dBd8LhD/H5f7ObiexJpFJZnWJBb1k",
+	"cdyekjnzCVsmVAi/sceP73KtvBTeNuswBV+BYjTbwPqz1jTEdTNoadSItY1IjXHkk9BiDj9yrD+w1Z7x",
+	"DJO/oEaGyfHCNHIt7Eu6ejqeEcHgKaZCHAN6kdALM3ZOyRB4Ow7JZcbeE7/duoElE3zZhN13Hu6b/oks",
+	"GpZ3ebjepT0j/ep+2J8YW7dVM388OmpPuAjWCUS6njeCCON3UdRwhw/HPVmwTmW9YlM+49Pay3mgBNCE",
+	"XEC3zSr9lGeulyqsNlPLXRXeQV5yzSc5c7aJMvVixlSTkkpX0AMZ3cT91bijPEu/xjiXhuZRqRE83KQG",
+	"eqN7js/npttK1ATMGoKkKHOEkHeauZfQScFwCH3G4R0esBCy4No430TX7Hx2dSnN0CLqs11vWQpbfMC2",
+	"boPgvd/2+vhD98xff1dhx5/J7XKPtPL98uR/7vnD979i9k7Gf1cZwOm8Sm13eXvcryBA3CvBYuHXKENk",
+	"PQkkF61JIIdEUXFhtWgb1VJNSggBwjk7gHEqmX67RVuWO02lBEjdoqtRkomc2xhlH4mbYSn7qry8U5Lu",
+	"kvtGccYurC8OLwKMSV/su8WQoJ7lVLEe6mXlmvU3Zqp6W/QaNaEWtvinrLG5qlhuuUh9EJg+ahhXBhiS",
+	"ALZh6YDCt3N0bv1JE0ZYhvVtwFImNvZNUvR2KU6zPfpg0+DrITaz5eHcA0lMpGzHRF1zwvAJPjwOi0Zz",
+	"CcYxw3LwpsUWtTEo+ONIPqOL7Si82ucY/eo6lqbTpiMufubv2+3ylT0oug92+K52+J3x7n31l6/Zrnxz",
+	"AX5r/lJk3bfmK60ifp9SWe2GVC5WhfnajC9Hf703uFZFllRNieXiMby8Kp/eluLqk1mQUmK+n0X20Jbp",
+	"aPczPy024Yh9qmaPFFRfRJXHwIiE17XwOoCcl2BIlAXxD/EragJB7xCnhlkXyFZXtpW3J3YLD+alG5qX",
+	"+lQiStYW+r0rQTksuC2b8b1wBk+7+AAHBJxN7T9sVobkugfLdIn5v2CWeR4la4ZV1bnCJ+OGlg8kqpE0",
+	"WVNPJjlx9/GkER7fB7ij80bLWlY6e9hVi+hbqi1ejHlWrbYC46QKfBOqyRhagN77HG8tc2bK2jPQZvRB",
+	"3I5J/4PoZNSHye6N857HENujKjrCcXDjJTQrfePrvBsU+n58NNyW4P7R0VEq78ZXK5hCCqqmVTVcDm7q",
+	"IqgXMnXWJTvsTYxC1hVzP2EVJVnua45C5jbxHKWTpzKtR54VkyUGQAKt2uLIST/liLzHV05LevWUZ5WS",
+	"ksPAZbkuM7hjjWZtiIu2VvJqYxdMjVxCAFi+CYqmYhrso2IeWF94RztRkmZTzJwAYkstKabvsmEEOrBV",
+	"sLHW2CmyUsHsy0u01ifqmQzDY90w0RCXlUn8VRerlVQm7LsyYdqo26EQMDztc89DFfOlhXfN203pvmPG",
+	"/xCi2u/hsD3OPd8Z746urJUP3OZ7GJETjBoCYlIM327rSNP9RpfyunMgZTPP+p4BeUdJL4vPznVnFp6Q",
+	"Ub9XqvmdIgm2YqSEMMuHu9Xt3a0eHd07YOIr0hcXUtj1PoeJGJzGUMnWvY+da1JsDoTNYdfB0CULo/h8",
+	"YawJa1Wo6YJqBgijmeI05zrSPLg4mOXQ2mkTIrOWrErxkt5WrafozHiwam0zxfuDebAjfYV2pKeWYoMZ",
+	"6ZLynE5CwV2uXXT7pzInAUOou9CpwRBKx0xIKKnegxGVuayS1qQzoxhdBjeDbb3twkQKTO92dvZi9EHY",
+	"ZE0AWn0csgkdu7jtSp3vkFKIfOsDUHwx4HpSH5ekNJ3YB7OSVtP6kG9TiXz86M5VedwoPb4r0Il8myhQ",
+	"70edFBsh13bQyungTmEHmaJrEcaw3LUyBDa3I+xwbGBhyhXLwmhlfX8/mL+NHSfvZi7Sy97HdtdwDc4l",
+	"P98zN15lSptN/jjmEfYn4HVltn9XqcJLum99eQE7jDceVu+4unl7LStm1PNJYWEMxkRIS/1tI43Ud5a7",
+	"aYvrXFuUcs6zNVWZ7mp/fOEzrN2JlOwkmSJD0FQKYbNmg23g7OyF2/LnKnS+aD24Yrc6V3R6UeWTIRlf",
+	"H249i6qpbrf++5augqNLqV0hRsvbIMaPKR+rD88Sq26CenHF3UQQSr7eLxncblKnsKkbJHUqq9PewPoZ",
+	"DnarBTQst90EekqN1c1qRRdaMGFE3jtMUb6jL+XiUcn+G79ryCaI7UENACOkLShOhe1u7ZKJiUXVkCnF",
+	"lNlbj6ski5ceKrhBpTnnF8zHJYa96c5Ww7vG1K/TDoh1dnem17fYgEZpanxWkEKXIaAxNnkQ2yDyVkVk",
+	"QV1JALOgtqyCw7nka/Oy3nRUJOnR0dFRovFtVHaumQXdkDd4qnsrFpWSkTUZl//mH+zel6PIggoUU3do",
+	"Q6+k2sAdrsnS5ZGWynn1/cl/ulgzxsOrCMfphFRh5mq93U94qb2huSzwdHuPBT6P0A1yJbafgfLQ8576",
+	"isGdv5RSopuUatd5/FWhQ8hDaOquFVRsU2Y+iBN/IYk6KjCwTXI+HZF3gqYbJMIV6mpVszLYsusl4h9h",
+	"ww/Wtk+jzHkI30SZCxhxI22uxKut6lxYcLs6d6IvKnhYv2LXBrclRu2TLOMuLahnxXX4dilaHwS+mmBT",
+	"uWTakY119TZu/Zpw01kve6CA21DM4tKAuzSemq4Sut63tlJSapMy/bf70lbq1GIrWkrlnJxifovKiKMl",
+	"F+BN9UVD1pUhc07UqaTJ/g6s7rekoOwdd6kvdnK/NGvtoIMcfvR/ws9lxdMWnozf0+bO+GC+0bWrNsLB",
+	"du7AhocfRKWCao0hA4+GTCR2QJ+KBMaNCnzDoN9o982ZBXuz638E2Jz4WqJ3xb4TA5cn9SAb2mv1VgoG",
+	"75YMruMN4lvuTi6UZYTvXjCU1PupxAIaUExFNvhZu+qmsmx6vzw/rCK+fEbH14v/tzDdG4oAxXKut7xM",
+	"epZLAcdOCtGwnba/dbYpc61DVLuUEVn5NqET+z21K3tQlfdnh71fuPZ+dlpjo58mcZ/F0S82c9/DC9Re",
+	"CnlCHFR5jy5rpli86MlJLWNp4Wk9PZeqrBuf9kbhd0J9MlRrn7jkErM4IfDwjeuI1LxJrp9ntMFp9EHA",
+	"cbnPXBOaZWU1oqXMHFslU6pZ9dhQXyYZ12BjxrIUNtZOMbliwlfE68SYcc8PjHl/JuDTjSTwJfYQIXrY",
+	"MvdckzE+vsCSQB08PaFq/o7yUGWF/YZnx/5837YSh24JZmi/3LtXxx6QVLWkNLdvH3FUXzWJfM42j4pU",
+	"8fqv3YULQXM76Me+PWts4duvAxfcyrrXZVKfdK7UU8xPU5+orBy/9kWlg1ZRY0Sd8p5idtUHl8i25Kcu",
+	"S3UC8n1JrQ+evRM4zRZEC1XFrf+iSJnKsiyRbNd6KmQHLLLJd21RPddtzafMJ51jsxmbJuKX3hbmAdH6",
+	"I1pFmdobyT5bdlyiGteY/fPThXW/70E6dRbdpZpiNKoNPCYSG9GczHgOyI36rpb4OnRXgJ/uXa3DdQZn",
+	"JyZ1G1wP+/Q5Y1RNF307lRn8+nV8Vibv22NGvND36/dCZHv0OkM1r1eXV1Qby936zgVZAXpu6mqaFxmz",
+	"xWl7HkCZKrYXZgGx9O7yGvn855ocIForHNwdpgjwFczu9JLw2sVzdckR8Kt0/Kw9rqLC93aaKnQxnzNt",
+	"WtkpZFE9oGBEI64pWld8fmiNTIpMqBqRMWZ902OIXFvlDCNh3VrsF6ucUbFxagxXLifVWqooUbXboAtU",
+	"GkOuDg7DgijQZCVXRU6Vn9kwtdTlE39k8+M/xkOylNq4RiwLo21h8mcOEjsKroKQNOzKvu0B6UFmVLUl",
+	"Fvljq4K0Z06T+BzkzMYTX3CR9c9u8sMeyU1ul2e4w90ju2CvPqnst3owDNPfhGdEx/FZZBdpJLF1lBKt",
+	"sytXOLQVp7NttktsYIPpAapVLuEvYxk1dEI1G5G3KfKlipELtjLtBRNVNA8XGbva6iBypOwW97mXUL41",
+	"YkLAsGxbWv2qUuw6jHYndvBD/97J/5Cxq1Cs/H5rq/YzGpU4VmKxBdIWijGLQ8hts/Vt0osr+3CQ+F06",
+	"A7zMMIh2Bmte4EdbKw2/znK5dk8CMq7YFANWpOJzLkJCrYQwM4tnfjk7EP/MSHySWJ1WG2pYK9o72zZk",
+	"d2cteK/Nv67C/7rc+9PrEOjL2LGOX6FRyzpEz2WcNM6mTaLCt5tJ9upcVZDXxbcD9Q1me6dywB2MSrdp",
+	"US3Goc0oXknbGjwG/qtQeUebj+Ld+V0TNjE+3pZDNyIdn8w8l3Muejl3h4MzZg6eITb+O5IB//7ZmBVI",
+	"r/86A37D/us1vTo4mbMfHx3959FRi9EvFjwYDYG8ipGFMSvrz7NoP2rB8HIpJFrKj07iwP//LxLWRdzC",
+	"iF/Z9385OoqT/Pz9/fmuDRfuZdO/O+zOt63sbPt+fJcf//nPf/6zdcHNFb4TulxjzBnqp9JuwE/wHqu+",
+	"7H8kOMiPqRN4cbXiiukfzxfFkBw9In+ngjz6638ckaOjY/x/5G+vzzvvFHnxvjtF7nLTneIgt7rT622y",
+	"vxHQW1F5t8jaihSPwRALc+QJrZL8zcRQLupQLFRekdStovkVDr5DLn9Z7Prcsi+ti9uMvsll4NVyVgc3",
+	"Zp1Q+/PtbSyiZNyP29h2ilfckH3jID0Zd5ctJnlD7y1aJnHDLeIgN9zidZXUW4mxK6HLwmxxJFzKC1YR",
+	"0tvIGob6fC+aDZUQJXPnNXjB3L6Am2SQshxEIby3spCK9NuifLULv8oJ7CH0oklvJO92Kl2VrXrwd96n",
+	"71DZY0fd63bF+HX1hm1JqkqvxqH2VorlSxcQmbZDvVvlkqIbHRuCg4+lTUQvcaDP3CTUJX5MTg0zB/a9",
+	"StUqFJBrwgVVm8QkewbaImgLBPVtyvowIp7dn6FK9uO/prigJEtwjrjjb9hyazgeUYzFaUsoS+atuB1e",
+	"jVfNgT7iORGsPyRcgGsTVFD09RMpICL1zGYtdvnwkONY1/rQ+9VRL17RORf2zKlOlB/V46Rofe1DoR6c",
+	"7w/O96/N+f5QJeAhEKBjsYBPEruIQmC5aY0XeB1JlG5lV6rSpPHeC9MR2gzCRs5tnV3017sk/abQZWCA",
+	"LYr+qcVLOnf+g2h5EC0PouXrFi2vN1Bz6EGofAqhEt7gvbTP/yzTbxEtQho+czs/WCk2Y4qJKYvFTYJx",
+	"/xr1eht1+npDPbahQBs0euBmfAwkOoZPiSwQnLTctE4dIUy8wfaHFKe2qImtw9YyaPIFhX0wyZaU55jh",
+	"WbDc52HUcN+2X2iWKcCekD6WTRZSXiR6hE/vTl8ln0wWXy4K7/e0cW/svbu8Gj2I6Nc25HIVie+Lx1fw",
+	"dBhjISaUtHj6SUn6HQJgP6pOCIQOlw4uDuhqVZkuSeS1qo8fRHvZx8rSt9Z/jHewpRBk+vLxa2WjO8ge",
+	"AyPxXU4hFKNZdcNtjmDbNh38O6O5ZoHIJ1LmjIqtxR0rcNmjymMVWp+i3GMVCx7qPn52aneF5BOh3Q5h",
+	"E8ZxQ/PooNuIYEcYa6CH21Pqa0v4M1iMRI1x9eDnhx9FhQlcH/rzdhrdDkVJV3nIqT3N3c9VRZ31/Ime",
+	"rVa0lSVV4GSnmgDgb+nZamWGetbXrtj1mirIXlgRMm6ZO3EsvFrta6TEjl1dXqD4T6mYsjz3DrC0ZC9f",
+	"ru6KwJbKEKlsmWdbk2fKhDm2r5fsP/JNWKVXXVy9Fy7mZ1KK41BeOly87UeipRRB5RmSmcxzuba7dTty",
+	"HayOkhSRNo1LQkQO7PIGwwETxdImAwk/+LUNfm8i7Z8u53JAhxZ7U2dZk3py/jXLGo/3bS6K+gtx4AT2",
+	"zrPDfPTet/p67UXdMNPC4YXIVpILcxPc9IC/CwVkXR5fQAX707aiL2zOtcH0iK6/TbQFXItfukRbuphA",
+	"nwnz9aY92VXFhss1N9m02ZI0mypmEGUumeKzDbJjPhfUFIppwrWNNrP3E1u3Fyvp+TNvy7T15eDu7eTA",
+	"inZUTdj6ww/D5qvPsqRfL/SHXvDQ2dX/tekejh/ViWE4KFTeRKyfz8/ffnv2HZpZjPT45OuVVzNxPT56",
+	"8p+7EiTa+FW3lbvIrEXz/M2s1fPZzivqp2WRPlGHLUUMSG2eICIGEoU/7QKUm68Jot8TfOt9GN6ygfuz",
+	"0r07fTWMk1gTqQKy9FfH7y47jI9Pizh9v+eVdf6bZN81OX740f318vn1tkRcNnNWOfaIvHVKcMZyfonP",
+	"vIMKZZuEUoqaCUOo2CylYm05uUq++96vp9PFcx21/hPdOT212eO6rXumH3XfK2YdSfoi4GGJS7svmlW8",
+	"C3P2sEO7ETbbbdDPXave9ucGQj8vd3ePqF1auiMIujghrl2g0LbHsoUeDDuyPLd1D8Iz23ubDTycyR72",
+	"7/KkPoXtO4LWg+H7szN81zDtDqNOSrz48iwGn0gOvKpxl5g/30AmHH7MAoWDYdv9q/3VyhlzoQS29ggu",
+	"Y0Y5WBf9SITO8Ymbdpnyw+9l5CRd+gFePh/tuDQmeH3JlU7Diu+U/SfGzmJO+bWqTY9vjQ4bzCWVBzlg",
+	"Tii2cMvUiNmN3Rz3msMyrMLXeLM01fu+4sZpEmU7jwhphVsSCi94tuUV2zm9YCSTa1Emgx+Rn20Ffmt4",
+	"yrimqxWjyr7sc41cIkub7Ez7OrKgAJAnR0/KNEwcq2+7CzwY/U/qrgMsAR9cHL58EjoLfPWkwHjGoeHY",
+	"h29j9XhNuKmWU9LOKiZXTGxLQA+zlw4qiitqTzpf5nBu5LH9mWfsIfn8DfQeIe0lu0PxvD3MU22ZbxeI",
+	"6vdRswj2C9j3CerXtRXTpZ4SpKum28xe1SlLMJFl3vQAwD6MDoilT6r0nUzuUvLswD8ZSXO6/5Y8IzTP",
+	"8Z2HVXwWJZ8bkvWCTxdEsZxR7Uzzvlo/crMVRSMiUJcr2A79Rh8EDMwyO6ywZwmwtjyT23IJEI+84NrI",
+	"8mo9gcmVLgu6w3Ck5GahoHuoEw31gUMaTM+8fBZyGKWcHXV5wK01VXZ0xVaFCfFlDiGc+6Avr4Mdp9+x",
+	"PJTM7cf0LhF3tqXPuyyxq0O0kRuvy+UNDtCN/gWxnvtQ7jypwSL2YnfIehzNV1nPHgwQNJgdtsCavqM9",
+	"F8KwD/gLLOrKKkcsqxXq3pIQ85KztR1xqxEx1rHSBsRnVLO+xsOwp2cIgM7BqxEEbtuWV11TB1MeQmYP",
+	"M56F16cw4VnoPFjvPr/XYhXcukPjXZ15fJkmvNsXT/2jRmqA7MPfDz9OkeSvD11ltK2lMDUq03jVhW54",
+	"X8bKx/QCL9q2viXWOa6XVysrepUKpSuEhGbA0Q7dEJmxZU/P3Uq7KIVTz9AeLsH3ewmGoyur7z3cg3co",
+	"owiu+y1e5ggYFgEk3NcfbY+a0Dp76sid9ILn+cEsp/MdOijAXBNoOC/D1rA33nxtmIRhTv1N66e2VIMb",
+	"o6amoiURNVKIUs7pPM3V/NH7hVjfBhoUwbeBFbC4rRgNSvkc0ENPpWJbNVycb6uG+1NO53truGcApp8Q",
+	"xjvUXAR1BQyacKENoxmsz/6wptx404Vt257F0450W8+3EE576LsWep9C37UQedB3Pzt9N+D8Haq6VXaE",
+	"qPGg7e6p7SZg2V+kHH6cIeVfH1pO1K71uqc70Nw+2bGMa0Se0ojxawZwNSzflBLBL5FrMpVixtXSFteb",
+	"OPGULMxWVXpL/mwZ1aldbBfFd+Y5258oMg+AVJ7Q56ze4UrvVb3zmkxZljaAbY+XbU2ijImlI32iJnf4",
+	"0WdrvT6cUFGNi01Gr4YB3kH/d673Uyo60UmU/vcGVSg+d8oAZpXzmfnMyQIO7waRTzOr/06osLZvWFgb",
+	"7rU9pQFI2Z7I4gWz1R6sZbr0e+VyTrgYWssvKghDQP8hmRRWx9cXBO/p7gniSYQg2meUd5U6/Jq1oRti",
+	"pXQhDM/tBYVhfmIY2PhC2BOUOpl30WnmLC96t4vtT0wjt2E2KcvKdzCc3Fox+TZKCUUi7tyWUlblZ8uV",
+	"vdV6s8qQOMexZwvN0uBfKwcqecc2idcQczPnd2+1c/zNXeyrrnU7U/Dq+1Es13AvtbIeIflhgO32Bteq",
+	"p82hwnf8GHfIfEo3XdimB1H5PlHmrcYC+NbZXef3dwqdtpot/Fr2MV2Eg/gk5ouATXdswfhMrQYBZROJ",
+	"XUqy3DXKadmyKSDCp9tM4eLP8bMxN3TludVLf5NoJ5smqw2HZB9Jup8bBQt9kCgqIUsq6LwSJRqUObjL",
+	"uKgpRacXcaPyAayjAl858Xq4fTrLsqtFImCGau0jG1IKPEdHnP5blGHDUrYNiYYYVSsDbJjXd/jyL2MK",
+	"gRSiY+dKFisd0vp7MznPYBlm4z8oQo0tT/RBwJhsOWFZ5llkrWAMOamGbmA+TGRhTC251j5bGYTC4Vas",
+	"aAgAi2GwE27hmLA+QAx5WyCgc3/URlzeWmIUY43SodHYlQS324a36UcaaBQNVeZz2DVWXV/6RhMIm67E",
+	"Q/soLTf4690AUNT2njCzZkw4zLGj+fBDW5ArDpfUGIAYzRTxwd27WBWTnE/DPeiAiuyACr1G34tyroM2",
+	"gP3D9dq9M/QjA+xXSi74hBuWVaEFrWpxRkY664TrrodkgbGi8WosqKmwN8DRB3Fq23pZjc9T4h5IfEhr",
+	"SDNGEilY3QFWIYNIO9wDL6o54WxkZluu0zBlLU/Z9kk1n6Oby72xqaSVqKWRqK2teq4fxAt8tuCuYWRK",
+	"lX3Ot2DEFVch438e/OPJgXt1cPAyGw9rP2Eyg8avkJJbG7pcjcm37wS/IppNpcj0d5aHVRuf+Wf5Yx+I",
+	"yzUZ6wV9/MNffhxX0gjZpV0RJqYSeODPr0+eHZz9fPL4h7/AlscfjZ/4evRxIrPN9ZhcsE3Z2QHNJcyA",
+	"ANvKUwvYPuCLldeZ9RWyKytoOc2xSJ6czSr44vYBeQD+3wBwNLeaR0MBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vmihailenco/msgpack/v5"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"q4/models"
+)
+
+// saveProxyBid 儲存使用者對拍賣商品的代理出價上限，已存在時只會提高上限
+func (impl *ServerImpl) saveProxyBid(ctx context.Context, itemID uuid.UUID, userID uuid.UUID, maxAmount models.Money) error {
+	proxyBid := models.ProxyBid{
+		AuctionItemID: itemID,
+		UserID:        userID,
+		MaxAmount:     maxAmount,
+	}
+	result := impl.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "auction_item_id"}, {Name: "user_id"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"max_amount": gorm.Expr("GREATEST(proxy_bids.max_amount, EXCLUDED.max_amount)"),
+			"updated_at": time.Now(),
+		}),
+	}).Create(&proxyBid)
+	if result.Error != nil {
+		return fmt.Errorf("fail to save proxy bid, err=%w", result.Error)
+	}
+	return nil
+}
+
+// loadProxyBidToRedis 將目前最高出價者仍有效的代理出價寫入Redis
+func (impl *ServerImpl) loadProxyBidToRedis(ctx context.Context, auction *models.AuctionItem) error {
+	if auction.CurrentBid == nil {
+		return nil
+	}
+	// 只有目前領先者的代理出價需要被載入，其他使用者的上限都已經被超過
+	proxyBid := models.ProxyBid{}
+	if result := impl.db.WithContext(ctx).
+		Preload("User").
+		Where("auction_item_id = ? AND user_id = ? AND max_amount > ?", auction.ID, auction.CurrentBid.UserID, auction.CurrentBid.Amount).
+		First(&proxyBid); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("fail to find proxy bid, err=%w", result.Error)
+	}
+	bidInfoBytes, err := msgpack.Marshal(BidInfo{
+		ItemID: auction.ID,
+		User: BidInfoUser{
+			ID:         proxyBid.UserID,
+			Name:       proxyBid.User.Username,
+			Reputation: proxyBid.User.Reputation,
+		},
+		Amount:    auction.CurrentBid.Amount,
+		CreatedAt: proxyBid.UpdatedAt,
+		Currency:  auction.Currency,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to marshal proxy bid info, err=%w", err)
+	}
+	proxyKey := impl.auctionKey(auction.ID, "proxy")
+	return LoadProxyBidScript.Run(ctx, impl.redisClient, []string{proxyKey},
+		impl.config.Redis.ExpireTime.Seconds(),
+		proxyBid.UserID.String(),
+		int64(proxyBid.MaxAmount),
+		base64.StdEncoding.EncodeToString(bidInfoBytes),
+	).Err()
+}
@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+const (
+	// 問題的最大長度(字元數)
+	maxQuestionLength = 1000
+	// 回答的最大長度(字元數)
+	maxAnswerLength = 2000
+)
+
+// List questions of an auction item
+// (GET /auction/item/{itemID}/questions)
+func (impl *ServerImpl) GetAuctionItemItemIDQuestions(ctx context.Context, request openapi.GetAuctionItemItemIDQuestionsRequestObject) (openapi.GetAuctionItemItemIDQuestionsResponseObject, error) {
+	const op = "GetAuctionItemItemIDQuestions"
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.GetAuctionItemItemIDQuestions404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 未回答的問題只有賣家和提問者可以看到
+	query := impl.db.WithContext(ctx).
+		Preload("User").
+		Where("auction_item_id = ?", auction.ID).
+		Order("created_at").
+		Order("id")
+	if viewerID := impl.viewerID(request.Params.AccessToken); viewerID != auction.UserID {
+		query = query.Where("(answered_at IS NOT NULL OR user_id = ?)", viewerID)
+	}
+	var questions []models.Question
+	if result := query.Find(&questions); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list questions, err=%w", op, result.Error)
+	}
+	return openapi.GetAuctionItemItemIDQuestions200JSONResponse(lo.Map(questions, questionToAPI)), nil
+}
+
+// Ask a question about an auction item
+// (POST /auction/item/{itemID}/questions)
+func (impl *ServerImpl) PostAuctionItemItemIDQuestions(ctx context.Context, request openapi.PostAuctionItemItemIDQuestionsRequestObject) (openapi.PostAuctionItemItemIDQuestionsResponseObject, error) {
+	const op = "PostAuctionItemItemIDQuestions"
+	// 檢查問題內容是否合法
+	content, message := impl.sanitizeQuestionText(request.Body.Question, maxQuestionLength)
+	if message != "" {
+		return openapi.PostAuctionItemItemIDQuestions400JSONResponse{
+			Message: lo.ToPtr("Question " + message),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDQuestions401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDQuestions401Response{}, nil
+	}
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDQuestions404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 賣家不能對自己的拍賣提問
+	userID := uuid.MustParse(token.Subject)
+	if auction.UserID == userID {
+		return openapi.PostAuctionItemItemIDQuestions403Response{}, nil
+	}
+	// 停權的使用者不能提問
+	banned, err := impl.userBanned(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PostAuctionItemItemIDQuestions403Response{}, nil
+	}
+	// 結標後不再接受提問
+	if auction.Status != models.AuctionStatusActive || time.Now().After(auction.EndTime) {
+		return openapi.PostAuctionItemItemIDQuestions409JSONResponse{
+			Message: lo.ToPtr("Auction has ended"),
+		}, nil
+	}
+	question := models.Question{
+		AuctionItemID: auction.ID,
+		UserID:        userID,
+		Content:       content,
+	}
+	if result := impl.db.WithContext(ctx).Create(&question); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to create question, err=%w", op, result.Error)
+	}
+	slog.Info("Question asked", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()), slog.String("questionID", question.ID.String()))
+	question.User = models.User{ID: userID, Username: token.Username}
+	return openapi.PostAuctionItemItemIDQuestions201JSONResponse(questionToAPI(question, 0)), nil
+}
+
+// Answer a question about an auction item
+// (POST /auction/item/{itemID}/questions/{questionID}/answer)
+func (impl *ServerImpl) PostAuctionItemItemIDQuestionsQuestionIDAnswer(ctx context.Context, request openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswerRequestObject) (openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswerResponseObject, error) {
+	const op = "PostAuctionItemItemIDQuestionsQuestionIDAnswer"
+	// 檢查回答內容是否合法
+	answer, message := impl.sanitizeQuestionText(request.Body.Answer, maxAnswerLength)
+	if message != "" {
+		return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer400JSONResponse{
+			Message: lo.ToPtr("Answer " + message),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer401Response{}, nil
+	}
+	// 檢查問題是否存在
+	question := models.Question{ID: request.QuestionID}
+	if result := impl.db.WithContext(ctx).
+		Preload("AuctionItem").
+		Preload("User").
+		Where("auction_item_id = ?", request.ItemID).
+		First(&question); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find question, err=%w", op, result.Error)
+	}
+	// 拍賣物品已被取消或隱藏
+	if question.AuctionItem.ID == uuid.Nil {
+		return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer404Response{}, nil
+	}
+	// 只有賣家可以回答
+	if question.AuctionItem.UserID != uuid.MustParse(token.Subject) {
+		return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer403Response{}, nil
+	}
+	// 只更新尚未回答的問題，避免同時回答時互相覆蓋
+	now := time.Now()
+	result := impl.db.WithContext(ctx).
+		Model(&models.Question{}).
+		Where("id = ? AND answered_at IS NULL", question.ID).
+		Updates(map[string]any{
+			"answer":      answer,
+			"answered_at": now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to answer question, err=%w", op, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer409JSONResponse{
+			Message: lo.ToPtr("Question already answered"),
+		}, nil
+	}
+	slog.Info("Question answered", slog.String("user", token.Subject), slog.String("auctionID", question.AuctionItemID.String()), slog.String("questionID", question.ID.String()))
+	question.Answer = answer
+	question.AnsweredAt = &now
+	response := questionToAPI(question, 0)
+	// 透過SSE通知正在觀看拍賣的使用者
+	if err := impl.publishAuctionEvent(question.AuctionItemID, AuctionEventAnswer, response); err != nil {
+		slog.Error("Fail to publish answer event", slog.String("op", op), slog.String("questionID", question.ID.String()), slog.Any("error", err))
+	}
+	return openapi.PostAuctionItemItemIDQuestionsQuestionIDAnswer200JSONResponse(response), nil
+}
+
+// sanitizeQuestionText 過濾問題或回答中不安全的HTML，返回過濾後的內容
+// 內容為空或超過maxLength個字元時返回錯誤訊息
+func (impl *ServerImpl) sanitizeQuestionText(raw string, maxLength int) (string, string) {
+	if utf8.RuneCountInString(raw) > maxLength {
+		return "", "too long"
+	}
+	content := impl.htmlChecker.Sanitize(raw)
+	// 只有標籤沒有文字的內容視為空白
+	if strings.TrimSpace(impl.textExtractor.Sanitize(content)) == "" {
+		return "", "is required"
+	}
+	return content, ""
+}
+
+// questionToAPI 轉換問題
+// NOTE: question需要預先載入User
+func questionToAPI(question models.Question, _ int) openapi.Question {
+	return openapi.Question{
+		Id:         question.ID,
+		ItemId:     question.AuctionItemID,
+		Asker:      question.User.Username,
+		Question:   question.Content,
+		Answer:     question.Answer,
+		AskedAt:    question.CreatedAt,
+		AnsweredAt: question.AnsweredAt,
+	}
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeQuestionText(t *testing.T) {
+	impl := &ServerImpl{
+		htmlChecker:   bluemonday.UGCPolicy(),
+		textExtractor: bluemonday.StrictPolicy().AddSpaceWhenStrippingTag(true),
+	}
+
+	tests := []struct {
+		name        string
+		input       string
+		maxLength   int
+		want        string
+		wantMessage string
+	}{
+		{
+			name:      "保留安全的HTML",
+			input:     "Does it include the <strong>lens</strong>?",
+			maxLength: 100,
+			want:      "Does it include the <strong>lens</strong>?",
+		},
+		{
+			name:      "移除不安全的HTML",
+			input:     `Is it new?<script>alert(1)</script>`,
+			maxLength: 100,
+			want:      "Is it new?",
+		},
+		{
+			name:        "空白內容",
+			input:       "   ",
+			maxLength:   100,
+			wantMessage: "is required",
+		},
+		{
+			name:        "過濾後沒有文字",
+			input:       "<script>alert(1)</script><p> </p>",
+			maxLength:   100,
+			wantMessage: "is required",
+		},
+		{
+			name:        "超過最大長度",
+			input:       strings.Repeat("問", 11),
+			maxLength:   10,
+			wantMessage: "too long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, message := impl.sanitizeQuestionText(tt.input, tt.maxLength)
+			assert.Equal(t, tt.wantMessage, message)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/samber/lo"
+
+	"q4/api/openapi"
+)
+
+// Role 代表使用者的角色，登入時依IdP群組決定並寫入access token
+type Role string
+
+const (
+	// RoleAdmin 管理員，具有所有角色的權限
+	RoleAdmin Role = "admin"
+	// RoleModerator 審核員，可以處理檢舉和停權使用者
+	RoleModerator Role = "moderator"
+	// RoleSeller 賣家，可以刊登拍賣物品
+	RoleSeller Role = "seller"
+	// RoleBidder 買家，可以出價和購買
+	RoleBidder Role = "bidder"
+)
+
+// Roles 返回所有支援的角色
+func Roles() []Role {
+	return []Role{RoleAdmin, RoleModerator, RoleSeller, RoleBidder}
+}
+
+// Valid 檢查是否為支援的角色
+func (r Role) Valid() bool {
+	return slices.Contains(Roles(), r)
+}
+
+// RolesOf 返回屬於groups的使用者具有的角色，包含預設角色
+func (c RBACConfig) RolesOf(groups []string) []string {
+	roles := []string{}
+	for _, role := range Roles() {
+		granted := slices.Contains(c.DefaultRoles, role) || slices.ContainsFunc(c.Groups[role], func(group string) bool {
+			return slices.Contains(groups, group)
+		})
+		if granted {
+			roles = append(roles, string(role))
+		}
+	}
+	return roles
+}
+
+// hasRole 檢查使用者是否具有任一指定的角色，管理員具有所有角色的權限
+// NOTE: 加入角色前簽發的token沒有roles claim，視為只有預設角色
+func (impl *ServerImpl) hasRole(token *openapi.JWT, roles ...Role) bool {
+	granted := token.Roles
+	if granted == nil {
+		granted = lo.Map(impl.config.RBAC.DefaultRoles, func(role Role, _ int) string {
+			return string(role)
+		})
+	}
+	return slices.ContainsFunc(granted, func(role string) bool {
+		return Role(role) == RoleAdmin || slices.Contains(roles, Role(role))
+	})
+}
+
+// authorize 解析並驗證access token，並檢查使用者是否具有任一指定的角色
+//   - 沒有提供或不合法的access token返回http.StatusUnauthorized
+//   - 沒有指定的角色返回http.StatusForbidden
+//   - 沒有指定角色時只檢查是否已登入
+func (impl *ServerImpl) authorize(op string, accessToken *string, roles ...Role) (*openapi.JWT, int) {
+	if accessToken == nil {
+		return nil, http.StatusUnauthorized
+	}
+	token, err := openapi.ParseAndValidateJWT(*accessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return nil, http.StatusUnauthorized
+	}
+	if len(roles) > 0 && !impl.hasRole(token, roles...) {
+		return token, http.StatusForbidden
+	}
+	return token, http.StatusOK
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+)
+
+func TestRolesOf(t *testing.T) {
+	config := RBACConfig{
+		Groups: map[Role][]string{
+			RoleAdmin:     {"ops"},
+			RoleModerator: {"trust-safety", "ops"},
+			RoleSeller:    {"merchants"},
+		},
+		DefaultRoles: []Role{RoleBidder},
+	}
+
+	tests := []struct {
+		name   string
+		groups []string
+		want   []string
+	}{
+		{name: "沒有群組只有預設角色", want: []string{"bidder"}},
+		{name: "不認識的群組", groups: []string{"unknown"}, want: []string{"bidder"}},
+		{name: "賣家群組", groups: []string{"merchants"}, want: []string{"seller", "bidder"}},
+		{name: "一個群組對應多個角色", groups: []string{"ops"}, want: []string{"admin", "moderator", "bidder"}},
+		{name: "多個群組", groups: []string{"trust-safety", "merchants"}, want: []string{"moderator", "seller", "bidder"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, config.RolesOf(tt.groups))
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	impl := &ServerImpl{config: ServerConfig{
+		Auth: AuthConfig{PrivateKey: privateKey},
+		RBAC: RBACConfig{DefaultRoles: []Role{RoleBidder}},
+	}}
+	sign := func(roles ...string) *string {
+		accessToken, err := jwt.NewWithClaims(&jwt.SigningMethodEd25519{}, openapi.JWT{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: uuid.NewString()},
+			Roles:            roles,
+		}).SignedString(privateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &accessToken
+	}
+
+	tests := []struct {
+		name        string
+		accessToken *string
+		roles       []Role
+		want        int
+	}{
+		{name: "沒有access token", roles: []Role{RoleSeller}, want: http.StatusUnauthorized},
+		{name: "不合法的access token", accessToken: lo.ToPtr("invalid"), want: http.StatusUnauthorized},
+		{name: "只檢查是否已登入", accessToken: sign("bidder"), want: http.StatusOK},
+		{name: "具有指定的角色", accessToken: sign("seller", "bidder"), roles: []Role{RoleSeller}, want: http.StatusOK},
+		{name: "具有任一指定的角色", accessToken: sign("moderator"), roles: []Role{RoleAdmin, RoleModerator}, want: http.StatusOK},
+		{name: "沒有指定的角色", accessToken: sign("bidder"), roles: []Role{RoleSeller}, want: http.StatusForbidden},
+		{name: "舊的token視為只有預設角色", accessToken: sign(), roles: []Role{RoleBidder}, want: http.StatusOK},
+		{name: "舊的token沒有預設以外的角色", accessToken: sign(), roles: []Role{RoleSeller}, want: http.StatusForbidden},
+		{name: "管理員具有所有角色的權限", accessToken: sign("admin"), roles: []Role{RoleModerator}, want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, status := impl.authorize("TestAuthorize", tt.accessToken, tt.roles...)
+			assert.Equal(t, tt.want, status)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package api
+
+import (
+	"q4/models"
+)
+
+// reserveMet 返回目前最高出價是否達到底價，未設定底價時返回nil
+// NOTE: auction需要預先載入CurrentBid
+func reserveMet(auction *models.AuctionItem) *bool {
+	if auction.ReservePrice == nil {
+		return nil
+	}
+	met := auction.CurrentBid != nil && auction.CurrentBid.Amount >= *auction.ReservePrice
+	return &met
+}
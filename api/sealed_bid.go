@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/samber/lo"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// placeSealedBid 處理密封出價拍賣的出價
+//   - 出價只需要不低於起標價，不會和其他出價比較，也不會推送給SSE訂閱者
+//   - 不支援代理出價
+func (impl *ServerImpl) placeSealedBid(ctx context.Context, auction *models.AuctionItem, token *openapi.JWT, body *openapi.PostAuctionItemItemIDBidsJSONRequestBody) (openapi.PostAuctionItemItemIDBidsResponseObject, error) {
+	const op = "placeSealedBid"
+	if body.MaxBid != nil {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Sealed bid auction does not support proxy bidding"),
+		}, nil
+	}
+	bidInfo := BidInfo{
+		ItemID:    auction.ID,
+		User:      impl.bidInfoUser(ctx, token),
+		Amount:    body.Bid,
+		CreatedAt: time.Now(),
+		Currency:  auction.Currency,
+	}
+	status, minimumBid, err := impl.runAuctionScript(ctx, auction, &bidInfo, SealedBidScript, int64(body.Bid))
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to place sealed bid, err=%w", op, err)
+	}
+	if status == -2 {
+		return openapi.PostAuctionItemItemIDBids410JSONResponse{}, nil
+	} else if status == 0 {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message:    lo.ToPtr("Bid too low"),
+			MinimumBid: lo.ToPtr(minimumBid),
+		}, nil
+	} else if status != 1 {
+		return nil, fmt.Errorf("[%s] Invalid script return value: %d", op, status)
+	}
+	slog.Info("Sealed bid placed", slog.String("user", token.Subject), slog.String("auctionID", auction.ID.String()))
+	return openapi.PostAuctionItemItemIDBids200Response{}, nil
+}
+
+// sealedState 將密封出價拍賣的設定轉換為SealedBidScript使用的狀態欄位，其他類型的拍賣返回nil
+func sealedState(auction *models.AuctionItem) []any {
+	if !auction.Type.IsSealed() {
+		return nil
+	}
+	return []any{"sealed_minimum", int64(auction.StartingPrice)}
+}
+
+// sealedBidsHidden 返回拍賣的出價是否需要對其他使用者隱藏，密封出價拍賣在結標前不公開出價
+func sealedBidsHidden(auction *models.AuctionItem) bool {
+	return auction.Type.IsSealed() && auction.Status == models.AuctionStatusActive
+}
+
+// revealSealedBids 在結標時揭曉密封出價，將得標出價設為最高出價並返回得標出價和成交價格
+// 呼叫前需要先等待所有出價都同步回資料庫
+func (impl *ServerImpl) revealSealedBids(ctx context.Context, auction *models.AuctionItem) (*models.Bid, models.Money, error) {
+	var bids []models.Bid
+	if result := impl.db.WithContext(ctx).Preload("User").
+		Where("auction_item_id = ?", auction.ID).
+		Order("created_at").
+		Find(&bids); result.Error != nil {
+		return nil, 0, fmt.Errorf("fail to find sealed bids, err=%w", result.Error)
+	}
+	winner, price := resolveSealedBids(auction, bids)
+	if winner == nil {
+		return nil, 0, nil
+	}
+	auction.CurrentBidID = &winner.ID
+	auction.CurrentBid = winner
+	return winner, price, nil
+}
+
+// resolveSealedBids 從依出價時間排序的密封出價中決定得標出價和成交價格
+//   - 最高出價者得標，同額時先出價者得標
+//   - 第一價格拍賣以得標出價成交
+//   - 第二價格拍賣以其他出價者的最高出價成交，但不低於起標價和底價
+func resolveSealedBids(auction *models.AuctionItem, bids []models.Bid) (*models.Bid, models.Money) {
+	var winner *models.Bid
+	for i := range bids {
+		if winner == nil || bids[i].Amount > winner.Amount {
+			winner = &bids[i]
+		}
+	}
+	if winner == nil {
+		return nil, 0
+	}
+	if auction.Type != models.AuctionTypeSealedSecond {
+		return winner, winner.Amount
+	}
+
+	price := auction.StartingPrice
+	if auction.ReservePrice != nil {
+		price = max(price, *auction.ReservePrice)
+	}
+	for _, bid := range bids {
+		if bid.UserID != winner.UserID {
+			price = max(price, bid.Amount)
+		}
+	}
+	return winner, min(price, winner.Amount)
+}
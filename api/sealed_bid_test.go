@@ -0,0 +1,96 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+)
+
+func TestResolveSealedBids(t *testing.T) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	bid := func(user uuid.UUID, amount models.Money) models.Bid {
+		return models.Bid{ID: uuid.New(), UserID: user, Amount: amount}
+	}
+
+	tests := []struct {
+		name       string
+		auction    models.AuctionItem
+		bids       []models.Bid
+		wantWinner int
+		wantPrice  models.Money
+	}{
+		{
+			name:       "沒有出價時沒有得標者",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedFirst, StartingPrice: 100},
+			bids:       nil,
+			wantWinner: -1,
+		},
+		{
+			name:       "第一價格以最高出價成交",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedFirst, StartingPrice: 100},
+			bids:       []models.Bid{bid(alice, 300), bid(bob, 500), bid(carol, 400)},
+			wantWinner: 1,
+			wantPrice:  500,
+		},
+		{
+			name:       "同額時先出價者得標",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedFirst, StartingPrice: 100},
+			bids:       []models.Bid{bid(alice, 500), bid(bob, 500)},
+			wantWinner: 0,
+			wantPrice:  500,
+		},
+		{
+			name:       "第二價格以其他出價者的最高出價成交",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedSecond, StartingPrice: 100},
+			bids:       []models.Bid{bid(alice, 300), bid(bob, 500), bid(carol, 400)},
+			wantWinner: 1,
+			wantPrice:  400,
+		},
+		{
+			name:       "第二價格不計算得標者自己的其他出價",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedSecond, StartingPrice: 100},
+			bids:       []models.Bid{bid(bob, 450), bid(alice, 300), bid(bob, 500)},
+			wantWinner: 2,
+			wantPrice:  300,
+		},
+		{
+			name:       "第二價格只有一位出價者時以起標價成交",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedSecond, StartingPrice: 100},
+			bids:       []models.Bid{bid(alice, 300)},
+			wantWinner: 0,
+			wantPrice:  100,
+		},
+		{
+			name:       "第二價格不低於底價",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedSecond, StartingPrice: 100, ReservePrice: lo.ToPtr(models.Money(350))},
+			bids:       []models.Bid{bid(alice, 300), bid(bob, 500)},
+			wantWinner: 1,
+			wantPrice:  350,
+		},
+		{
+			name:       "第二價格不高於得標出價",
+			auction:    models.AuctionItem{Type: models.AuctionTypeSealedSecond, StartingPrice: 100, ReservePrice: lo.ToPtr(models.Money(600))},
+			bids:       []models.Bid{bid(alice, 300), bid(bob, 500)},
+			wantWinner: 1,
+			wantPrice:  500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			winner, price := resolveSealedBids(&tt.auction, tt.bids)
+			if tt.wantWinner < 0 {
+				assert.Nil(t, winner)
+				return
+			}
+			if assert.NotNil(t, winner) {
+				assert.Equal(t, tt.bids[tt.wantWinner].ID, winner.ID)
+			}
+			assert.Equal(t, tt.wantPrice, price)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"q4/models"
+)
+
+// 全文檢索使用simple設定，只依空白和標點切詞且不做詞幹還原，避免不同語言的描述被錯誤處理
+const (
+	// searchMatch 篩選符合搜尋字串的拍賣物品
+	searchMatch = "search_vector @@ websearch_to_tsquery('simple', ?)"
+	// searchRank 計算拍賣物品和搜尋字串的相關程度，標題的權重高於描述
+	searchRank = "ts_rank_cd(search_vector, websearch_to_tsquery('simple', ?))"
+	// searchHeadline 從描述中擷取包含搜尋字詞的片段，並以<mark>標示符合的字詞
+	searchHeadline = "ts_headline('simple', description_text, websearch_to_tsquery('simple', ?), 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=8, MaxWords=24, FragmentDelimiter=\" ... \"')"
+)
+
+// descriptionText 將已處理過的描述HTML轉換為用於全文檢索的純文字
+// 保留HTML跳脫字元，讓ts_headline產生的片段可以直接當作HTML顯示
+func (impl *ServerImpl) descriptionText(description string) string {
+	return strings.Join(strings.Fields(impl.textExtractor.Sanitize(description)), " ")
+}
+
+// searchSnippets 返回拍賣物品描述中符合搜尋字串的片段
+func (impl *ServerImpl) searchSnippets(ctx context.Context, search string, itemIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	var rows []struct {
+		ID      uuid.UUID
+		Snippet string
+	}
+	if result := impl.db.WithContext(ctx).Model(&models.AuctionItem{}).
+		Select("id, "+searchHeadline+" AS snippet", search).
+		Where("id IN ?", itemIDs).
+		Scan(&rows); result.Error != nil {
+		return nil, fmt.Errorf("fail to highlight search results, err=%w", result.Error)
+	}
+	snippets := make(map[uuid.UUID]string, len(rows))
+	for _, row := range rows {
+		snippets[row.ID] = row.Snippet
+	}
+	return snippets, nil
+}
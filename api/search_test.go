@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescriptionText(t *testing.T) {
+	impl := &ServerImpl{textExtractor: bluemonday.StrictPolicy().AddSpaceWhenStrippingTag(true)}
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "空白描述",
+			input: "",
+			want:  "",
+		},
+		{
+			name:  "相鄰的區塊之間保留空白",
+			input: "<p>Vintage</p><p>film <strong>camera</strong></p>",
+			want:  "Vintage film camera",
+		},
+		{
+			name:  "合併多餘的空白",
+			input: "<ul>\n  <li>lens</li>\n  <li>strap</li>\n</ul>",
+			want:  "lens strap",
+		},
+		{
+			name:  "保留HTML跳脫字元",
+			input: "<p>Tom &amp; Jerry &lt;3</p>",
+			want:  "Tom &amp; Jerry &lt;3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, impl.descriptionText(tt.input))
+		})
+	}
+}
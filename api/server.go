@@ -11,6 +11,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,7 +27,6 @@ import (
 	"github.com/vmihailenco/msgpack/v5"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
 
 	"q4/adapters/oidc"
@@ -35,19 +35,28 @@ import (
 	"q4/adapters/sse"
 	"q4/api/openapi"
 	"q4/models"
+	"q4/notifications"
+	"q4/webhooks"
 )
 
 type ServerImpl struct {
-	oidcProvider  *oidc.ExtendedProvider
-	sseManager    sse.IConnectionManager[openapi.BidEvent]
-	s3Operator    *internalS3.S3Operator
-	htmlChecker   *bluemonday.Policy
-	redisClient   *redis.Client
-	consumer      redisAdapter.IConsumer[sse.PublishRequest[openapi.BidEvent]]
-	groupConsumer redisAdapter.IGroupConsumer[BidInfo]
-	wg            sync.WaitGroup
-	cancelFunc    context.CancelFunc
-	db            *gorm.DB
+	oidcProvider         *oidc.ExtendedProvider
+	sseManager           sse.IConnectionManager[AuctionEvent]
+	s3Operator           *internalS3.S3Operator
+	htmlChecker          *bluemonday.Policy
+	textExtractor        *bluemonday.Policy
+	redisClient          *redis.Client
+	consumer             redisAdapter.IConsumer[sse.PublishRequest[AuctionEvent]]
+	eventConsumer        redisAdapter.IConsumer[sse.PublishRequest[AuctionEvent]]
+	eventProducer        redisAdapter.IProducer[sse.PublishRequest[AuctionEvent]]
+	groupConsumer        redisAdapter.IGroupConsumer[BidInfo]
+	notificationConsumer redisAdapter.IGroupConsumer[BidInfo]
+	notifier             *notifications.Dispatcher
+	webhookConsumer      redisAdapter.IGroupConsumer[BidInfo]
+	webhookSender        *webhooks.Sender
+	wg                   sync.WaitGroup
+	cancelFunc           context.CancelFunc
+	db                   *gorm.DB
 
 	config ServerConfig
 }
@@ -96,31 +105,39 @@ func NewServer(config ServerConfig) (*ServerImpl, error) {
 	})
 
 	// 初始化SSE管理器
+	//  - 出價事件直接從bid stream讀取
+	//  - 其他拍賣事件(例如結標)透過event stream在實例間傳遞
 	consumer, err := redisAdapter.NewConsumer(
 		redisClient,
 		config.Redis.StreamKeys.BidStream,
-		redisAdapter.WithConsumerParseFunc(func(m map[string]any) (sse.PublishRequest[openapi.BidEvent], error) {
-			bidInfo, err := redisAdapter.DefaultParseFromMessage[BidInfo](m)
-			if err != nil {
-				return sse.PublishRequest[openapi.BidEvent]{}, fmt.Errorf("fail to parse message to sse.PublishRequest[openapi.BidEvent], err=%w", err)
-			}
-			return sse.PublishRequest[openapi.BidEvent]{
-				Channel: bidInfo.ItemID.String(),
-				Message: openapi.BidEvent{
-					Bid:  bidInfo.Amount,
-					User: bidInfo.User.Name,
-					Time: bidInfo.CreatedAt,
-				},
-			}, nil
-		}),
+		redisAdapter.WithConsumerParseFunc(parseBidEvent),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("[%s] Fail to create consumer, err=%w", op, err)
 	}
-	sseManager, err := sse.NewConnectionManager[openapi.BidEvent](
-		sse.WithLogger[openapi.BidEvent](slog.Default()),
-		sse.WithSubscriber(consumer),
+	eventConsumer, err := redisAdapter.NewConsumer[sse.PublishRequest[AuctionEvent]](
+		redisClient,
+		config.Redis.StreamKeys.EventStream,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create event consumer, err=%w", op, err)
+	}
+	eventProducer, err := redisAdapter.NewProducer[sse.PublishRequest[AuctionEvent]](
+		redisClient,
+		config.Redis.StreamKeys.EventStream,
+		redisAdapter.WithProducerLogger[sse.PublishRequest[AuctionEvent]](slog.Default()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create event producer, err=%w", op, err)
+	}
+	sseManager, err := sse.NewConnectionManager[AuctionEvent](
+		sse.WithLogger[AuctionEvent](slog.Default()),
+		sse.WithSubscriber(sse.NewMergedSubscriber(consumer, eventConsumer)),
+		sse.WithPublisher(eventProducer),
 	)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create SSE connection manager, err=%w", op, err)
+	}
 
 	// 初始化group consumer
 	groupConsumer, err := redisAdapter.NewGroupConsumer[BidInfo](
@@ -129,32 +146,96 @@ func NewServer(config ServerConfig) (*ServerImpl, error) {
 		config.Redis.ConsumerGroup,
 		config.ID,
 		redisAdapter.WithGroupConsumerLogger[BidInfo](slog.Default()),
+		redisAdapter.WithGroupConsumerParseFunc(parseBidInfo),
 		redisAdapter.WithGroupConsumerStrictOrdering[BidInfo](true),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("[%s] Fail to create group consumer, err=%w", op, err)
 	}
 
+	// 初始化通知
+	//  - 通知使用獨立的consumer group讀取bid stream，只處理group建立之後的出價
+	//  - 沒有設定SMTP伺服器時不送出電子郵件
+	if err := createConsumerGroup(context.Background(), redisClient, config.Redis.StreamKeys.BidStream, config.Notification.ConsumerGroup); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create notification consumer group, err=%w", op, err)
+	}
+	notificationConsumer, err := redisAdapter.NewGroupConsumer[BidInfo](
+		redisClient,
+		config.Redis.StreamKeys.BidStream,
+		config.Notification.ConsumerGroup,
+		config.ID,
+		redisAdapter.WithGroupConsumerLogger[BidInfo](slog.Default()),
+		redisAdapter.WithGroupConsumerParseFunc(parseBidInfo),
+		// 與同步出價的group分開記錄處理失敗的出價，避免混在bid stream的dead-letter中
+		redisAdapter.WithGroupConsumerDeadLetterStream[BidInfo](fmt.Sprintf("%s:%s:dead-letter", config.Redis.StreamKeys.BidStream, config.Notification.ConsumerGroup)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create notification consumer, err=%w", op, err)
+	}
+	notifierOptions := []notifications.DispatcherOption{
+		notifications.WithDispatcherLogger(slog.Default().With(slog.String("caller", "NotificationDispatcher"))),
+		notifications.WithNotifier(models.NotificationChannelInbox, notifications.NewInboxNotifier(db)),
+		notifications.WithNotifier(models.NotificationChannelWebhook, notifications.NewWebhookNotifier(webhooks.NewSender(config.Notification.WebhookTimeout, config.Webhook.AllowPrivateAddress))),
+	}
+	if smtpConfig := config.Notification.SMTP; smtpConfig.Addr != "" {
+		smtpNotifier, err := notifications.NewSMTPNotifier(smtpConfig.Addr, smtpConfig.Username, smtpConfig.Password, smtpConfig.From)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] Fail to create SMTP notifier, err=%w", op, err)
+		}
+		notifierOptions = append(notifierOptions, notifications.WithNotifier(models.NotificationChannelEmail, smtpNotifier))
+	}
+	notifier := notifications.NewDispatcher(redisClient, config.Redis.KeyPrefix, config.Notification.DedupTTL, notifierOptions...)
+
+	// 初始化webhook
+	//  - webhook事件使用獨立的consumer group讀取bid stream，只處理group建立之後的出價
+	if err := createConsumerGroup(context.Background(), redisClient, config.Redis.StreamKeys.BidStream, config.Webhook.ConsumerGroup); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create webhook consumer group, err=%w", op, err)
+	}
+	webhookConsumer, err := redisAdapter.NewGroupConsumer[BidInfo](
+		redisClient,
+		config.Redis.StreamKeys.BidStream,
+		config.Webhook.ConsumerGroup,
+		config.ID,
+		redisAdapter.WithGroupConsumerLogger[BidInfo](slog.Default()),
+		redisAdapter.WithGroupConsumerParseFunc(parseBidInfo),
+		redisAdapter.WithGroupConsumerDeadLetterStream[BidInfo](fmt.Sprintf("%s:%s:dead-letter", config.Redis.StreamKeys.BidStream, config.Webhook.ConsumerGroup)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to create webhook consumer, err=%w", op, err)
+	}
+
 	return &ServerImpl{
-		oidcProvider:  oidcProvider,
-		sseManager:    sseManager,
-		s3Operator:    s3Operator,
-		htmlChecker:   bluemonday.UGCPolicy(),
-		redisClient:   redisClient,
-		consumer:      consumer,
-		groupConsumer: groupConsumer,
-		db:            db,
-		config:        config,
+		oidcProvider:         oidcProvider,
+		sseManager:           sseManager,
+		s3Operator:           s3Operator,
+		htmlChecker:          bluemonday.UGCPolicy(),
+		textExtractor:        bluemonday.StrictPolicy().AddSpaceWhenStrippingTag(true),
+		redisClient:          redisClient,
+		consumer:             consumer,
+		eventConsumer:        eventConsumer,
+		eventProducer:        eventProducer,
+		groupConsumer:        groupConsumer,
+		notificationConsumer: notificationConsumer,
+		notifier:             notifier,
+		webhookConsumer:      webhookConsumer,
+		webhookSender:        webhooks.NewSender(config.Webhook.Timeout, config.Webhook.AllowPrivateAddress),
+		db:                   db,
+		config:               config,
 	}, nil
 }
 
 func (impl *ServerImpl) Start() {
 	// 啟動consumer
 	impl.consumer.Start()
+	impl.eventConsumer.Start()
+	// 啟動event producer
+	impl.eventProducer.Start()
 	// 啟動sse connection manager
 	impl.sseManager.Start()
 	// 啟動group consumer
 	impl.groupConsumer.Start()
+	impl.notificationConsumer.Start()
+	impl.webhookConsumer.Start()
 	// 啟動一個worker用於將Redis中的出價紀錄存回資料庫
 	ctx, cancel := context.WithCancel(context.Background())
 	impl.cancelFunc = cancel
@@ -181,26 +262,56 @@ func (impl *ServerImpl) Start() {
 						UserID:        msg.Data.User.ID,
 						Amount:        msg.Data.Amount,
 						AuctionItemID: msg.Data.ItemID,
+						Quantity:      msg.Data.Quantity,
 					}
 					auction := models.AuctionItem{ID: msg.Data.ItemID}
-					if result := impl.db.Preload("CurrentBid.User").First(&auction); result.Error != nil {
+					if result := impl.db.Unscoped().Preload("CurrentBid.User").First(&auction); result.Error != nil {
 						return fmt.Errorf("fail to find auction item, err=%w", result.Error)
 					}
-					var currentBid uint32
+					// 拍賣已被取消時不需要再更新最高出價
+					if auction.DeletedAt.Valid {
+						logger.Warn("Ignore bid of cancelled auction", slog.String("itemID", msg.Data.ItemID.String()))
+						return nil
+					}
+					// 密封出價只記錄出價，結標時才揭曉最高出價
+					if msg.Data.Sealed {
+						logger.Debug("Save sealed bid", slog.String("itemID", msg.Data.ItemID.String()))
+						if result := impl.db.Create(&record); result.Error != nil {
+							return fmt.Errorf("fail to save sealed bid, err=%w", result.Error)
+						}
+						return nil
+					}
+					// 多數量拍賣的最高出價為決定統一成交價的出價
+					if auction.IsMultiUnit() {
+						return impl.syncMultiUnitBid(ctx, &auction, &record)
+					}
+					var currentBid models.Money
 					if auction.CurrentBid != nil {
 						currentBid = auction.CurrentBid.Amount
-					} else {
+					} else if auction.Type != models.AuctionTypeDutch {
+						// 荷式拍賣的成交價格會低於起標價
 						currentBid = auction.StartingPrice
 					}
-					if currentBid < msg.Data.Amount {
-						logger.Debug("Update current bid", slog.String("itemID", msg.Data.ItemID.String()), slog.Uint64("from", uint64(currentBid)), slog.Int64("to", int64(msg.Data.Amount)))
+					// 代理出價上限相同時，代理出價會以與挑戰者相同的金額緊接著寫入，由代理出價領先
+					tied := auction.CurrentBid != nil && currentBid == msg.Data.Amount && auction.CurrentBid.UserID != msg.Data.User.ID
+					if currentBid < msg.Data.Amount || tied {
+						logger.Debug("Update current bid", slog.String("itemID", msg.Data.ItemID.String()), slog.Int64("from", int64(currentBid)), slog.Int64("to", int64(msg.Data.Amount)))
 						auction.CurrentBidID = &record.ID
 						auction.CurrentBid = &record
 						if result := impl.db.Save(&auction); result.Error != nil {
 							return fmt.Errorf("fail to update auction item, err=%w", result.Error)
 						}
 					} else {
-						logger.Warn("Ignore lower bid", slog.String("itemID", msg.Data.ItemID.String()), slog.Int64("current", int64(auction.CurrentBid.Amount)), slog.Int64("new", int64(msg.Data.Amount)))
+						logger.Warn("Ignore lower bid", slog.String("itemID", msg.Data.ItemID.String()), slog.Int64("current", int64(currentBid)), slog.Int64("new", int64(msg.Data.Amount)))
+					}
+					// 更新軟結標延後的結束時間
+					if msg.Data.ExtendedEndTime != nil {
+						logger.Debug("Extend end time", slog.String("itemID", msg.Data.ItemID.String()), slog.Time("to", *msg.Data.ExtendedEndTime))
+						if result := impl.db.Model(&models.AuctionItem{}).
+							Where("id = ? AND end_time < ?", msg.Data.ItemID, *msg.Data.ExtendedEndTime).
+							Update("end_time", *msg.Data.ExtendedEndTime); result.Error != nil {
+							return fmt.Errorf("fail to extend auction end time, err=%w", result.Error)
+						}
 					}
 					return nil
 				}
@@ -223,16 +334,71 @@ func (impl *ServerImpl) Start() {
 			}
 		}
 	}()
+	// 啟動一個worker用於將到期的拍賣結標
+	slog.Info("Start auction settlement worker")
+	impl.wg.Add(1)
+	go func() {
+		defer impl.wg.Done()
+		defer slog.Info("Auction settlement worker stopped")
+		impl.runSettlementWorker(ctx)
+	}()
+	// 啟動一個worker用於偵測疑似哄抬價格的使用者
+	slog.Info("Start shill detection worker")
+	impl.wg.Add(1)
+	go func() {
+		defer impl.wg.Done()
+		defer slog.Info("Shill detection worker stopped")
+		impl.runShillDetectionWorker(ctx)
+	}()
+	// 啟動一個worker用於通知出價被超過的使用者
+	slog.Info("Start outbid notification worker")
+	impl.wg.Add(1)
+	go func() {
+		defer impl.wg.Done()
+		defer slog.Info("Outbid notification worker stopped")
+		defer impl.notificationConsumer.Close()
+		impl.runOutbidNotificationWorker(ctx)
+	}()
+	// 啟動一個worker用於通知即將結束的拍賣
+	slog.Info("Start ending soon notification worker")
+	impl.wg.Add(1)
+	go func() {
+		defer impl.wg.Done()
+		defer slog.Info("Ending soon notification worker stopped")
+		impl.runEndingSoonNotificationWorker(ctx)
+	}()
+	// 啟動一個worker用於將出價轉換為webhook事件
+	slog.Info("Start webhook event worker")
+	impl.wg.Add(1)
+	go func() {
+		defer impl.wg.Done()
+		defer slog.Info("Webhook event worker stopped")
+		defer impl.webhookConsumer.Close()
+		impl.runWebhookEventWorker(ctx)
+	}()
+	// 啟動一個worker用於送出webhook
+	slog.Info("Start webhook delivery worker")
+	impl.wg.Add(1)
+	go func() {
+		defer impl.wg.Done()
+		defer slog.Info("Webhook delivery worker stopped")
+		impl.runWebhookDeliveryWorker(ctx)
+	}()
 }
 
 func (impl *ServerImpl) Close() {
 	// 關閉group consumer
 	impl.groupConsumer.Close()
+	impl.notificationConsumer.Close()
+	impl.webhookConsumer.Close()
 	// 關閉worker
 	impl.cancelFunc()
 	impl.wg.Wait()
 	// 關閉consumer
 	impl.consumer.Close()
+	impl.eventConsumer.Close()
+	// 關閉event producer
+	impl.eventProducer.Close()
 	// 關閉sse connection manager
 	impl.sseManager.Done()
 }
@@ -240,6 +406,20 @@ func (impl *ServerImpl) Close() {
 // Add a new auction item
 // (POST /auction/item)
 func (impl *ServerImpl) PostAuctionItem(ctx context.Context, request openapi.PostAuctionItemRequestObject) (openapi.PostAuctionItemResponseObject, error) {
+	return withIdempotency(ctx, impl, idempotencyRequest{
+		Op:          "PostAuctionItem",
+		Key:         request.Params.IdempotencyKey,
+		AccessToken: request.Params.AccessToken,
+		Payload:     request.Body,
+	}, openapi.PostAuctionItemResponseObject(openapi.PostAuctionItem422JSONResponse{
+		Message: lo.ToPtr("Idempotency key reused with a different request"),
+	}), func(ctx context.Context) (openapi.PostAuctionItemResponseObject, error) {
+		return impl.postAuctionItem(ctx, request)
+	}, openapi.PostAuctionItemResponseObject.VisitPostAuctionItemResponse)
+}
+
+// postAuctionItem 新增拍賣物品
+func (impl *ServerImpl) postAuctionItem(ctx context.Context, request openapi.PostAuctionItemRequestObject) (openapi.PostAuctionItemResponseObject, error) {
 	const op = "PostAuctionItem"
 	// 檢查拍賣物品的拍賣時間和結束時間是否合法
 	if request.Body.StartTime.After(request.Body.EndTime) || request.Body.EndTime.Before(time.Now()) {
@@ -247,16 +427,40 @@ func (impl *ServerImpl) PostAuctionItem(ctx context.Context, request openapi.Pos
 			Message: lo.ToPtr("Invalid auction time"),
 		}, nil
 	}
+	// 檢查最小加價規則是否合法
+	bidIncrement, ok := bidIncrementFromAPI(request.Body.BidIncrement)
+	if !ok {
+		return openapi.PostAuctionItem400JSONResponse{
+			Message: lo.ToPtr("Invalid bid increment settings"),
+		}, nil
+	}
+	// 檢查標籤是否合法
+	tags, ok := normalizeTags(lo.FromPtr(request.Body.Tags))
+	if !ok {
+		return openapi.PostAuctionItem400JSONResponse{
+			Message: lo.ToPtr("Invalid tags"),
+		}, nil
+	}
 	// 檢查使用者是否有權限新增拍賣物品
-	//  - 檢查是否有提供access token
-	if request.Params.AccessToken == nil {
+	//  - 只有賣家可以刊登拍賣物品
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleSeller)
+	switch status {
+	case http.StatusUnauthorized:
 		return openapi.PostAuctionItem401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostAuctionItem403JSONResponse{
+			Message: lo.ToPtr("Seller role required"),
+		}, nil
 	}
-	//  - 解析並驗證access token
-	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	//  - 停權的使用者不能刊登拍賣物品
+	banned, err := impl.userBanned(ctx, uuid.MustParse(token.Subject))
 	if err != nil {
-		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
-		return openapi.PostAuctionItem401Response{}, nil
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PostAuctionItem403JSONResponse{
+			Message: lo.ToPtr("User is banned"),
+		}, nil
 	}
 	// 處理拍賣描述
 	if request.Body.Description != nil {
@@ -267,7 +471,7 @@ func (impl *ServerImpl) PostAuctionItem(ctx context.Context, request openapi.Pos
 		request.Body.Description = lo.ToPtr("")
 	}
 	if request.Body.StartingPrice == nil {
-		request.Body.StartingPrice = lo.ToPtr(int64(0))
+		request.Body.StartingPrice = lo.ToPtr(models.Money(0))
 	}
 	if request.Body.StartTime == nil {
 		request.Body.StartTime = lo.ToPtr(time.Now())
@@ -275,20 +479,71 @@ func (impl *ServerImpl) PostAuctionItem(ctx context.Context, request openapi.Pos
 	if request.Body.Carousels == nil {
 		request.Body.Carousels = lo.ToPtr([]string{})
 	}
+	if request.Body.SoftClose == nil {
+		request.Body.SoftClose = &openapi.SoftClose{}
+	}
+	if request.Body.Type == nil {
+		request.Body.Type = lo.ToPtr(openapi.English)
+	}
+	if request.Body.Dutch == nil {
+		request.Body.Dutch = &openapi.DutchSchedule{}
+	}
+	if request.Body.Quantity == nil {
+		request.Body.Quantity = lo.ToPtr(uint32(1))
+	}
+	if request.Body.Currency == nil {
+		request.Body.Currency = lo.ToPtr(openapi.Currency(models.DefaultCurrency))
+	}
 	// 儲存拍賣物品
 	auction := models.AuctionItem{
 		UserID:        uuid.MustParse(token.Subject),
 		Title:         request.Body.Title,
 		Description:   *request.Body.Description,
-		StartingPrice: uint32(*request.Body.StartingPrice),
+		StartingPrice: *request.Body.StartingPrice,
 		CurrentBidID:  nil,
 		StartTime:     *request.Body.StartTime,
 		EndTime:       request.Body.EndTime,
 		Carousels:     *request.Body.Carousels,
+
+		SoftCloseWindow:    request.Body.SoftClose.Window,
+		SoftCloseExtension: request.Body.SoftClose.Extension,
+		BidIncrement:       bidIncrement,
+		ReservePrice:       request.Body.ReservePrice,
+		BuyNowPrice:        request.Body.BuyNowPrice,
+		Type:               models.AuctionType(*request.Body.Type),
+		DutchDecrement:     request.Body.Dutch.Decrement,
+		DutchInterval:      request.Body.Dutch.Interval,
+		DutchFloorPrice:    request.Body.Dutch.FloorPrice,
+		Quantity:           *request.Body.Quantity,
+		CategoryID:         request.Body.CategoryId,
+		Tags:               tags,
+		Currency:           models.Currency(*request.Body.Currency),
+		DescriptionText:    impl.descriptionText(*request.Body.Description),
+	}
+	// 檢查拍賣設定是否合法
+	if message := validateAuctionItem(&auction); message != nil {
+		return openapi.PostAuctionItem400JSONResponse{
+			Message: message,
+		}, nil
+	}
+	// 檢查分類是否存在
+	if auction.CategoryID != nil {
+		exists, err := impl.categoryExists(ctx, *auction.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] Fail to find category, err=%w", op, err)
+		}
+		if !exists {
+			return openapi.PostAuctionItem400JSONResponse{
+				Message: lo.ToPtr("Category not found"),
+			}, nil
+		}
 	}
 	if result := impl.db.Debug().Create(&auction); result.Error != nil {
 		return nil, fmt.Errorf("[%s] Fail to create auction item, err=%w", op, result.Error)
 	}
+	if err := impl.indexSuggestTitle(ctx, &auction); err != nil {
+		slog.Error("Fail to index auction title", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+	}
 	return openapi.PostAuctionItem201Response{
 		Headers: openapi.PostAuctionItem201ResponseHeaders{
 			Location: auction.ID.String(),
@@ -303,44 +558,110 @@ func (impl *ServerImpl) GetAuctionItemItemID(ctx context.Context, request openap
 	// 檢查拍賣物品是否存在
 	auction := models.AuctionItem{ID: request.ItemID}
 	if result := impl.db.Debug().
-		Preload(
-			"BidRecords",
-			func(db *gorm.DB) *gorm.DB {
-				return db.Order((clause.OrderByColumn{Column: clause.Column{Name: "created_at"}, Desc: true}))
-			}).
-		Preload("BidRecords.User").
 		Preload("CurrentBid.User").
+		Preload("WinningBid.User").
+		Preload("Category").
 		First(&auction); result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return openapi.GetAuctionItemItemID404Response{}, nil
 		}
 		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
 	}
-	// 取得所有出價紀錄
-	bidRecords := make([]openapi.BidEvent, len(auction.BidRecords))
-	for i, bid := range auction.BidRecords {
-		bidRecords[i] = openapi.BidEvent{
-			Bid:  bid.Amount,
-			User: bid.User.Username,
-			Time: bid.CreatedAt,
+	// 取得最新的出價紀錄和出價統計，完整的出價紀錄需要透過GetAuctionItemItemIDBids分頁查詢
+	//  - 密封出價拍賣在結標前只能看到自己的出價
+	viewerID := impl.viewerID(request.Params.AccessToken)
+	var records []models.Bid
+	if result := impl.bidQuery(ctx, &auction, viewerID).
+		Preload("User").
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(latestBidRecords).
+		Find(&records); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to find latest bids, err=%w", op, result.Error)
+	}
+	bidCount, bidderCount, err := impl.bidCounts(ctx, &auction, viewerID)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to count bids, err=%w", op, err)
+	}
+	//  - 多數量拍賣需要每位出價者最新的出價來計算數量的分配
+	latestBids, err := impl.latestBidPerBidder(ctx, &auction)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to find latest bid of each bidder, err=%w", op, err)
+	}
+
+	// 取得得標紀錄
+	var winningBid *openapi.BidEvent
+	if auction.WinningBid != nil {
+		winningBid = &openapi.BidEvent{
+			Bid:            auction.WinningBid.Amount,
+			User:           auction.WinningBid.User.Username,
+			UserReputation: lo.ToPtr(reputationToAPI(auction.WinningBid.User.Reputation)),
+			Time:           auction.WinningBid.CreatedAt,
+		}
+	}
+
+	// 取得軟結標設定
+	var softClose *openapi.SoftClose
+	if auction.SoftCloseWindow > 0 && auction.SoftCloseExtension > 0 {
+		softClose = &openapi.SoftClose{
+			Window:    auction.SoftCloseWindow,
+			Extension: auction.SoftCloseExtension,
 		}
 	}
 
+	// 取得多數量拍賣的統一成交價
+	var clearingPrice *models.Money
+	if auction.IsMultiUnit() && auction.CurrentBid != nil {
+		clearingPrice = lo.ToPtr(auction.CurrentBid.Amount)
+	}
+
 	// 回傳拍賣物品資訊
 	return openapi.GetAuctionItemItemID200JSONResponse{
-		BidRecords:  bidRecords,
-		Description: auction.Description,
-		EndTime:     auction.EndTime,
-		Title:       auction.Title,
-		StartPrice:  int64(auction.StartingPrice),
-		StartTime:   auction.StartTime,
-		Carousels:   auction.Carousels,
+		BidRecords:    bidRecordsToAPI(&auction, records),
+		BidCount:      bidCount,
+		BidderCount:   bidderCount,
+		Description:   auction.Description,
+		EndTime:       auction.EndTime,
+		Title:         auction.Title,
+		StartPrice:    auction.StartingPrice,
+		StartTime:     auction.StartTime,
+		Carousels:     auction.Carousels,
+		Status:        openapi.AuctionStatus(auction.Status),
+		WinningBid:    winningBid,
+		FinalPrice:    auction.FinalPrice,
+		SoftClose:     softClose,
+		BidIncrement:  bidIncrementToAPI(auction.BidIncrement),
+		ReserveMet:    reserveMet(&auction),
+		BuyNowPrice:   impl.buyNowPrice(&auction),
+		Type:          openapi.AuctionType(auction.Type),
+		Dutch:         dutchScheduleToAPI(&auction),
+		CurrentPrice:  dutchCurrentPrice(&auction, time.Now()),
+		Quantity:      auction.Quantity,
+		ClearingPrice: clearingPrice,
+		WinningBids:   winningBidsToAPI(&auction, latestBids),
+		Category:      categoryToAPI(auction.Category),
+		Tags:          auction.Tags,
+		Currency:      openapi.Currency(auction.Currency),
 	}, nil
 }
 
 // Place a bid on an auction item
 // (POST /auction/item/{itemID}/bids)
 func (impl *ServerImpl) PostAuctionItemItemIDBids(ctx context.Context, request openapi.PostAuctionItemItemIDBidsRequestObject) (openapi.PostAuctionItemItemIDBidsResponseObject, error) {
+	return withIdempotency(ctx, impl, idempotencyRequest{
+		Op:          "PostAuctionItemItemIDBids",
+		Key:         request.Params.IdempotencyKey,
+		AccessToken: request.Params.AccessToken,
+		Payload:     []any{request.ItemID, request.Body},
+	}, openapi.PostAuctionItemItemIDBidsResponseObject(openapi.PostAuctionItemItemIDBids422JSONResponse{
+		Message: lo.ToPtr("Idempotency key reused with a different request"),
+	}), func(ctx context.Context) (openapi.PostAuctionItemItemIDBidsResponseObject, error) {
+		return impl.postAuctionItemItemIDBids(ctx, request)
+	}, openapi.PostAuctionItemItemIDBidsResponseObject.VisitPostAuctionItemItemIDBidsResponse)
+}
+
+// postAuctionItemItemIDBids 對拍賣物品出價
+func (impl *ServerImpl) postAuctionItemItemIDBids(ctx context.Context, request openapi.PostAuctionItemItemIDBidsRequestObject) (openapi.PostAuctionItemItemIDBidsResponseObject, error) {
 	const op = "PostAuctionItemItemIDBids"
 	// 檢查拍賣物品是否存在
 	auction := models.AuctionItem{ID: request.ItemID}
@@ -354,21 +675,75 @@ func (impl *ServerImpl) PostAuctionItemItemIDBids(ctx context.Context, request o
 	if time.Now().Before(auction.StartTime) {
 		return openapi.PostAuctionItemItemIDBids403JSONResponse{}, nil
 	}
-	// 檢查拍賣物品是否已經結束
-	if time.Now().After(auction.EndTime) {
+	// 檢查拍賣物品是否已經結標
+	// NOTE: 結束時間的檢查交由BidScript處理，確保所有實例都以Redis中的狀態為準
+	if auction.Status != models.AuctionStatusActive {
 		return openapi.PostAuctionItemItemIDBids410JSONResponse{}, nil
 	}
+	// 荷式拍賣只能透過接受目前價格成交
+	if auction.Type == models.AuctionTypeDutch {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Dutch auction does not accept bids"),
+		}, nil
+	}
 	// 檢查使用者是否可以出價
 	//  - 檢查是否有提供access token
 	if request.Params.AccessToken == nil {
 		return openapi.PostAuctionItemItemIDBids401Response{}, nil
 	}
+	// 檢查出價金額是否合法
+	if !request.Body.Bid.Valid() || request.Body.MaxBid != nil && !request.Body.MaxBid.Valid() {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Invalid bid amount"),
+		}, nil
+	}
+	// 檢查代理出價的上限是否合法
+	if request.Body.MaxBid != nil && *request.Body.MaxBid < request.Body.Bid {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Maximum bid must not be lower than bid"),
+		}, nil
+	}
 	//  - 解析並驗證access token
 	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
 	if err != nil {
 		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
 		return openapi.PostAuctionItemItemIDBids401Response{}, nil
 	}
+	//  - 賣家不能對自己的拍賣出價
+	if auction.UserID == uuid.MustParse(token.Subject) {
+		return openapi.PostAuctionItemItemIDBids403JSONResponse{
+			Message: lo.ToPtr("Seller cannot bid on own auction"),
+		}, nil
+	}
+	//  - 只有買家可以出價
+	if !impl.hasRole(token, RoleBidder) {
+		return openapi.PostAuctionItemItemIDBids403JSONResponse{
+			Message: lo.ToPtr("Bidder role required"),
+		}, nil
+	}
+	//  - 停權的使用者不能出價
+	banned, err := impl.userBanned(ctx, uuid.MustParse(token.Subject))
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to check user ban, err=%w", op, err)
+	}
+	if banned {
+		return openapi.PostAuctionItemItemIDBids403JSONResponse{
+			Message: lo.ToPtr("User is banned"),
+		}, nil
+	}
+	// 密封出價拍賣的出價不會和其他出價比較
+	if auction.Type.IsSealed() {
+		return impl.placeSealedBid(ctx, &auction, token, request.Body)
+	}
+	// 多數量拍賣的出價以統一成交價排序
+	if auction.IsMultiUnit() {
+		return impl.placeMultiUnitBid(ctx, &auction, token, request.Body)
+	}
+	if request.Body.Quantity != nil && *request.Body.Quantity != 1 {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message: lo.ToPtr("Invalid quantity"),
+		}, nil
+	}
 
 	// 取得Redis上商品的出價鎖
 	lockKey := fmt.Sprintf("%sauction:%s:lock", impl.config.Redis.KeyPrefix, request.ItemID)
@@ -385,14 +760,14 @@ func (impl *ServerImpl) PostAuctionItemItemIDBids(ctx context.Context, request o
 	}()
 
 	// 準備出價資訊
-	auctionKey := fmt.Sprintf("%sauction:%s", impl.config.Redis.KeyPrefix, request.ItemID)
+	auctionKey := impl.auctionKey(request.ItemID)
+	stateKey := impl.auctionKey(request.ItemID, "state")
+	proxyKey := impl.auctionKey(request.ItemID, "proxy")
 	bidInfo := BidInfo{
-		ItemID: request.ItemID,
-		User: BidInfoUser{
-			ID:   uuid.MustParse(token.Subject),
-			Name: token.Username,
-		},
+		ItemID:    request.ItemID,
+		User:      impl.bidInfoUser(ctx, token),
 		Amount:    request.Body.Bid,
+		Currency:  auction.Currency,
 		CreatedAt: time.Now(),
 	}
 	bidInfoBytes, err := msgpack.Marshal(bidInfo)
@@ -401,41 +776,51 @@ func (impl *ServerImpl) PostAuctionItemItemIDBids(ctx context.Context, request o
 	}
 	bidInfoBase64 := base64.StdEncoding.EncodeToString(bidInfoBytes)
 	expireTime := impl.config.Redis.ExpireTime.Seconds()
+	bidKeys := []string{auctionKey, impl.config.Redis.StreamKeys.BidStream, stateKey, proxyKey}
+	bidArgs := []any{int64(request.Body.Bid), bidInfoBase64, expireTime, bidInfo.CreatedAt.UnixMilli(), int64(lo.FromPtrOr(request.Body.MaxBid, request.Body.Bid)), token.Subject}
 	// 透過Lua script來處理出價
-	status, err := BidScript.Run(lockCtx, impl.redisClient, []string{auctionKey, impl.config.Redis.StreamKeys.BidStream}, request.Body.Bid, bidInfoBase64, expireTime).Int()
+	status, minimumBid, endTime, err := runBidScript(lockCtx, impl.redisClient, bidKeys, bidArgs...)
 	if err != nil {
 		return nil, fmt.Errorf("[%s] Fail to place bid, err=%w", op, err)
 	}
-	if status == 0 {
-		return openapi.PostAuctionItemItemIDBids400JSONResponse{}, nil
-	} else if status == 1 {
+	if status == -2 {
+		return openapi.PostAuctionItemItemIDBids410JSONResponse{}, nil
+	} else if status == 0 {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message:    lo.ToPtr("Bid too low"),
+			MinimumBid: lo.ToPtr(minimumBid),
+		}, nil
+	} else if status > 0 {
 		slog.Info("Higher bid occurs", slog.String("user", token.Subject), slog.Int64("bid", int64(request.Body.Bid)), slog.String("auctionID", auction.ID.String()))
+		impl.handleBidPlaced(lockCtx, &auction, &bidInfo, request.Body.MaxBid, status, endTime)
 		return openapi.PostAuctionItemItemIDBids200Response{}, nil
 	} else if status != -1 {
 		return nil, fmt.Errorf("[%s] Invalid script return value: %d", op, status)
 	}
 
-	// 將資料庫紀錄的最高出價寫入Redis
+	// 將資料庫紀錄的最高出價和拍賣狀態寫入Redis
 	// NOTE: 由於每次出價都一定會更新Redis，所以除非從請求剛進來時系統向資料庫請求拍賣資訊，
 	//       到取得鎖的過程中，拍賣物品的最高出價已經被其他人更新，且Redis的資料也過期，不然
 	//       請求剛進來時系統向資料庫請求拍賣資訊都能確定是最新的。
-	currentBid := auction.StartingPrice
-	if auction.CurrentBidID != nil {
-		currentBid = auction.CurrentBid.Amount
-	}
-	if err := impl.redisClient.Set(lockCtx, auctionKey, currentBid, impl.config.Redis.ExpireTime).Err(); err != nil {
-		return nil, fmt.Errorf("[%s] Fail to update current bid in Redis, err=%w", op, err)
+	if err := impl.loadAuctionToRedis(lockCtx, &auction); err != nil {
+		return nil, fmt.Errorf("[%s] Fail to load auction into Redis, err=%w", op, err)
 	}
 
 	// 再次透過Lua script來處理出價
-	status, err = BidScript.Run(lockCtx, impl.redisClient, []string{auctionKey, impl.config.Redis.StreamKeys.BidStream}, request.Body.Bid, bidInfoBase64, expireTime).Int()
+	status, minimumBid, endTime, err = runBidScript(lockCtx, impl.redisClient, bidKeys, bidArgs...)
 	if err != nil {
 		return nil, fmt.Errorf("[%s] Fail to place bid, err=%w", op, err)
 	}
-	if status == 0 {
-		return openapi.PostAuctionItemItemIDBids400JSONResponse{}, nil
-	} else if status == 1 {
+	if status == -2 {
+		return openapi.PostAuctionItemItemIDBids410JSONResponse{}, nil
+	} else if status == 0 {
+		return openapi.PostAuctionItemItemIDBids400JSONResponse{
+			Message:    lo.ToPtr("Bid too low"),
+			MinimumBid: lo.ToPtr(minimumBid),
+		}, nil
+	} else if status > 0 {
 		slog.Info("Higher bid occurs", slog.String("user", token.Subject), slog.Int64("bid", int64(request.Body.Bid)), slog.String("auctionID", auction.ID.String()))
+		impl.handleBidPlaced(lockCtx, &auction, &bidInfo, request.Body.MaxBid, status, endTime)
 		return openapi.PostAuctionItemItemIDBids200Response{}, nil
 	} else if status != -1 {
 		return nil, fmt.Errorf("[%s] Invalid script return value: %d", op, status)
@@ -462,7 +847,7 @@ func (impl *ServerImpl) GetAuctionItemItemIDEvents(ctx context.Context, request
 		}, nil
 	}
 	// 檢查拍賣物品是否已經結束拍賣
-	if time.Now().After(auction.EndTime) {
+	if auction.Status != models.AuctionStatusActive || time.Now().After(auction.EndTime) {
 		return openapi.GetAuctionItemItemIDEvents410JSONResponse{
 			Message: lo.ToPtr("Auction has ended"),
 		}, nil
@@ -478,6 +863,15 @@ func (impl *ServerImpl) GetAuctionItemItemIDEvents(ctx context.Context, request
 	if err != nil {
 		return nil, fmt.Errorf("[%s] Fail to subscribe to item events, err=%w", op, err)
 	}
+	// 荷式拍賣的價格由開始時間和降價設定決定，各實例在降價時間點自行推送價格事件
+	var priceDrop <-chan time.Time
+	if auction.Type == models.AuctionTypeDutch {
+		if next, ok := nextDutchPriceDrop(&auction, time.Now()); ok {
+			timer := time.NewTimer(time.Until(next))
+			defer timer.Stop()
+			priceDrop = timer.C
+		}
+	}
 LOOP:
 	for {
 		select {
@@ -485,8 +879,20 @@ LOOP:
 			impl.sseManager.Unsubscribe(request.ItemID.String(), ch)
 			break LOOP
 		case event := <-ch:
-			c.SSEvent("bid", event)
+			c.SSEvent(event.Event, event.Data)
 			w.Flush()
+			// 結標後不會再有新的事件，結束串流
+			if event.Event == AuctionEventEnded {
+				impl.sseManager.Unsubscribe(request.ItemID.String(), ch)
+				break LOOP
+			}
+		case now := <-priceDrop:
+			c.SSEvent(AuctionEventPrice, openapi.AuctionPriceEvent{Price: dutchPrice(&auction, now), Time: now})
+			w.Flush()
+			priceDrop = nil
+			if next, ok := nextDutchPriceDrop(&auction, now); ok {
+				priceDrop = time.After(time.Until(next))
+			}
 		// 30秒沒有事件就發送一個空行，確保瀏覽器和Cloudflare不會斷開連線
 		case <-time.After(30 * time.Second):
 			w.WriteString("\n\n")
@@ -501,106 +907,14 @@ LOOP:
 func (impl *ServerImpl) GetAuctionItems(ctx context.Context, request openapi.GetAuctionItemsRequestObject) (openapi.GetAuctionItemsResponseObject, error) {
 	const op = "GetAuctionItems"
 	now := time.Now()
-	// 建立查詢
-	query := impl.db.Debug().Joins("CurrentBid").Model(&models.AuctionItem{})
-	//  - title
-	if request.Params.Title != nil {
-		query = query.Where("title LIKE ?", "%"+*request.Params.Title+"%")
-	}
-	//  - start_price
-	if request.Params.StartPrice != nil {
-		if request.Params.StartPrice.From != nil {
-			query = query.Where("starting_price >= ?", *request.Params.StartPrice.From)
-		}
-		if request.Params.StartPrice.To != nil {
-			query = query.Where("starting_price <= ?", *request.Params.StartPrice.To)
-		}
-	}
-	//  - start_time
-	if request.Params.StartTime != nil {
-		if request.Params.StartTime.From != nil {
-			query = query.Where("start_time >= ?", *request.Params.StartTime.From)
-		}
-		if request.Params.StartTime.To != nil {
-			query = query.Where("start_time <= ?", *request.Params.StartTime.To)
-		}
-	}
-	//  - end_time
-	if request.Params.EndTime != nil {
-		if request.Params.EndTime.From != nil {
-			query = query.Where("end_time >= ?", *request.Params.EndTime.From)
-		}
-		if request.Params.EndTime.To != nil {
-			query = query.Where("end_time <= ?", *request.Params.EndTime.To)
-		}
-	}
-	//  - current_bid
-	// 目前實際價格是記錄在另外一張表(bids)中，所以需要透過join來查詢
-	// 且如果目前沒有人出價，則需要使用起標價格來進行篩選
-	if request.Params.CurrentBid != nil {
-		if request.Params.CurrentBid.From != nil {
-			query = query.Where(`"CurrentBid".amount >= ? OR current_bid_id IS NULL AND starting_price >= ?`, *request.Params.CurrentBid.From, *request.Params.CurrentBid.From)
-		}
-		if request.Params.CurrentBid.To != nil {
-			query = query.Where(`"CurrentBid".amount <= ? OR current_bid_id IS NULL AND starting_price <= ?`, *request.Params.CurrentBid.To, *request.Params.CurrentBid.To)
-		}
-	}
-	//  - sort
-	sortKey, desc := "title", false
-	if request.Params.Sort != nil {
-		if request.Params.Sort.Key != nil {
-			switch *request.Params.Sort.Key {
-			case openapi.Title:
-				sortKey = "title"
-			case openapi.StartTime:
-				sortKey = "start_time"
-			case openapi.EndTime:
-				sortKey = "end_time"
-			case openapi.CurrentBid:
-				sortKey = "current_price"
-			case openapi.StartPrice:
-				sortKey = "starting_price"
-			default:
-				return openapi.GetAuctionItems400JSONResponse{
-					Message: lo.ToPtr("Invalid sort key"),
-				}, nil
-			}
-		}
-		if request.Params.Sort.Order != nil {
-			desc = *request.Params.Sort.Order == openapi.Desc
-		}
-	}
-	query = query.Order(clause.OrderBy{Columns: []clause.OrderByColumn{
-		{Column: clause.Column{Name: sortKey}, Desc: desc},
-		{Column: clause.Column{Name: "id"}, Desc: false},
-	}})
-	//  - cursor
-	if request.Params.LastItemID != nil {
-		var cursor string
-		if result := impl.db.Model(&models.AuctionItem{}).Select(sortKey).Where("id = ?", *request.Params.LastItemID).First(&cursor); result.Error != nil {
-			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-				return openapi.GetAuctionItems400JSONResponse{
-					Message: lo.ToPtr("Last item not found"),
-				}, nil
-			}
-			return nil, fmt.Errorf("[%s] Fail to find last item, err=%w", op, result.Error)
-		}
-		if desc {
-			query = query.Where(sortKey+" < ?", cursor)
-		} else {
-			query = query.Where(sortKey+" > ?", cursor)
-		}
-		query = query.Or(sortKey+" = ? AND id > ?", cursor, *request.Params.LastItemID)
-	}
-	//  - size
-	size := uint32(1)
-	if request.Params.Size != nil {
-		size = *request.Params.Size
+	query, message, err := impl.auctionListQuery(ctx, &request.Params, now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to build query, err=%w", op, err)
 	}
-	query = query.Limit(int(size))
-	//  - excludeEnded
-	if request.Params.ExcludeEnded != nil && *request.Params.ExcludeEnded {
-		query = query.Where("end_time > ?", now)
+	if message != "" {
+		return openapi.GetAuctionItems400JSONResponse{
+			Message: lo.ToPtr(message),
+		}, nil
 	}
 	// todo: 嘗試從redis查詢，如果有就直接返回redis內儲存的查詢結果
 	// 查詢拍賣物品
@@ -611,25 +925,16 @@ func (impl *ServerImpl) GetAuctionItems(ctx context.Context, request openapi.Get
 	if len(auctions) == 0 {
 		return openapi.GetAuctionItems404Response{}, nil
 	}
-	output := make([]struct {
-		CurrentBid uint32    `json:"currentBid"`
-		EndTime    time.Time `json:"endTime"`
-		Id         uuid.UUID `json:"id"`
-		IsEnded    bool      `json:"isEnded"`
-		StartTime  time.Time `json:"startTime"`
-		Title      string    `json:"title"`
-	}, len(auctions))
-	for i, auction := range auctions {
-		if auction.CurrentBid != nil {
-			output[i].CurrentBid = uint32(auction.CurrentBid.Amount)
-		} else {
-			output[i].CurrentBid = uint32(auction.StartingPrice)
+	// 記錄有結果的搜尋字串作為熱門搜尋，翻頁時不重複記錄
+	search := strings.TrimSpace(lo.FromPtr(request.Params.Search))
+	if search != "" && request.Params.LastItemID == nil {
+		if err := impl.recordSearchQuery(ctx, search); err != nil {
+			slog.Error("Fail to record search query", slog.String("search", search), slog.Any("error", err))
 		}
-		output[i].Id = auction.ID
-		output[i].Title = auction.Title
-		output[i].EndTime = auction.EndTime
-		output[i].StartTime = auction.StartTime
-		output[i].IsEnded = now.After(auction.EndTime)
+	}
+	output, err := impl.auctionListItems(ctx, auctions, search, now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to convert auction items, err=%w", op, err)
 	}
 	return openapi.GetAuctionItems200JSONResponse{
 		Count: len(auctions),
@@ -666,9 +971,15 @@ func (impl *ServerImpl) GetAuthCallback(ctx context.Context, request openapi.Get
 	if result.Error != nil {
 		return nil, fmt.Errorf("[%s] Fail to create user, err=%w", op, result.Error)
 	}
-	// 建立token
+	// 停權的使用者不能登入
+	if user.Banned() {
+		slog.Info("Banned user tried to log in", slog.String("user", user.ID.String()))
+		return openapi.GetAuthCallback403Response{}, nil
+	}
+	// 建立token，依IdP群組決定使用者的角色
 	q4Token := jwt.NewWithClaims(&jwt.SigningMethodEd25519{}, openapi.JWT{
 		Username: idTokenClaims.Name,
+		Roles:    impl.config.RBAC.RolesOf(idTokenClaims.Groups),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(impl.config.Auth.ExpireDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -789,6 +1100,73 @@ func (impl *ServerImpl) PostImage(ctx context.Context, request openapi.PostImage
 	}, nil
 }
 
+// handleBidPlaced 處理競價成功後的後續工作
+//   - 儲存代理出價的上限
+//   - 依據BidScript的返回值，通知SSE訂閱者出價以外的狀態變化，延後的結束時間使用BidScript寫入的值
+func (impl *ServerImpl) handleBidPlaced(ctx context.Context, auction *models.AuctionItem, bidInfo *BidInfo, maxBid *models.Money, status int, endTime *time.Time) {
+	if maxBid != nil {
+		if err := impl.saveProxyBid(ctx, auction.ID, bidInfo.User.ID, *maxBid); err != nil {
+			slog.Error("Fail to save proxy bid", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+		}
+	}
+	if status&BidFlagOutbid != 0 {
+		slog.Info("Bid outbid by proxy bid", slog.String("user", bidInfo.User.ID.String()), slog.String("auctionID", auction.ID.String()))
+	}
+	if status&BidFlagReserveMet != 0 {
+		if err := impl.publishAuctionEvent(auction.ID, AuctionEventReserve, openapi.AuctionReserveEvent{ReserveMet: true}); err != nil {
+			slog.Error("Fail to publish reserve event", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+		}
+	}
+	if status&BidFlagBuyNowWithdrawn != 0 {
+		if err := impl.publishAuctionEvent(auction.ID, AuctionEventBuyNow, openapi.AuctionBuyNowEvent{Available: false}); err != nil {
+			slog.Error("Fail to publish buy now event", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+		}
+	}
+	if status&BidFlagExtended != 0 && endTime != nil {
+		if err := impl.publishAuctionEvent(auction.ID, AuctionEventExtended, openapi.AuctionExtendedEvent{EndTime: *endTime}); err != nil {
+			slog.Error("Fail to publish extended event", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+		}
+	}
+}
+
+// loadAuctionToRedis 將資料庫中的最高出價和拍賣狀態寫入Redis
+func (impl *ServerImpl) loadAuctionToRedis(ctx context.Context, auction *models.AuctionItem) error {
+	auctionKey := impl.auctionKey(auction.ID)
+	stateKey := impl.auctionKey(auction.ID, "state")
+	currentBid := auction.StartingPrice
+	if auction.CurrentBidID != nil {
+		currentBid = auction.CurrentBid.Amount
+	}
+	state := []any{
+		"end_time", auction.EndTime.UnixMilli(),
+		"soft_close_window", int64(auction.SoftCloseWindow) * 1000,
+		"soft_close_extension", int64(auction.SoftCloseExtension) * 1000,
+	}
+	state = append(state, bidIncrementState(auction.BidIncrement)...)
+	state = append(state, dutchState(auction)...)
+	state = append(state, sealedState(auction)...)
+	state = append(state, multiUnitState(auction)...)
+	if auction.ReservePrice != nil {
+		state = append(state, "reserve_price", int64(*auction.ReservePrice))
+	}
+	if auction.CurrentBid != nil && !auction.IsMultiUnit() {
+		state = append(state, "leader", auction.CurrentBid.UserID.String())
+	}
+	if buyNowPrice := impl.buyNowPrice(auction); buyNowPrice != nil {
+		state = append(state, "buy_now_price", int64(*buyNowPrice), "buy_now_threshold", int64(impl.buyNowThreshold(*buyNowPrice)))
+	}
+	if auction.Status != models.AuctionStatusActive {
+		state = append(state, "closed", "1")
+	}
+	if err := LoadAuctionScript.Run(ctx, impl.redisClient, []string{auctionKey, stateKey}, append([]any{int64(currentBid), impl.config.Redis.ExpireTime.Seconds()}, state...)...).Err(); err != nil {
+		return err
+	}
+	if err := impl.loadRankingToRedis(ctx, auction); err != nil {
+		return err
+	}
+	return impl.loadProxyBidToRedis(ctx, auction)
+}
+
 func generateID(prefix string) (string, error) {
 	const op = "generateID"
 	bytes := make([]byte, 20)
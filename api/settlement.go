@@ -0,0 +1,277 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// runSettlementWorker 定期檢查已到期的拍賣並進行結標，直到ctx被取消
+func (impl *ServerImpl) runSettlementWorker(ctx context.Context) {
+	logger := slog.Default().With(slog.String("caller", "AuctionSettlement"))
+	ticker := time.NewTicker(impl.config.Settlement.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 查詢已到期但尚未結標的拍賣
+			var itemIDs []uuid.UUID
+			if result := impl.db.WithContext(ctx).Model(&models.AuctionItem{}).
+				Where("status = ? AND end_time <= ?", models.AuctionStatusActive, time.Now()).
+				Order("end_time").
+				Limit(impl.config.Settlement.BatchSize).
+				Pluck("id", &itemIDs); result.Error != nil {
+				logger.Error("Fail to find expired auctions", slog.Any("error", result.Error))
+				continue
+			}
+			for _, itemID := range itemIDs {
+				if err := impl.settleAuction(ctx, itemID); err != nil {
+					logger.Error("Fail to settle auction", slog.String("itemID", itemID.String()), slog.Any("error", err))
+				}
+			}
+		}
+	}
+}
+
+// settleAuction 對單一拍賣進行結標
+//
+// 流程:
+//   - 1. 取得結標鎖，確保同一個拍賣只會由一個實例進行結標
+//   - 2. 在Redis中將拍賣標記為已結標，之後的出價都會被拒絕
+//   - 3. 等待bid stream中此拍賣已寫入的出價都同步回資料庫
+//   - 4. 密封出價拍賣在此時揭曉出價，多數量拍賣在此時分配數量，決定得標出價和成交價格
+//   - 5. 依據最高出價記錄結標狀態、得標出價和成交價格，未達底價時流標
+//   - 6. 透過SSE和webhook發送結標事件，並通知得標者
+func (impl *ServerImpl) settleAuction(ctx context.Context, itemID uuid.UUID) error {
+	const op = "settleAuction"
+	logger := slog.Default().With(slog.String("caller", "AuctionSettlement"), slog.String("itemID", itemID.String()))
+	ctx, cancel := context.WithTimeout(ctx, impl.config.Settlement.Timeout)
+	defer cancel()
+
+	// 取得結標鎖
+	lockKey := fmt.Sprintf("%sauction:%s:settle-lock", impl.config.Redis.KeyPrefix, itemID)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return fmt.Errorf("[%s] Fail to acquire settle lock, err=%w", op, err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			logger.Warn("Fail to release settle lock", slog.Any("error", err))
+		}
+	}()
+
+	// 取得鎖之後再確認一次拍賣狀態，避免重複結標
+	auction := models.AuctionItem{ID: itemID}
+	if result := impl.db.WithContext(lockCtx).First(&auction); result.Error != nil {
+		return fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	if auction.Status != models.AuctionStatusActive {
+		return nil
+	}
+
+	// 在Redis中標記為已結標
+	stateKey := impl.auctionKey(itemID, "state")
+	now := time.Now()
+	closed, lastBidID, err := runCloseAuctionScript(lockCtx, impl.redisClient, []string{stateKey}, now.UnixMilli(), impl.config.Redis.ExpireTime.Seconds())
+	if err != nil {
+		return fmt.Errorf("[%s] Fail to close auction in Redis, err=%w", op, err)
+	}
+	if !closed {
+		logger.Debug("Auction end time in Redis is not reached, skip settlement")
+		return nil
+	}
+
+	// 等待此拍賣的出價都同步回資料庫
+	if err := impl.waitBidStreamDrained(lockCtx, itemID, lastBidID); err != nil {
+		return fmt.Errorf("[%s] Fail to wait bid stream drained, err=%w", op, err)
+	}
+
+	// 記錄結標結果
+	if result := impl.db.WithContext(lockCtx).Preload("CurrentBid.User").First(&auction); result.Error != nil {
+		return fmt.Errorf("[%s] Fail to reload auction item, err=%w", op, result.Error)
+	}
+	winningBid, price := auction.CurrentBid, models.Money(0)
+	if auction.Type.IsSealed() {
+		if winningBid, price, err = impl.revealSealedBids(lockCtx, &auction); err != nil {
+			return fmt.Errorf("[%s] Fail to reveal sealed bids, err=%w", op, err)
+		}
+	} else if auction.IsMultiUnit() {
+		if winningBid, price, err = impl.clearMultiUnitBids(lockCtx, &auction); err != nil {
+			return fmt.Errorf("[%s] Fail to clear multi-quantity bids, err=%w", op, err)
+		}
+	} else if winningBid != nil {
+		price = winningBid.Amount
+	}
+	auction.Status = models.AuctionStatusUnsold
+	if met := reserveMet(&auction); met != nil && !*met {
+		logger.Info("Reserve price not met")
+	} else if winningBid != nil {
+		auction.Status = models.AuctionStatusSold
+		auction.WinningBidID = &winningBid.ID
+		auction.FinalPrice = &price
+	}
+	auction.SettledAt = &now
+	if result := impl.db.WithContext(lockCtx).Model(&models.AuctionItem{ID: itemID}).Updates(map[string]any{
+		"status":         auction.Status,
+		"current_bid_id": auction.CurrentBidID,
+		"winning_bid_id": auction.WinningBidID,
+		"final_price":    auction.FinalPrice,
+		"settled_at":     auction.SettledAt,
+	}); result.Error != nil {
+		return fmt.Errorf("[%s] Fail to update auction result, err=%w", op, result.Error)
+	}
+	logger.Info("Auction settled", slog.String("status", string(auction.Status)))
+
+	// 通知SSE訂閱者
+	event := openapi.AuctionEndedEvent{
+		Status:     openapi.AuctionStatus(auction.Status),
+		FinalPrice: auction.FinalPrice,
+		Time:       now,
+	}
+	if auction.Status == models.AuctionStatusSold {
+		event.WinningBid = &openapi.BidEvent{
+			Bid:            winningBid.Amount,
+			User:           winningBid.User.Username,
+			UserReputation: lo.ToPtr(reputationToAPI(winningBid.User.Reputation)),
+			Time:           winningBid.CreatedAt,
+		}
+	}
+	if err := impl.publishAuctionEvent(itemID, AuctionEventEnded, event); err != nil {
+		logger.Error("Fail to publish ended event", slog.Any("error", err))
+	}
+	if err := impl.enqueueWebhookEvent(lockCtx, &auction, models.WebhookEventAuctionEnded, now, event); err != nil {
+		logger.Error("Fail to enqueue ended webhook event", slog.Any("error", err))
+	}
+	if auction.Status == models.AuctionStatusSold {
+		auction.WinningBid = winningBid
+		if err := impl.notifyWinners(lockCtx, &auction); err != nil {
+			logger.Error("Fail to notify winners", slog.Any("error", err))
+		}
+	}
+	return nil
+}
+
+// waitBidStreamDrained 等待拍賣寫入bid stream的出價都被group consumer處理完畢
+//
+// lastBidID為結標時記錄的最後一筆出價ID，為空字串時代表Redis中沒有需要同步的出價
+func (impl *ServerImpl) waitBidStreamDrained(ctx context.Context, itemID uuid.UUID, lastBidID string) error {
+	stream, group := impl.config.Redis.StreamKeys.BidStream, impl.config.Redis.ConsumerGroup
+	if lastBidID == "" {
+		return nil
+	}
+	// stream不存在代表沒有任何出價需要同步
+	exists, err := impl.redisClient.Exists(ctx, stream).Result()
+	if err != nil {
+		return fmt.Errorf("fail to check stream exists, err=%w", err)
+	}
+	if exists == 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		drained, err := impl.isBidStreamDrained(ctx, stream, group, itemID, lastBidID)
+		if err != nil {
+			return err
+		}
+		if drained {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// isBidStreamDrained 檢查group是否已讀取到target，且target之前屬於此拍賣的訊息都已經被確認
+// NOTE: 其他拍賣未確認的訊息(例如處理失敗等待重試)不會影響此拍賣的結標
+func (impl *ServerImpl) isBidStreamDrained(ctx context.Context, stream, group string, itemID uuid.UUID, target string) (bool, error) {
+	groups, err := impl.redisClient.XInfoGroups(ctx, stream).Result()
+	if err != nil {
+		return false, fmt.Errorf("fail to get group info, err=%w", err)
+	}
+	g, ok := lo.Find(groups, func(g redis.XInfoGroup) bool {
+		return g.Name == group
+	})
+	if !ok {
+		return false, fmt.Errorf("consumer group %s not found in stream %s", group, stream)
+	}
+	if compareStreamID(g.LastDeliveredID, target) < 0 {
+		return false, nil
+	}
+
+	start := "-"
+	for {
+		pending, err := impl.redisClient.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  group,
+			Start:  start,
+			End:    target,
+			Count:  100,
+		}).Result()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return false, fmt.Errorf("fail to get pending messages, err=%w", err)
+		}
+		for _, p := range pending {
+			messages, err := impl.redisClient.XRange(ctx, stream, p.ID, p.ID).Result()
+			if err != nil {
+				return false, fmt.Errorf("fail to get pending message, err=%w", err)
+			}
+			// 已被修剪的訊息沒有內容，無法再同步
+			for _, message := range messages {
+				bidInfo, err := parseBidInfo(message.Values)
+				if err == nil && bidInfo.ItemID == itemID {
+					return false, nil
+				}
+			}
+		}
+		if len(pending) < 100 {
+			return true, nil
+		}
+		start = "(" + pending[len(pending)-1].ID
+	}
+}
+
+// compareStreamID 比較兩個stream ID(格式為<毫秒>-<序號>)的先後
+// 返回-1代表a在b之前，0代表相同，1代表a在b之後
+func compareStreamID(a, b string) int {
+	aMs, aSeq := splitStreamID(a)
+	bMs, bSeq := splitStreamID(b)
+	switch {
+	case aMs < bMs:
+		return -1
+	case aMs > bMs:
+		return 1
+	case aSeq < bSeq:
+		return -1
+	case aSeq > bSeq:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func splitStreamID(id string) (uint64, uint64) {
+	msPart, seqPart, _ := strings.Cut(id, "-")
+	ms, _ := strconv.ParseUint(msPart, 10, 64)
+	seq, _ := strconv.ParseUint(seqPart, 10, 64)
+	return ms, seq
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestCompareStreamID(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "相同ID", a: "1700000000000-0", b: "1700000000000-0", want: 0},
+		{name: "毫秒較小", a: "1699999999999-5", b: "1700000000000-0", want: -1},
+		{name: "毫秒較大", a: "1700000000001-0", b: "1700000000000-9", want: 1},
+		{name: "序號較小", a: "1700000000000-1", b: "1700000000000-2", want: -1},
+		{name: "序號較大", a: "1700000000000-10", b: "1700000000000-9", want: 1},
+		{name: "空的group位置", a: "0-0", b: "1700000000000-0", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, compareStreamID(tt.a, tt.b))
+		})
+	}
+}
+
+func TestIsBidStreamDrained(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	ctx := context.Background()
+	itemID, otherItemID := uuid.New(), uuid.New()
+	impl := &ServerImpl{redisClient: client}
+	addBid := func(id uuid.UUID) string {
+		data, err := msgpack.Marshal(BidInfo{ItemID: id, Amount: 100})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client.XAdd(ctx, &redis.XAddArgs{
+			Stream: "stream:bids",
+			Values: []any{"data", base64.StdEncoding.EncodeToString(data)},
+		}).Val()
+	}
+
+	tests := []struct {
+		name      string
+		setupFunc func() string
+		want      bool
+	}{
+		{
+			name: "尚未讀取到最後一筆出價",
+			setupFunc: func() string {
+				return addBid(itemID)
+			},
+			want: false,
+		},
+		{
+			name: "此拍賣的出價尚未確認",
+			setupFunc: func() string {
+				target := addBid(itemID)
+				client.XReadGroup(ctx, &redis.XReadGroupArgs{Group: "sync", Consumer: "c1", Streams: []string{"stream:bids", ">"}})
+				return target
+			},
+			want: false,
+		},
+		{
+			name: "其他拍賣的出價尚未確認不影響結標",
+			setupFunc: func() string {
+				addBid(otherItemID)
+				target := addBid(itemID)
+				client.XReadGroup(ctx, &redis.XReadGroupArgs{Group: "sync", Consumer: "c1", Streams: []string{"stream:bids", ">"}})
+				client.XAck(ctx, "stream:bids", "sync", target)
+				return target
+			},
+			want: true,
+		},
+		{
+			name: "所有出價都已確認",
+			setupFunc: func() string {
+				target := addBid(itemID)
+				client.XReadGroup(ctx, &redis.XReadGroupArgs{Group: "sync", Consumer: "c1", Streams: []string{"stream:bids", ">"}})
+				client.XAck(ctx, "stream:bids", "sync", target)
+				return target
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr.FlushAll()
+			client.XGroupCreateMkStream(ctx, "stream:bids", "sync", "$")
+			target := tt.setupFunc()
+
+			drained, err := impl.isBidStreamDrained(ctx, "stream:bids", "sync", itemID, target)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, drained)
+		})
+	}
+}
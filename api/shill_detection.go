@@ -0,0 +1,263 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+	"q4/shill"
+)
+
+// runShillDetectionWorker 定期分析已結標拍賣的出價紀錄，標記疑似哄抬價格的使用者，直到ctx被取消
+func (impl *ServerImpl) runShillDetectionWorker(ctx context.Context) {
+	logger := slog.Default().With(slog.String("caller", "ShillDetection"))
+	ticker := time.NewTicker(impl.config.ShillDetection.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := impl.detectShillBidders(ctx); err != nil {
+				logger.Error("Fail to detect shill bidders", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// detectShillBidders 分析回溯期間內結標的拍賣，將可疑的使用者寫入ShillFlag
+//
+// 流程:
+//   - 1. 取得偵測鎖，確保同一時間只有一個實例在進行偵測
+//   - 2. 查詢回溯期間內結標的拍賣和出價紀錄，整理出每個使用者的參與紀錄
+//   - 3. 計算分數並將達到門檻的使用者標記為需要審核
+func (impl *ServerImpl) detectShillBidders(ctx context.Context) error {
+	const op = "detectShillBidders"
+	logger := slog.Default().With(slog.String("caller", "ShillDetection"))
+
+	// 取得偵測鎖
+	lockKey := fmt.Sprintf("%sshill-detection-lock", impl.config.Redis.KeyPrefix)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return fmt.Errorf("[%s] Fail to acquire detection lock, err=%w", op, err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			logger.Warn("Fail to release detection lock", slog.Any("error", err))
+		}
+	}()
+
+	// 查詢參與紀錄
+	var auctions []models.AuctionItem
+	if result := impl.db.WithContext(lockCtx).
+		Select("id", "user_id", "type", "quantity", "status", "winning_bid_id", "bid_increment").
+		Preload("WinningBid").
+		Where("status IN ? AND settled_at >= ?",
+			[]models.AuctionStatus{models.AuctionStatusSold, models.AuctionStatusUnsold},
+			time.Now().Add(-impl.config.ShillDetection.Lookback)).
+		Find(&auctions); result.Error != nil {
+		return fmt.Errorf("[%s] Fail to query settled auctions, err=%w", op, result.Error)
+	}
+	var bids []models.Bid
+	if len(auctions) > 0 {
+		if result := impl.db.WithContext(lockCtx).
+			Select("id", "auction_item_id", "user_id", "amount", "created_at").
+			Where("auction_item_id IN ?", lo.Map(auctions, func(auction models.AuctionItem, _ int) uuid.UUID {
+				return auction.ID
+			})).
+			Order("created_at").
+			Order("id").
+			Find(&bids); result.Error != nil {
+			return fmt.Errorf("[%s] Fail to query bid history, err=%w", op, result.Error)
+		}
+	}
+	participations := shillParticipations(auctions, bids)
+
+	// 標記可疑的使用者
+	results := shill.Detect(shill.Config{
+		MinAuctions: impl.config.ShillDetection.MinAuctions,
+		Threshold:   impl.config.ShillDetection.Threshold,
+	}, participations)
+	for _, result := range results {
+		flag := models.ShillFlag{
+			UserID:          result.UserID,
+			Score:           result.Score,
+			Auctions:        result.Signals.Auctions,
+			Wins:            result.Signals.Wins,
+			SameSellerRatio: result.Signals.SameSellerRatio,
+			PushRatio:       result.Signals.PushRatio,
+		}
+		// 參與的拍賣數量或分數增加後再次被判定為可疑時，清除審核時間讓審核員重新審核
+		// NOTE: 每次偵測都會重新判定回溯期間內的所有使用者，沒有新的可疑跡象時保留審核結果
+		doUpdates := append(clause.AssignmentColumns([]string{"score", "auctions", "wins", "same_seller_ratio", "push_ratio", "updated_at"}), clause.Assignment{
+			Column: clause.Column{Name: "reviewed_at"},
+			Value:  gorm.Expr("CASE WHEN excluded.auctions > shill_flags.auctions OR excluded.score > shill_flags.score THEN NULL ELSE shill_flags.reviewed_at END"),
+		})
+		if err := impl.db.WithContext(lockCtx).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: doUpdates,
+		}).Create(&flag).Error; err != nil {
+			return fmt.Errorf("[%s] Fail to save shill flag, err=%w", op, err)
+		}
+		logger.Warn("Flag suspicious bidder", slog.String("userID", result.UserID.String()), slog.Float64("score", result.Score))
+	}
+	logger.Debug("Shill detection finished", slog.Int("bidders", len(participations)), slog.Int("flagged", len(results)))
+	return nil
+}
+
+// shillParticipations 依拍賣和依時間排序的出價紀錄，整理出每個使用者的參與紀錄
+// 英式拍賣中，使用者的出價被其他人以一個最小加價內超過後又再次出價時，視為推高價格(shill.Participation.Pushed)
+func shillParticipations(auctions []models.AuctionItem, bids []models.Bid) map[uuid.UUID][]shill.Participation {
+	bidsByAuction := lo.GroupBy(bids, func(bid models.Bid) uuid.UUID {
+		return bid.AuctionItemID
+	})
+	participations := make(map[uuid.UUID][]shill.Participation)
+	for _, auction := range auctions {
+		auctionBids := bidsByAuction[auction.ID]
+		pushed := make(map[uuid.UUID]bool)
+		if auction.Type == models.AuctionTypeEnglish && !auction.IsMultiUnit() {
+			overtaken := make(map[uuid.UUID]bool)
+			for i, bid := range auctionBids {
+				if overtaken[bid.UserID] {
+					pushed[bid.UserID] = true
+				}
+				if i > 0 {
+					prev := auctionBids[i-1]
+					if prev.UserID != bid.UserID && bid.Amount-prev.Amount <= auction.BidIncrement.Increment(prev.Amount) {
+						overtaken[prev.UserID] = true
+					}
+				}
+			}
+		}
+		bidders := lo.Uniq(lo.Map(auctionBids, func(bid models.Bid, _ int) uuid.UUID {
+			return bid.UserID
+		}))
+		for _, userID := range bidders {
+			participations[userID] = append(participations[userID], shill.Participation{
+				AuctionID: auction.ID,
+				SellerID:  auction.UserID,
+				Sold:      auction.Status == models.AuctionStatusSold,
+				Won:       auction.WinningBid != nil && auction.WinningBid.UserID == userID,
+				Pushed:    pushed[userID],
+			})
+		}
+	}
+	return participations
+}
+
+// List shill bidding flags
+// (GET /moderation/shill-flags)
+func (impl *ServerImpl) GetModerationShillFlags(ctx context.Context, request openapi.GetModerationShillFlagsRequestObject) (openapi.GetModerationShillFlagsResponseObject, error) {
+	const op = "GetModerationShillFlags"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultModerationCasePageSize))
+	if size == 0 || size > maxModerationCasePageSize {
+		return openapi.GetModerationShillFlags400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	// 檢查使用者是否為審核員或管理員
+	_, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.GetModerationShillFlags401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.GetModerationShillFlags403Response{}, nil
+	}
+	// 依第一次被標記的順序查詢
+	query := impl.db.WithContext(ctx).
+		Preload("User").
+		Order("created_at").
+		Order("id").
+		Limit(size)
+	if lo.FromPtr(request.Params.Reviewed) {
+		query = query.Where("reviewed_at IS NOT NULL")
+	} else {
+		query = query.Where("reviewed_at IS NULL")
+	}
+	//  - cursor
+	if request.Params.LastFlagID != nil {
+		last := models.ShillFlag{ID: *request.Params.LastFlagID}
+		if result := impl.db.WithContext(ctx).First(&last); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return openapi.GetModerationShillFlags400JSONResponse{
+					Message: lo.ToPtr("Last flag not found"),
+				}, nil
+			}
+			return nil, fmt.Errorf("[%s] Fail to find last flag, err=%w", op, result.Error)
+		}
+		query = query.Where("(created_at > ? OR created_at = ? AND id > ?)", last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	var flags []models.ShillFlag
+	if result := query.Find(&flags); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list shill flags, err=%w", op, result.Error)
+	}
+	return openapi.GetModerationShillFlags200JSONResponse{
+		Count: len(flags),
+		Items: lo.Map(flags, shillFlagToAPI),
+	}, nil
+}
+
+// Mark a shill bidding flag as reviewed
+// (POST /moderation/shill-flags/{flagID}/review)
+func (impl *ServerImpl) PostModerationShillFlagsFlagIDReview(ctx context.Context, request openapi.PostModerationShillFlagsFlagIDReviewRequestObject) (openapi.PostModerationShillFlagsFlagIDReviewResponseObject, error) {
+	const op = "PostModerationShillFlagsFlagIDReview"
+	// 檢查使用者是否為審核員或管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleModerator)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostModerationShillFlagsFlagIDReview401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostModerationShillFlagsFlagIDReview403Response{}, nil
+	}
+	// 檢查標記是否存在
+	flag := models.ShillFlag{ID: request.FlagID}
+	if result := impl.db.WithContext(ctx).First(&flag); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostModerationShillFlagsFlagIDReview404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find shill flag, err=%w", op, result.Error)
+	}
+	// 只更新尚未審核的標記，避免覆蓋同時進行的審核
+	result := impl.db.WithContext(ctx).
+		Model(&models.ShillFlag{}).
+		Where("id = ? AND reviewed_at IS NULL", flag.ID).
+		Update("reviewed_at", time.Now())
+	if result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to review shill flag, err=%w", op, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return openapi.PostModerationShillFlagsFlagIDReview409JSONResponse{
+			Message: lo.ToPtr("Flag is already reviewed"),
+		}, nil
+	}
+	slog.Info("Shill flag reviewed", slog.String("user", token.Subject), slog.String("flagID", flag.ID.String()))
+	return openapi.PostModerationShillFlagsFlagIDReview204Response{}, nil
+}
+
+// shillFlagToAPI 轉換疑似哄抬價格的標記
+// NOTE: flag需要預先載入User
+func shillFlagToAPI(flag models.ShillFlag, _ int) openapi.ShillFlag {
+	return openapi.ShillFlag{
+		Id:              flag.ID,
+		User:            flag.User.Username,
+		Score:           flag.Score,
+		Auctions:        flag.Auctions,
+		Wins:            flag.Wins,
+		SameSellerRatio: flag.SameSellerRatio,
+		PushRatio:       flag.PushRatio,
+		FlaggedAt:       flag.UpdatedAt,
+		ReviewedAt:      flag.ReviewedAt,
+	}
+}
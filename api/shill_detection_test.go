@@ -0,0 +1,79 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+	"q4/shill"
+)
+
+func TestShillParticipations(t *testing.T) {
+	seller, shillBidder, winner, bidder := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	bid := func(userID uuid.UUID, amount models.Money) models.Bid {
+		return models.Bid{ID: uuid.New(), UserID: userID, Amount: amount}
+	}
+
+	tests := []struct {
+		name    string
+		auction models.AuctionItem
+		bids    []models.Bid
+		want    map[uuid.UUID]bool
+	}{
+		{
+			name:    "被一個最小加價內超過後又再次出價",
+			auction: models.AuctionItem{Type: models.AuctionTypeEnglish, BidIncrement: models.BidIncrementRule{Type: models.BidIncrementFixed, Step: 10}},
+			bids:    []models.Bid{bid(shillBidder, 100), bid(winner, 110), bid(shillBidder, 150), bid(winner, 200)},
+			want:    map[uuid.UUID]bool{winner: false, shillBidder: true},
+		},
+		{
+			name:    "被大幅超過後再次出價不算推高價格",
+			auction: models.AuctionItem{Type: models.AuctionTypeEnglish, BidIncrement: models.BidIncrementRule{Type: models.BidIncrementFixed, Step: 10}},
+			bids:    []models.Bid{bid(bidder, 100), bid(winner, 200), bid(bidder, 300), bid(winner, 400)},
+			want:    map[uuid.UUID]bool{winner: false, bidder: false},
+		},
+		{
+			name:    "被超過後沒有再次出價",
+			auction: models.AuctionItem{Type: models.AuctionTypeEnglish},
+			bids:    []models.Bid{bid(bidder, 100), bid(winner, 101)},
+			want:    map[uuid.UUID]bool{winner: false, bidder: false},
+		},
+		{
+			name:    "提高自己的出價不算被超過",
+			auction: models.AuctionItem{Type: models.AuctionTypeEnglish},
+			bids:    []models.Bid{bid(bidder, 100), bid(bidder, 101), bid(winner, 200)},
+			want:    map[uuid.UUID]bool{winner: false, bidder: false},
+		},
+		{
+			name:    "密封出價拍賣不判斷推高價格",
+			auction: models.AuctionItem{Type: models.AuctionTypeSealedFirst},
+			bids:    []models.Bid{bid(winner, 100), bid(bidder, 100), bid(winner, 100)},
+			want:    map[uuid.UUID]bool{winner: false, bidder: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auction := tt.auction
+			auction.ID, auction.UserID, auction.Status = uuid.New(), seller, models.AuctionStatusSold
+			auction.WinningBid = &tt.bids[len(tt.bids)-1]
+			for i := range tt.bids {
+				tt.bids[i].AuctionItemID = auction.ID
+			}
+
+			participations := shillParticipations([]models.AuctionItem{auction}, tt.bids)
+			assert.Len(t, participations, len(tt.want))
+			for userID, pushed := range tt.want {
+				assert.Equal(t, []shill.Participation{{
+					AuctionID: auction.ID,
+					SellerID:  seller,
+					Sold:      true,
+					Won:       userID == auction.WinningBid.UserID,
+					Pushed:    pushed,
+				}}, participations[userID])
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+
+	redisAdapter "q4/adapters/redis"
+	"q4/api/openapi"
+	"q4/models"
+)
+
+const (
+	// 索引的前綴最多的字元數，較長的搜尋字串會以此長度的前綴查詢後再過濾
+	maxSuggestPrefixLength = 20
+	// 標題中只有前幾個單字的開頭會被索引
+	maxSuggestWords = 5
+	// 每個前綴最多保留的建議數量
+	maxSuggestEntries = 100
+	// 每次查詢預設返回的建議數量
+	defaultSuggestSize = 5
+	// 重建索引時每批讀取的拍賣物品數量
+	suggestRebuildBatchSize = 500
+)
+
+// Get search suggestions
+// (GET /auction/suggest)
+func (impl *ServerImpl) GetAuctionSuggest(ctx context.Context, request openapi.GetAuctionSuggestRequestObject) (openapi.GetAuctionSuggestResponseObject, error) {
+	const op = "GetAuctionSuggest"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultSuggestSize))
+	if size == 0 || size > maxSuggestEntries {
+		return openapi.GetAuctionSuggest400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	query := normalizeSuggestText(request.Params.Q)
+	if query == "" {
+		return openapi.GetAuctionSuggest200JSONResponse{Titles: []string{}, Queries: []string{}}, nil
+	}
+	titles, err := impl.lookupSuggestions(ctx, "title", query, size)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to look up title suggestions, err=%w", op, err)
+	}
+	queries, err := impl.lookupSuggestions(ctx, "query", query, size)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to look up query suggestions, err=%w", op, err)
+	}
+	return openapi.GetAuctionSuggest200JSONResponse{Titles: titles, Queries: queries}, nil
+}
+
+// Rebuild the suggestion index
+// (POST /auction/suggest/rebuild)
+func (impl *ServerImpl) PostAuctionSuggestRebuild(ctx context.Context, request openapi.PostAuctionSuggestRebuildRequestObject) (openapi.PostAuctionSuggestRebuildResponseObject, error) {
+	const op = "PostAuctionSuggestRebuild"
+	// 檢查使用者是否為管理員
+	token, status := impl.authorize(op, request.Params.AccessToken, RoleAdmin)
+	switch status {
+	case http.StatusUnauthorized:
+		return openapi.PostAuctionSuggestRebuild401Response{}, nil
+	case http.StatusForbidden:
+		return openapi.PostAuctionSuggestRebuild403Response{}, nil
+	}
+	indexed, err := impl.rebuildSuggestIndex(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to rebuild suggestion index, err=%w", op, err)
+	}
+	slog.Info("Suggestion index rebuilt", slog.String("user", token.Subject), slog.Int("indexed", indexed))
+	return openapi.PostAuctionSuggestRebuild200JSONResponse{Indexed: indexed}, nil
+}
+
+// suggestKey 返回建議索引中前綴的sorted set鍵，kind為title或query
+func (impl *ServerImpl) suggestKey(kind string, prefix string) string {
+	return fmt.Sprintf("%ssuggest:%s:%s", impl.config.Redis.KeyPrefix, kind, prefix)
+}
+
+// lookupSuggestions 從前綴索引中查詢符合搜尋字串的建議，依分數由高到低排序
+func (impl *ServerImpl) lookupSuggestions(ctx context.Context, kind string, query string, size int) ([]string, error) {
+	runes := []rune(query)
+	key := impl.suggestKey(kind, string(runes[:min(len(runes), maxSuggestPrefixLength)]))
+	// 搜尋字串比索引的前綴長時，需要讀取完整的集合再過濾
+	stop := int64(size - 1)
+	if len(runes) > maxSuggestPrefixLength {
+		stop = -1
+	}
+	members, err := impl.redisClient.ZRevRange(ctx, key, 0, stop).Result()
+	if err != nil {
+		return nil, err
+	}
+	suggestions := lo.Filter(members, func(member string, _ int) bool {
+		return matchesSuggestion(member, query)
+	})
+	return suggestions[:min(len(suggestions), size)], nil
+}
+
+// indexSuggestTitle 將拍賣物品的標題加入建議索引，較新的拍賣物品排在前面
+func (impl *ServerImpl) indexSuggestTitle(ctx context.Context, auction *models.AuctionItem) error {
+	score := float64(auction.CreatedAt.Unix())
+	_, err := impl.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, prefix := range suggestPrefixes(auction.Title) {
+			key := impl.suggestKey("title", prefix)
+			pipe.ZAdd(ctx, key, redis.Z{Score: score, Member: auction.Title})
+			pipe.ZRemRangeByRank(ctx, key, 0, -maxSuggestEntries-1)
+		}
+		return nil
+	})
+	return err
+}
+
+// reindexSuggestTitle 在拍賣物品的標題修改後更新建議索引，索引更新失敗不會影響修改的結果
+func (impl *ServerImpl) reindexSuggestTitle(ctx context.Context, oldTitle string, auction *models.AuctionItem) {
+	if oldTitle == auction.Title {
+		return
+	}
+	if err := impl.indexSuggestTitle(ctx, auction); err != nil {
+		slog.Error("Fail to index auction title", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+	}
+	if err := impl.unindexSuggestTitle(ctx, oldTitle); err != nil {
+		slog.Error("Fail to unindex auction title", slog.String("auctionID", auction.ID.String()), slog.Any("error", err))
+	}
+}
+
+// removeSuggestTitle 將標題從建議索引中移除
+// NOTE: 相同標題的拍賣物品共用同一筆建議，呼叫前需要確認沒有其他拍賣物品使用此標題
+func (impl *ServerImpl) removeSuggestTitle(ctx context.Context, title string) error {
+	_, err := impl.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, prefix := range suggestPrefixes(title) {
+			pipe.ZRem(ctx, impl.suggestKey("title", prefix), title)
+		}
+		return nil
+	})
+	return err
+}
+
+// unindexSuggestTitle 在標題不再被拍賣物品使用時將它從建議索引中移除
+func (impl *ServerImpl) unindexSuggestTitle(ctx context.Context, title string) error {
+	var count int64
+	if result := impl.db.WithContext(ctx).Model(&models.AuctionItem{}).Where("title = ?", title).Count(&count); result.Error != nil {
+		return fmt.Errorf("fail to count auction items, err=%w", result.Error)
+	}
+	if count > 0 {
+		return nil
+	}
+	return impl.removeSuggestTitle(ctx, title)
+}
+
+// recordSearchQuery 累計搜尋字串的使用次數，作為熱門搜尋的建議
+func (impl *ServerImpl) recordSearchQuery(ctx context.Context, search string) error {
+	query := normalizeSuggestText(search)
+	if query == "" {
+		return nil
+	}
+	runes := []rune(query)
+	_, err := impl.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for n := 1; n <= min(len(runes), maxSuggestPrefixLength); n++ {
+			key := impl.suggestKey("query", string(runes[:n]))
+			pipe.ZIncrBy(ctx, key, 1, query)
+			pipe.ZRemRangeByRank(ctx, key, 0, -maxSuggestEntries-1)
+		}
+		return nil
+	})
+	return err
+}
+
+// rebuildSuggestIndex 清除標題的建議索引，並從資料庫重新建立，返回索引的拍賣物品數量
+// 熱門搜尋只記錄在Redis中，不會被清除
+func (impl *ServerImpl) rebuildSuggestIndex(ctx context.Context) (int, error) {
+	// 取得重建鎖，確保同一時間只有一個實例在重建索引
+	lockKey := fmt.Sprintf("%ssuggest:lock", impl.config.Redis.KeyPrefix)
+	dMutex := redisAdapter.NewAutoRenewMutex(impl.redisClient, lockKey)
+	lockCtx, err := dMutex.Lock(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fail to acquire rebuild lock, err=%w", err)
+	}
+	defer func() {
+		if _, err := dMutex.Unlock(); err != nil {
+			slog.Warn("Fail to release rebuild lock", slog.Any("error", err))
+		}
+	}()
+
+	// 清除舊的索引
+	iter := impl.redisClient.Scan(lockCtx, 0, impl.suggestKey("title", "*"), suggestRebuildBatchSize).Iterator()
+	var keys []string
+	for iter.Next(lockCtx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return 0, fmt.Errorf("fail to scan suggestion keys, err=%w", err)
+	}
+	for _, chunk := range lo.Chunk(keys, suggestRebuildBatchSize) {
+		if err := impl.redisClient.Unlink(lockCtx, chunk...).Err(); err != nil {
+			return 0, fmt.Errorf("fail to delete suggestion keys, err=%w", err)
+		}
+	}
+
+	// 寫入所有未取消的拍賣物品
+	indexed := 0
+	var auctions []models.AuctionItem
+	result := impl.db.WithContext(lockCtx).Select("id", "title", "created_at").
+		FindInBatches(&auctions, suggestRebuildBatchSize, func(tx *gorm.DB, batch int) error {
+			for i := range auctions {
+				if err := impl.indexSuggestTitle(lockCtx, &auctions[i]); err != nil {
+					return err
+				}
+			}
+			indexed += len(auctions)
+			return nil
+		})
+	if result.Error != nil {
+		return indexed, fmt.Errorf("fail to index auction items, err=%w", result.Error)
+	}
+	return indexed, nil
+}
+
+// suggestPrefixes 返回標題中前maxSuggestWords個單字開頭的所有前綴，用於標題中間的單字也能被補全
+func suggestPrefixes(title string) []string {
+	words := strings.Fields(normalizeSuggestText(title))
+	prefixes := []string{}
+	for i := 0; i < min(len(words), maxSuggestWords); i++ {
+		runes := []rune(strings.Join(words[i:], " "))
+		for n := 1; n <= min(len(runes), maxSuggestPrefixLength); n++ {
+			prefix := string(runes[:n])
+			if !lo.Contains(prefixes, prefix) {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+	return prefixes
+}
+
+// matchesSuggestion 返回建議中是否有單字以搜尋字串開頭
+func matchesSuggestion(suggestion string, query string) bool {
+	return strings.Contains(" "+normalizeSuggestText(suggestion), " "+query)
+}
+
+// normalizeSuggestText 將文字轉為小寫並合併多餘的空白
+func normalizeSuggestText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"q4/models"
+)
+
+func TestSuggestPrefixes(t *testing.T) {
+	tests := []struct {
+		name  string
+		title string
+		want  []string
+	}{
+		{
+			name:  "空白標題沒有前綴",
+			title: "  ",
+			want:  []string{},
+		},
+		{
+			name:  "每個單字的開頭都有前綴",
+			title: "Old  Map",
+			want:  []string{"o", "ol", "old", "old ", "old m", "old ma", "old map", "m", "ma", "map"},
+		},
+		{
+			name:  "重複的前綴只出現一次",
+			title: "aa a",
+			want:  []string{"a", "aa", "aa ", "aa a"},
+		},
+		{
+			name:  "前綴長度不超過上限",
+			title: strings.Repeat("x", maxSuggestPrefixLength+5),
+			want: func() []string {
+				prefixes := make([]string, maxSuggestPrefixLength)
+				for i := range prefixes {
+					prefixes[i] = strings.Repeat("x", i+1)
+				}
+				return prefixes
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, suggestPrefixes(tt.title))
+		})
+	}
+
+	t.Run("只索引前幾個單字的開頭", func(t *testing.T) {
+		prefixes := suggestPrefixes("a b c d e f g")
+		assert.Contains(t, prefixes, "e")
+		assert.NotContains(t, prefixes, "f")
+	})
+}
+
+func TestSuggestIndex(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	ctx := context.Background()
+	impl := &ServerImpl{redisClient: client, config: ServerConfig{Redis: RedisConfig{KeyPrefix: "q4:"}}}
+	now := time.Now()
+	index := func(title string, created time.Time) {
+		auction := models.AuctionItem{Model: gorm.Model{CreatedAt: created}, Title: title}
+		assert.NoError(t, impl.indexSuggestTitle(ctx, &auction))
+	}
+
+	index("Vintage Film Camera", now.Add(-time.Hour))
+	index("Camera Strap", now)
+	index("Vinyl Record", now.Add(-2*time.Hour))
+
+	t.Run("較新的標題排在前面", func(t *testing.T) {
+		titles, err := impl.lookupSuggestions(ctx, "title", "vin", 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Vintage Film Camera", "Vinyl Record"}, titles)
+	})
+
+	t.Run("標題中間的單字也能補全", func(t *testing.T) {
+		titles, err := impl.lookupSuggestions(ctx, "title", "cam", 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Camera Strap", "Vintage Film Camera"}, titles)
+	})
+
+	t.Run("限制返回的數量", func(t *testing.T) {
+		titles, err := impl.lookupSuggestions(ctx, "title", "cam", 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Camera Strap"}, titles)
+	})
+
+	t.Run("超過前綴長度的搜尋字串會再過濾", func(t *testing.T) {
+		index("Vintage Film Camera Bundle", now.Add(time.Hour))
+		index("Vintage Film Cassette Deck", now.Add(2*time.Hour))
+		titles, err := impl.lookupSuggestions(ctx, "title", "vintage film camera b", 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Vintage Film Camera Bundle"}, titles)
+	})
+
+	t.Run("移除的標題不再出現", func(t *testing.T) {
+		assert.NoError(t, impl.removeSuggestTitle(ctx, "Vinyl Record"))
+		titles, err := impl.lookupSuggestions(ctx, "title", "vinyl", 5)
+		assert.NoError(t, err)
+		assert.Empty(t, titles)
+	})
+
+	t.Run("熱門搜尋依次數排序", func(t *testing.T) {
+		assert.NoError(t, impl.recordSearchQuery(ctx, "camera lens"))
+		assert.NoError(t, impl.recordSearchQuery(ctx, "Camera  Strap"))
+		assert.NoError(t, impl.recordSearchQuery(ctx, "camera strap"))
+		queries, err := impl.lookupSuggestions(ctx, "query", "cam", 5)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"camera strap", "camera lens"}, queries)
+	})
+}
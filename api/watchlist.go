@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+// 每個使用者最多可以關注的拍賣物品數量
+const maxWatchlistItems = 200
+
+// Watch an auction item
+// (PUT /auction/item/{itemID}/watch)
+func (impl *ServerImpl) PutAuctionItemItemIDWatch(ctx context.Context, request openapi.PutAuctionItemItemIDWatchRequestObject) (openapi.PutAuctionItemItemIDWatchResponseObject, error) {
+	const op = "PutAuctionItemItemIDWatch"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PutAuctionItemItemIDWatch401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PutAuctionItemItemIDWatch401Response{}, nil
+	}
+	// 檢查拍賣物品是否存在
+	auction := models.AuctionItem{ID: request.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PutAuctionItemItemIDWatch404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find auction item, err=%w", op, result.Error)
+	}
+	// 只能關注尚未結標的拍賣
+	if auction.Status != models.AuctionStatusActive {
+		return openapi.PutAuctionItemItemIDWatch410Response{}, nil
+	}
+	// 檢查關注清單是否已滿
+	userID := uuid.MustParse(token.Subject)
+	var count int64
+	if result := impl.db.WithContext(ctx).Model(&models.WatchlistItem{}).Where("user_id = ?", userID).Count(&count); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to count watchlist items, err=%w", op, result.Error)
+	}
+	if count >= maxWatchlistItems {
+		return openapi.PutAuctionItemItemIDWatch409JSONResponse{
+			Message: lo.ToPtr("Watchlist is full"),
+		}, nil
+	}
+	// 已經關注時不做任何事
+	item := models.WatchlistItem{
+		UserID:        userID,
+		AuctionItemID: request.ItemID,
+	}
+	if result := impl.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&item); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to add watchlist item, err=%w", op, result.Error)
+	}
+	return openapi.PutAuctionItemItemIDWatch204Response{}, nil
+}
+
+// Unwatch an auction item
+// (DELETE /auction/item/{itemID}/watch)
+func (impl *ServerImpl) DeleteAuctionItemItemIDWatch(ctx context.Context, request openapi.DeleteAuctionItemItemIDWatchRequestObject) (openapi.DeleteAuctionItemItemIDWatchResponseObject, error) {
+	const op = "DeleteAuctionItemItemIDWatch"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.DeleteAuctionItemItemIDWatch401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.DeleteAuctionItemItemIDWatch401Response{}, nil
+	}
+	// 直接刪除紀錄，讓之後可以再次關注同一個拍賣物品
+	if result := impl.db.WithContext(ctx).Unscoped().
+		Where("user_id = ? AND auction_item_id = ?", uuid.MustParse(token.Subject), request.ItemID).
+		Delete(&models.WatchlistItem{}); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to remove watchlist item, err=%w", op, result.Error)
+	}
+	return openapi.DeleteAuctionItemItemIDWatch204Response{}, nil
+}
+
+// List watched auction items
+// (GET /me/watchlist)
+func (impl *ServerImpl) GetMeWatchlist(ctx context.Context, request openapi.GetMeWatchlistRequestObject) (openapi.GetMeWatchlistResponseObject, error) {
+	const op = "GetMeWatchlist"
+	sortKey := lo.FromPtrOr(request.Params.Sort, openapi.Recent)
+	if sortKey != openapi.Recent && sortKey != openapi.EndingSoon {
+		return openapi.GetMeWatchlist400JSONResponse{
+			Message: lo.ToPtr("Invalid sort key"),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeWatchlist401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeWatchlist401Response{}, nil
+	}
+	// 查詢關注的拍賣物品，已取消的拍賣也會列出，被管理員隱藏的拍賣不會列出
+	var items []models.WatchlistItem
+	if result := impl.db.WithContext(ctx).
+		Preload("AuctionItem", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		}).
+		Preload("AuctionItem.CurrentBid").
+		Where("user_id = ?", uuid.MustParse(token.Subject)).
+		Where("auction_item_id NOT IN (?)", impl.db.Unscoped().Model(&models.AuctionItem{}).Select("id").Where("hidden_at IS NOT NULL")).
+		Order("created_at DESC").
+		Find(&items); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list watchlist items, err=%w", op, result.Error)
+	}
+	auctions := lo.Map(items, func(item models.WatchlistItem, _ int) models.AuctionItem {
+		return item.AuctionItem
+	})
+	now := time.Now()
+	prices, err := impl.currentPrices(ctx, auctions, now)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Fail to get current prices, err=%w", op, err)
+	}
+	output := lo.Map(items, func(item models.WatchlistItem, _ int) openapi.WatchlistItem {
+		return openapi.WatchlistItem{
+			Id:           item.AuctionItemID,
+			Title:        item.AuctionItem.Title,
+			CurrentPrice: prices[item.AuctionItemID],
+			Currency:     openapi.Currency(item.AuctionItem.Currency),
+			EndTime:      item.AuctionItem.EndTime,
+			IsEnded:      isAuctionEnded(&item.AuctionItem, now),
+			Status:       openapi.AuctionStatus(item.AuctionItem.Status),
+			WatchedAt:    item.CreatedAt,
+		}
+	})
+	if sortKey == openapi.EndingSoon {
+		sortEndingSoon(output)
+	}
+	return openapi.GetMeWatchlist200JSONResponse(output), nil
+}
+
+// currentPrices 返回拍賣物品目前的價格
+//   - 競價中的拍賣優先使用Redis中的價格，沒有時使用資料庫中的最高出價
+//   - 荷式拍賣依時間計算目前價格
+//   - 密封出價拍賣在結標前不公開出價，使用起標價
+//
+// NOTE: auctions需要預先載入CurrentBid
+func (impl *ServerImpl) currentPrices(ctx context.Context, auctions []models.AuctionItem, now time.Time) (map[uuid.UUID]models.Money, error) {
+	prices := make(map[uuid.UUID]models.Money, len(auctions))
+	if len(auctions) == 0 {
+		return prices, nil
+	}
+	keys := lo.Map(auctions, func(auction models.AuctionItem, _ int) string {
+		return impl.auctionKey(auction.ID)
+	})
+	cached, err := impl.redisClient.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+	for i := range auctions {
+		raw, _ := cached[i].(string)
+		prices[auctions[i].ID] = currentPrice(&auctions[i], raw, now)
+	}
+	return prices, nil
+}
+
+// currentPrice 依Redis中的價格(沒有時為空字串)和資料庫中的出價計算拍賣物品目前的價格
+// NOTE: auction需要預先載入CurrentBid
+func currentPrice(auction *models.AuctionItem, cached string, now time.Time) models.Money {
+	if price := dutchCurrentPrice(auction, now); price != nil {
+		return *price
+	}
+	if sealedBidsHidden(auction) {
+		return auction.StartingPrice
+	}
+	if auction.FinalPrice != nil {
+		return *auction.FinalPrice
+	}
+	if auction.Status == models.AuctionStatusActive {
+		if price, err := strconv.ParseInt(cached, 10, 64); err == nil {
+			return models.Money(price)
+		}
+	}
+	if auction.CurrentBid != nil {
+		return auction.CurrentBid.Amount
+	}
+	return auction.StartingPrice
+}
+
+// isAuctionEnded 返回拍賣是否已經結束(已結標、已取消或已超過結束時間)
+func isAuctionEnded(auction *models.AuctionItem, now time.Time) bool {
+	return auction.Status != models.AuctionStatusActive || now.After(auction.EndTime)
+}
+
+// sortEndingSoon 將尚未結束的拍賣依結束時間由近到遠排在前面，已結束的拍賣依結束時間由近到遠排在後面
+func sortEndingSoon(items []openapi.WatchlistItem) {
+	slices.SortStableFunc(items, func(a, b openapi.WatchlistItem) int {
+		if a.IsEnded != b.IsEnded {
+			return lo.Ternary(a.IsEnded, 1, -1)
+		}
+		if a.IsEnded {
+			return b.EndTime.Compare(a.EndTime)
+		}
+		return a.EndTime.Compare(b.EndTime)
+	})
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"q4/api/openapi"
+	"q4/models"
+)
+
+func TestCurrentPrice(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	newAuction := func(modify func(auction *models.AuctionItem)) *models.AuctionItem {
+		auction := &models.AuctionItem{
+			StartingPrice: 100,
+			StartTime:     now.Add(-time.Hour),
+			EndTime:       now.Add(time.Hour),
+			Status:        models.AuctionStatusActive,
+			Type:          models.AuctionTypeEnglish,
+			Quantity:      1,
+		}
+		modify(auction)
+		return auction
+	}
+
+	tests := []struct {
+		name    string
+		auction *models.AuctionItem
+		cached  string
+		want    models.Money
+	}{
+		{
+			name:    "沒有出價時為起標價",
+			auction: newAuction(func(auction *models.AuctionItem) {}),
+			want:    100,
+		},
+		{
+			name: "Redis中沒有價格時使用資料庫中的最高出價",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.CurrentBid = &models.Bid{Amount: 150}
+			}),
+			want: 150,
+		},
+		{
+			name: "優先使用Redis中的價格",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.CurrentBid = &models.Bid{Amount: 150}
+			}),
+			cached: "180",
+			want:   180,
+		},
+		{
+			name: "已結標的拍賣使用成交價格",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Status = models.AuctionStatusSold
+				auction.CurrentBid = &models.Bid{Amount: 150}
+				auction.FinalPrice = lo.ToPtr(models.Money(120))
+			}),
+			cached: "150",
+			want:   120,
+		},
+		{
+			name: "密封出價拍賣不公開出價",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeSealedFirst
+			}),
+			cached: "100",
+			want:   100,
+		},
+		{
+			name: "荷式拍賣依時間計算價格",
+			auction: newAuction(func(auction *models.AuctionItem) {
+				auction.Type = models.AuctionTypeDutch
+				auction.StartingPrice = 1000
+				auction.DutchDecrement = 100
+				auction.DutchInterval = 1800
+				auction.DutchFloorPrice = 500
+			}),
+			cached: "1000",
+			want:   800,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, currentPrice(tt.auction, tt.cached, now))
+		})
+	}
+}
+
+func TestSortEndingSoon(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	item := func(name string, end time.Duration, ended bool) openapi.WatchlistItem {
+		return openapi.WatchlistItem{Id: uuid.New(), Title: name, EndTime: now.Add(end), IsEnded: ended}
+	}
+	items := []openapi.WatchlistItem{
+		item("ended long ago", -48*time.Hour, true),
+		item("ending later", 48*time.Hour, false),
+		item("ended recently", -time.Hour, true),
+		item("ending soon", time.Hour, false),
+	}
+	sortEndingSoon(items)
+	assert.Equal(t, []string{"ending soon", "ending later", "ended recently", "ended long ago"}, lo.Map(items, func(item openapi.WatchlistItem, _ int) string {
+		return item.Title
+	}))
+}
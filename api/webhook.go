@@ -0,0 +1,583 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"q4/api/openapi"
+	"q4/models"
+	"q4/webhooks"
+)
+
+const (
+	// 每個使用者最多可以註冊的webhook數量
+	maxWebhookEndpoints = 10
+	// webhook說明的最大長度(字元數)
+	maxWebhookDescriptionLength = 255
+	// 送出紀錄每頁預設返回的數量
+	defaultWebhookDeliveryPageSize = 20
+	// 送出紀錄每頁最多返回的數量
+	maxWebhookDeliveryPageSize = 100
+	// webhook URL的最大長度
+	maxWebhookURLLength = 2048
+)
+
+// List my webhooks
+// (GET /me/webhooks)
+func (impl *ServerImpl) GetMeWebhooks(ctx context.Context, request openapi.GetMeWebhooksRequestObject) (openapi.GetMeWebhooksResponseObject, error) {
+	const op = "GetMeWebhooks"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeWebhooks401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeWebhooks401Response{}, nil
+	}
+	var endpoints []models.WebhookEndpoint
+	if result := impl.db.WithContext(ctx).Where("user_id = ?", token.Subject).Order("created_at").Find(&endpoints); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list webhooks, err=%w", op, result.Error)
+	}
+	return openapi.GetMeWebhooks200JSONResponse(lo.Map(endpoints, webhookEndpointToAPI)), nil
+}
+
+// Register a webhook
+// (POST /me/webhooks)
+func (impl *ServerImpl) PostMeWebhooks(ctx context.Context, request openapi.PostMeWebhooksRequestObject) (openapi.PostMeWebhooksResponseObject, error) {
+	const op = "PostMeWebhooks"
+	// 檢查webhook設定是否合法
+	endpointURL := strings.TrimSpace(request.Body.Url)
+	if !impl.validWebhookURL(endpointURL) {
+		return openapi.PostMeWebhooks400JSONResponse{
+			Message: lo.ToPtr("Invalid webhook URL"),
+		}, nil
+	}
+	description := strings.TrimSpace(lo.FromPtr(request.Body.Description))
+	if utf8.RuneCountInString(description) > maxWebhookDescriptionLength {
+		return openapi.PostMeWebhooks400JSONResponse{
+			Message: lo.ToPtr("Description too long"),
+		}, nil
+	}
+	events := lo.Uniq(lo.Map(request.Body.Events, func(event openapi.WebhookEventType, _ int) models.WebhookEventType {
+		return models.WebhookEventType(event)
+	}))
+	if len(events) == 0 || !lo.EveryBy(events, models.WebhookEventType.Valid) {
+		return openapi.PostMeWebhooks400JSONResponse{
+			Message: lo.ToPtr("Invalid events"),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostMeWebhooks401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostMeWebhooks401Response{}, nil
+	}
+	// 檢查webhook數量是否已達上限
+	userID := uuid.MustParse(token.Subject)
+	var count int64
+	if result := impl.db.WithContext(ctx).Model(&models.WebhookEndpoint{}).Where("user_id = ?", userID).Count(&count); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to count webhooks, err=%w", op, result.Error)
+	}
+	if count >= maxWebhookEndpoints {
+		return openapi.PostMeWebhooks409JSONResponse{
+			Message: lo.ToPtr("Too many webhooks"),
+		}, nil
+	}
+	// 產生簽章使用的密鑰並儲存
+	secret, err := webhooks.NewSecret()
+	if err != nil {
+		return nil, fmt.Errorf("[%s] %w", op, err)
+	}
+	endpoint := models.WebhookEndpoint{
+		UserID:      userID,
+		URL:         endpointURL,
+		Description: description,
+		Secret:      secret,
+		Events:      events,
+	}
+	if result := impl.db.WithContext(ctx).Create(&endpoint); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to save webhook, err=%w", op, result.Error)
+	}
+	slog.Info("Webhook registered", slog.String("user", token.Subject), slog.String("webhookID", endpoint.ID.String()))
+	response := webhookEndpointToAPI(endpoint, 0)
+	return openapi.PostMeWebhooks201JSONResponse{
+		Id:          response.Id,
+		Url:         response.Url,
+		Description: response.Description,
+		Events:      response.Events,
+		CreatedAt:   response.CreatedAt,
+		Secret:      endpoint.Secret,
+	}, nil
+}
+
+// Delete a webhook
+// (DELETE /me/webhooks/{webhookID})
+func (impl *ServerImpl) DeleteMeWebhooksWebhookID(ctx context.Context, request openapi.DeleteMeWebhooksWebhookIDRequestObject) (openapi.DeleteMeWebhooksWebhookIDResponseObject, error) {
+	const op = "DeleteMeWebhooksWebhookID"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.DeleteMeWebhooksWebhookID401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.DeleteMeWebhooksWebhookID401Response{}, nil
+	}
+	// 刪除webhook，尚未送出的紀錄標記為失敗
+	deleted := false
+	if err := impl.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ? AND user_id = ?", request.WebhookID, token.Subject).Delete(&models.WebhookEndpoint{})
+		if result.Error != nil {
+			return fmt.Errorf("fail to delete webhook, err=%w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		if result := tx.Model(&models.WebhookDelivery{}).
+			Where("webhook_endpoint_id = ? AND status = ?", request.WebhookID, models.WebhookDeliveryPending).
+			Updates(map[string]any{
+				"status":          models.WebhookDeliveryFailed,
+				"next_attempt_at": nil,
+				"last_error":      "Webhook deleted",
+			}); result.Error != nil {
+			return fmt.Errorf("fail to cancel pending deliveries, err=%w", result.Error)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("[%s] %w", op, err)
+	}
+	if !deleted {
+		return openapi.DeleteMeWebhooksWebhookID404Response{}, nil
+	}
+	return openapi.DeleteMeWebhooksWebhookID204Response{}, nil
+}
+
+// List deliveries of a webhook
+// (GET /me/webhooks/{webhookID}/deliveries)
+func (impl *ServerImpl) GetMeWebhooksWebhookIDDeliveries(ctx context.Context, request openapi.GetMeWebhooksWebhookIDDeliveriesRequestObject) (openapi.GetMeWebhooksWebhookIDDeliveriesResponseObject, error) {
+	const op = "GetMeWebhooksWebhookIDDeliveries"
+	size := int(lo.FromPtrOr(request.Params.Size, defaultWebhookDeliveryPageSize))
+	if size == 0 || size > maxWebhookDeliveryPageSize {
+		return openapi.GetMeWebhooksWebhookIDDeliveries400JSONResponse{
+			Message: lo.ToPtr("Invalid size"),
+		}, nil
+	}
+	if status := request.Params.Status; status != nil && *status != openapi.WebhookDeliveryPending && *status != openapi.WebhookDeliverySucceeded && *status != openapi.WebhookDeliveryFailed {
+		return openapi.GetMeWebhooksWebhookIDDeliveries400JSONResponse{
+			Message: lo.ToPtr("Invalid status"),
+		}, nil
+	}
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.GetMeWebhooksWebhookIDDeliveries401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.GetMeWebhooksWebhookIDDeliveries401Response{}, nil
+	}
+	// 檢查webhook是否存在
+	endpoint := models.WebhookEndpoint{ID: request.WebhookID}
+	if result := impl.db.WithContext(ctx).Where("user_id = ?", token.Subject).First(&endpoint); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.GetMeWebhooksWebhookIDDeliveries404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find webhook, err=%w", op, result.Error)
+	}
+	// 由新到舊查詢送出紀錄，時間相同時依ID排序
+	query := impl.db.WithContext(ctx).
+		Where("webhook_endpoint_id = ?", endpoint.ID).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(size)
+	if request.Params.Status != nil {
+		query = query.Where("status = ?", string(*request.Params.Status))
+	}
+	//  - cursor
+	if request.Params.LastDeliveryID != nil {
+		last := models.WebhookDelivery{ID: *request.Params.LastDeliveryID}
+		if result := impl.db.WithContext(ctx).Where("webhook_endpoint_id = ?", endpoint.ID).First(&last); result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				return openapi.GetMeWebhooksWebhookIDDeliveries400JSONResponse{
+					Message: lo.ToPtr("Last delivery not found"),
+				}, nil
+			}
+			return nil, fmt.Errorf("[%s] Fail to find last delivery, err=%w", op, result.Error)
+		}
+		query = query.Where("(created_at < ? OR created_at = ? AND id < ?)", last.CreatedAt, last.CreatedAt, last.ID)
+	}
+	var deliveries []models.WebhookDelivery
+	if result := query.Find(&deliveries); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to list deliveries, err=%w", op, result.Error)
+	}
+	return openapi.GetMeWebhooksWebhookIDDeliveries200JSONResponse{
+		Count: len(deliveries),
+		Items: lo.Map(deliveries, webhookDeliveryToAPI),
+	}, nil
+}
+
+// Redeliver a failed delivery
+// (POST /me/webhooks/{webhookID}/deliveries/{deliveryID}/redeliver)
+func (impl *ServerImpl) PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver(ctx context.Context, request openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverRequestObject) (openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliverResponseObject, error) {
+	const op = "PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver"
+	// 檢查使用者是否已登入
+	//  - 檢查是否有提供access token
+	if request.Params.AccessToken == nil {
+		return openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver401Response{}, nil
+	}
+	//  - 解析並驗證access token
+	token, err := openapi.ParseAndValidateJWT(*request.Params.AccessToken, impl.config.Auth.PrivateKey)
+	if err != nil {
+		slog.Error("Fail to parse and validate JWT", slog.String("op", op), slog.Any("error", err))
+		return openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver401Response{}, nil
+	}
+	// 檢查webhook和送出紀錄是否存在
+	endpoint := models.WebhookEndpoint{ID: request.WebhookID}
+	if result := impl.db.WithContext(ctx).Where("user_id = ?", token.Subject).First(&endpoint); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find webhook, err=%w", op, result.Error)
+	}
+	delivery := models.WebhookDelivery{ID: request.DeliveryID}
+	if result := impl.db.WithContext(ctx).Where("webhook_endpoint_id = ?", endpoint.ID).First(&delivery); result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver404Response{}, nil
+		}
+		return nil, fmt.Errorf("[%s] Fail to find delivery, err=%w", op, result.Error)
+	}
+	// 只有失敗的紀錄可以重新送出，以相同的事件建立新的送出紀錄
+	if delivery.Status != models.WebhookDeliveryFailed {
+		return openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver409JSONResponse{
+			Message: lo.ToPtr("Delivery has not failed"),
+		}, nil
+	}
+	redelivery := models.WebhookDelivery{
+		WebhookEndpointID: endpoint.ID,
+		EventID:           delivery.EventID,
+		EventType:         delivery.EventType,
+		AuctionItemID:     delivery.AuctionItemID,
+		Payload:           delivery.Payload,
+		Status:            models.WebhookDeliveryPending,
+		NextAttemptAt:     lo.ToPtr(time.Now()),
+		RedeliveryOfID:    &delivery.ID,
+	}
+	if result := impl.db.WithContext(ctx).Create(&redelivery); result.Error != nil {
+		return nil, fmt.Errorf("[%s] Fail to save redelivery, err=%w", op, result.Error)
+	}
+	slog.Info("Webhook redelivery scheduled", slog.String("user", token.Subject), slog.String("deliveryID", delivery.ID.String()))
+	return openapi.PostMeWebhooksWebhookIDDeliveriesDeliveryIDRedeliver202JSONResponse(webhookDeliveryToAPI(redelivery, 0)), nil
+}
+
+// runWebhookEventWorker 從bid stream讀取出價，為拍賣賣家的webhook建立出價和延長事件的送出紀錄，直到ctx被取消
+// 使用獨立的consumer group，不影響出價同步的進度
+func (impl *ServerImpl) runWebhookEventWorker(ctx context.Context) {
+	logger := slog.Default().With(slog.String("caller", "WebhookEvent"))
+	ch := impl.webhookConsumer.Subscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			if err := impl.enqueueBidWebhookEvents(ctx, msg.Data); err != nil {
+				logger.Error("Fail to enqueue webhook events", slog.Any("error", err))
+				if err := msg.Fail(ctx, err); err != nil {
+					logger.Error("Fail to fail message", slog.Any("error", err))
+				}
+				continue
+			}
+			if err := msg.Done(ctx); err != nil {
+				logger.Error("Fail to done message", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// enqueueBidWebhookEvents 為出價建立bid.placed事件，延後結束時間時另外建立auction.extended事件，密封出價不會建立事件
+func (impl *ServerImpl) enqueueBidWebhookEvents(ctx context.Context, bidInfo BidInfo) error {
+	if bidInfo.Sealed {
+		return nil
+	}
+	auction := models.AuctionItem{ID: bidInfo.ItemID}
+	if result := impl.db.WithContext(ctx).First(&auction); result.Error != nil {
+		// 拍賣已被取消時不需要送出事件
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("fail to find auction item, err=%w", result.Error)
+	}
+	bidEvent := openapi.BidEvent{
+		Bid:            bidInfo.Amount,
+		User:           bidInfo.User.Name,
+		UserReputation: lo.ToPtr(reputationToAPI(bidInfo.User.Reputation)),
+		Time:           bidInfo.CreatedAt,
+	}
+	if bidInfo.Quantity > 0 {
+		bidEvent.Quantity = lo.ToPtr(bidInfo.Quantity)
+	}
+	if err := impl.enqueueWebhookEvent(ctx, &auction, models.WebhookEventBidPlaced, bidInfo.CreatedAt, bidEvent); err != nil {
+		return err
+	}
+	if bidInfo.ExtendedEndTime != nil {
+		if err := impl.enqueueWebhookEvent(ctx, &auction, models.WebhookEventAuctionExtended, bidInfo.CreatedAt, openapi.AuctionExtendedEvent{
+			EndTime: *bidInfo.ExtendedEndTime,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// enqueueWebhookEvent 為拍賣賣家訂閱了此事件的webhook建立送出紀錄，實際送出由runWebhookDeliveryWorker處理
+func (impl *ServerImpl) enqueueWebhookEvent(ctx context.Context, auction *models.AuctionItem, eventType models.WebhookEventType, eventTime time.Time, data any) error {
+	var endpoints []models.WebhookEndpoint
+	if result := impl.db.WithContext(ctx).Where("user_id = ?", auction.UserID).Find(&endpoints); result.Error != nil {
+		return fmt.Errorf("fail to find webhooks, err=%w", result.Error)
+	}
+	endpoints = lo.Filter(endpoints, func(endpoint models.WebhookEndpoint, _ int) bool {
+		return endpoint.Subscribes(eventType)
+	})
+	if len(endpoints) == 0 {
+		return nil
+	}
+	event := openapi.WebhookEvent{
+		Id:     uuid.New(),
+		Type:   openapi.WebhookEventType(eventType),
+		ItemId: auction.ID,
+		Time:   eventTime,
+		Data:   data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("fail to marshal webhook event, err=%w", err)
+	}
+	now := time.Now()
+	deliveries := lo.Map(endpoints, func(endpoint models.WebhookEndpoint, _ int) models.WebhookDelivery {
+		return models.WebhookDelivery{
+			WebhookEndpointID: endpoint.ID,
+			EventID:           event.Id,
+			EventType:         eventType,
+			AuctionItemID:     auction.ID,
+			Payload:           string(payload),
+			Status:            models.WebhookDeliveryPending,
+			NextAttemptAt:     &now,
+		}
+	})
+	if result := impl.db.WithContext(ctx).Create(&deliveries); result.Error != nil {
+		return fmt.Errorf("fail to save webhook deliveries, err=%w", result.Error)
+	}
+	return nil
+}
+
+// runWebhookDeliveryWorker 定期送出到期的webhook，直到ctx被取消
+func (impl *ServerImpl) runWebhookDeliveryWorker(ctx context.Context) {
+	logger := slog.Default().With(slog.String("caller", "WebhookDelivery"))
+	ticker := time.NewTicker(impl.config.Webhook.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := impl.deliverWebhooks(ctx); err != nil {
+				logger.Error("Fail to deliver webhooks", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+// deliverWebhooks 送出到期的webhook
+//
+// 流程:
+//   - 1. 取得到期的紀錄，多個實例可以同時送出不同的紀錄
+//   - 2. 由固定數量的worker送出並記錄結果，失敗時依重試次數延後下次送出的時間，超過次數後標記為失敗
+func (impl *ServerImpl) deliverWebhooks(ctx context.Context) error {
+	const op = "deliverWebhooks"
+	logger := slog.Default().With(slog.String("caller", "WebhookDelivery"))
+
+	deliveries, err := impl.claimWebhookDeliveries(ctx)
+	if err != nil {
+		return fmt.Errorf("[%s] Fail to claim pending deliveries, err=%w", op, err)
+	}
+
+	jobs := make(chan *models.WebhookDelivery)
+	var wg sync.WaitGroup
+	for range min(max(impl.config.Webhook.Workers, 1), len(deliveries)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range jobs {
+				updates := impl.attemptWebhookDelivery(ctx, delivery)
+				if result := impl.db.WithContext(ctx).Model(delivery).Updates(updates); result.Error != nil {
+					logger.Error("Fail to update delivery", slog.String("deliveryID", delivery.ID.String()), slog.Any("error", result.Error))
+				}
+			}
+		}()
+	}
+	for i := range deliveries {
+		jobs <- &deliveries[i]
+	}
+	close(jobs)
+	wg.Wait()
+	return nil
+}
+
+// claimWebhookDeliveries 取得這次要送出的紀錄，並預先載入WebhookEndpoint
+//   - 以FOR UPDATE SKIP LOCKED查詢到期的紀錄，其他實例正在取得的紀錄會被跳過
+//   - 每個webhook最多取得MaxInFlightPerEndpoint筆，避免大量事件同時送到同一個接收端
+//   - 將下次送出的時間延後作為租約，送出期間不會被其他實例再次取得，實例中斷時租約到期後會重新送出
+func (impl *ServerImpl) claimWebhookDeliveries(ctx context.Context) ([]models.WebhookDelivery, error) {
+	var ids []uuid.UUID
+	err := impl.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		var due []models.WebhookDelivery
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+			Order("next_attempt_at").
+			Limit(impl.config.Webhook.BatchSize).
+			Find(&due); result.Error != nil {
+			return result.Error
+		}
+		ids = lo.Map(capDeliveriesPerEndpoint(due, impl.config.Webhook.MaxInFlightPerEndpoint), func(delivery models.WebhookDelivery, _ int) uuid.UUID {
+			return delivery.ID
+		})
+		if len(ids) == 0 {
+			return nil
+		}
+		// 租約涵蓋所有紀錄由worker依序送出所需的最長時間
+		workers := max(impl.config.Webhook.Workers, 1)
+		lease := impl.config.Webhook.Timeout * time.Duration((len(ids)+workers-1)/workers+1)
+		return tx.Model(&models.WebhookDelivery{}).Where("id IN ?", ids).Update("next_attempt_at", now.Add(lease)).Error
+	})
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	var deliveries []models.WebhookDelivery
+	if result := impl.db.WithContext(ctx).Preload("WebhookEndpoint").Where("id IN ?", ids).Find(&deliveries); result.Error != nil {
+		return nil, result.Error
+	}
+	return deliveries, nil
+}
+
+// capDeliveriesPerEndpoint 依原本的順序保留每個webhook最多limit筆紀錄，limit小於1時視為1
+func capDeliveriesPerEndpoint(deliveries []models.WebhookDelivery, limit int) []models.WebhookDelivery {
+	limit = max(limit, 1)
+	counts := make(map[uuid.UUID]int)
+	return lo.Filter(deliveries, func(delivery models.WebhookDelivery, _ int) bool {
+		counts[delivery.WebhookEndpointID]++
+		return counts[delivery.WebhookEndpointID] <= limit
+	})
+}
+
+// attemptWebhookDelivery 送出一次webhook，返回需要更新的欄位
+// NOTE: delivery需要預先載入WebhookEndpoint
+func (impl *ServerImpl) attemptWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) map[string]any {
+	now := time.Now()
+	attempts := delivery.Attempts + 1
+	updates := map[string]any{
+		"attempts":        attempts,
+		"last_attempt_at": now,
+		"response_status": nil,
+		"next_attempt_at": nil,
+	}
+	// webhook已被刪除
+	if delivery.WebhookEndpoint.ID == uuid.Nil {
+		updates["status"] = models.WebhookDeliveryFailed
+		updates["last_error"] = "Webhook deleted"
+		return updates
+	}
+	result, err := impl.webhookSender.Send(ctx, webhooks.Request{
+		ID:     delivery.ID.String(),
+		Event:  string(delivery.EventType),
+		URL:    delivery.WebhookEndpoint.URL,
+		Secret: delivery.WebhookEndpoint.Secret,
+		Body:   []byte(delivery.Payload),
+	})
+	// NOTE: 只記錄狀態碼和固定的錯誤訊息，避免透露目標的回應或內部網路的資訊
+	switch {
+	case errors.Is(err, webhooks.ErrAddressNotAllowed):
+		updates["last_error"] = "Address not allowed"
+	case err != nil:
+		slog.Warn("Fail to deliver webhook", slog.String("deliveryID", delivery.ID.String()), slog.Any("error", err))
+		updates["last_error"] = "Request failed"
+	case result.Success():
+		updates["status"] = models.WebhookDeliverySucceeded
+		updates["response_status"] = result.StatusCode
+		updates["last_error"] = ""
+		return updates
+	default:
+		updates["response_status"] = result.StatusCode
+		updates["last_error"] = fmt.Sprintf("Unexpected status %d", result.StatusCode)
+	}
+	if attempts >= impl.config.Webhook.MaxAttempts {
+		updates["status"] = models.WebhookDeliveryFailed
+		return updates
+	}
+	updates["next_attempt_at"] = now.Add(webhooks.Backoff(attempts, impl.config.Webhook.InitialBackoff, impl.config.Webhook.MaxBackoff))
+	return updates
+}
+
+// validWebhookURL 檢查是否為可以送出webhook的http或https URL，且不是不允許連線的位址
+func (impl *ServerImpl) validWebhookURL(raw string) bool {
+	return len(raw) <= maxWebhookURLLength && impl.webhookSender.ValidURL(raw)
+}
+
+// webhookEndpointToAPI 轉換webhook，不包含密鑰
+func webhookEndpointToAPI(endpoint models.WebhookEndpoint, _ int) openapi.WebhookEndpoint {
+	return openapi.WebhookEndpoint{
+		Id:          endpoint.ID,
+		Url:         endpoint.URL,
+		Description: endpoint.Description,
+		Events: lo.Map(endpoint.Events, func(event models.WebhookEventType, _ int) openapi.WebhookEventType {
+			return openapi.WebhookEventType(event)
+		}),
+		CreatedAt: endpoint.CreatedAt,
+	}
+}
+
+// webhookDeliveryToAPI 轉換送出紀錄
+func webhookDeliveryToAPI(delivery models.WebhookDelivery, _ int) openapi.WebhookDelivery {
+	return openapi.WebhookDelivery{
+		Id:             delivery.ID,
+		EventId:        delivery.EventID,
+		Event:          openapi.WebhookEventType(delivery.EventType),
+		ItemId:         delivery.AuctionItemID,
+		Status:         openapi.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastAttemptAt:  delivery.LastAttemptAt,
+		RedeliveryOf:   delivery.RedeliveryOfID,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+	"q4/webhooks"
+)
+
+func TestValidWebhookURL(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "https", url: "https://example.com/hook", want: true},
+		{name: "http", url: "http://example.com:8080/hook", want: true},
+		{name: "不支援的scheme", url: "ftp://example.com/hook"},
+		{name: "沒有host", url: "https:///hook"},
+		{name: "相對路徑", url: "/hook"},
+		{name: "loopback", url: "http://localhost:8080/hook"},
+		{name: "雲端metadata", url: "http://169.254.169.254/latest/meta-data"},
+		{name: "超過長度上限", url: "https://example.com/" + strings.Repeat("a", maxWebhookURLLength)},
+	}
+
+	impl := &ServerImpl{webhookSender: webhooks.NewSender(time.Second, false)}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, impl.validWebhookURL(tt.url))
+		})
+	}
+}
+
+func TestCapDeliveriesPerEndpoint(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	deliveries := []models.WebhookDelivery{
+		{ID: uuid.New(), WebhookEndpointID: a},
+		{ID: uuid.New(), WebhookEndpointID: a},
+		{ID: uuid.New(), WebhookEndpointID: b},
+		{ID: uuid.New(), WebhookEndpointID: a},
+		{ID: uuid.New(), WebhookEndpointID: b},
+	}
+
+	tests := []struct {
+		name  string
+		limit int
+		want  []int
+	}{
+		{name: "每個webhook最多一筆", limit: 1, want: []int{0, 2}},
+		{name: "每個webhook最多兩筆", limit: 2, want: []int{0, 1, 2, 4}},
+		{name: "上限大於數量時全部保留", limit: 10, want: []int{0, 1, 2, 3, 4}},
+		{name: "未設定上限時視為一筆", limit: 0, want: []int{0, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make([]models.WebhookDelivery, 0, len(tt.want))
+			for _, i := range tt.want {
+				want = append(want, deliveries[i])
+			}
+			assert.Equal(t, want, capDeliveriesPerEndpoint(deliveries, tt.limit))
+		})
+	}
+}
+
+func TestAttemptWebhookDelivery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("internal secret"))
+			return
+		}
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/ok", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	impl := &ServerImpl{
+		webhookSender: webhooks.NewSender(time.Second, true),
+		config: ServerConfig{Webhook: WebhookConfig{
+			MaxAttempts:    3,
+			InitialBackoff: time.Minute,
+			MaxBackoff:     time.Hour,
+		}},
+	}
+	endpoint := func(path string) models.WebhookEndpoint {
+		return models.WebhookEndpoint{ID: uuid.New(), URL: server.URL + path, Secret: "secret"}
+	}
+
+	tests := []struct {
+		name        string
+		delivery    models.WebhookDelivery
+		wantStatus  any
+		wantError   string
+		wantRetry   bool
+		wantRespond any
+	}{
+		{
+			name:        "成功送出",
+			delivery:    models.WebhookDelivery{WebhookEndpoint: endpoint("/ok")},
+			wantStatus:  models.WebhookDeliverySucceeded,
+			wantRespond: http.StatusNoContent,
+		},
+		{
+			name:        "失敗後等待重試",
+			delivery:    models.WebhookDelivery{WebhookEndpoint: endpoint("/fail"), Attempts: 1},
+			wantError:   "Unexpected status 500",
+			wantRetry:   true,
+			wantRespond: http.StatusInternalServerError,
+		},
+		{
+			name:        "超過重試次數",
+			delivery:    models.WebhookDelivery{WebhookEndpoint: endpoint("/fail"), Attempts: 2},
+			wantStatus:  models.WebhookDeliveryFailed,
+			wantError:   "Unexpected status 500",
+			wantRespond: http.StatusInternalServerError,
+		},
+		{
+			name:        "不跟隨重新導向",
+			delivery:    models.WebhookDelivery{WebhookEndpoint: endpoint("/redirect"), Attempts: 1},
+			wantError:   "Unexpected status 302",
+			wantRetry:   true,
+			wantRespond: http.StatusFound,
+		},
+		{
+			name:       "webhook已被刪除",
+			delivery:   models.WebhookDelivery{},
+			wantStatus: models.WebhookDeliveryFailed,
+			wantError:  "Webhook deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := impl.attemptWebhookDelivery(context.Background(), &tt.delivery)
+			assert.Equal(t, tt.delivery.Attempts+1, updates["attempts"])
+			assert.Equal(t, tt.wantStatus, updates["status"])
+			if tt.wantError != "" {
+				assert.Equal(t, tt.wantError, updates["last_error"])
+			}
+			if tt.wantRespond != nil {
+				assert.Equal(t, tt.wantRespond, updates["response_status"])
+			}
+			if tt.wantRetry {
+				assert.NotNil(t, updates["next_attempt_at"])
+			} else {
+				assert.Nil(t, updates["next_attempt_at"])
+			}
+		})
+	}
+}
+
+func TestAttemptWebhookDeliveryPrivateAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	impl := &ServerImpl{
+		webhookSender: webhooks.NewSender(time.Second, false),
+		config:        ServerConfig{Webhook: WebhookConfig{MaxAttempts: 1}},
+	}
+	delivery := models.WebhookDelivery{WebhookEndpoint: models.WebhookEndpoint{ID: uuid.New(), URL: server.URL, Secret: "secret"}}
+	updates := impl.attemptWebhookDelivery(context.Background(), &delivery)
+	assert.Equal(t, models.WebhookDeliveryFailed, updates["status"])
+	assert.Equal(t, "Address not allowed", updates["last_error"])
+	assert.Nil(t, updates["response_status"])
+	assert.False(t, called)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/samber/lo"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 
@@ -31,6 +32,13 @@ func ParseArgs() (*Args, error) {
 	pflag.BytesBase64("auth-private-key", defaultPrivateKey, "")
 	pflag.Duration("auth-expire-duration", 3*time.Hour, "")
 
+	// rbac config
+	//  - 每個角色對應的IdP群組，IdP需要在id token的groups claim中提供使用者所屬的群組
+	for _, role := range api.Roles() {
+		pflag.StringSlice(fmt.Sprintf("rbac-%s-groups", role), nil, "")
+	}
+	pflag.StringSlice("rbac-default-roles", []string{string(api.RoleSeller), string(api.RoleBidder)}, "")
+
 	// oidc config
 	pflag.String("oidc-issuer-url", "", "")
 	pflag.String("oidc-client-id", "", "")
@@ -62,6 +70,47 @@ func ParseArgs() (*Args, error) {
 
 	// redis stream keys
 	pflag.String("redis-stream-key-for-bid", "q4-shared-bid-stream", "")
+	pflag.String("redis-stream-key-for-event", "q4-shared-event-stream", "")
+
+	// settlement config
+	pflag.Duration("settlement-interval", 5*time.Second, "")
+	pflag.Int("settlement-batch-size", 20, "")
+	pflag.Duration("settlement-timeout", 30*time.Second, "")
+
+	// buy now config
+	pflag.Float64("buy-now-withdraw-ratio", 0.5, "")
+
+	// shill detection config
+	pflag.Duration("shill-detection-interval", time.Hour, "")
+	pflag.Duration("shill-detection-lookback", 30*24*time.Hour, "")
+	pflag.Int("shill-detection-min-auctions", 5, "")
+	pflag.Float64("shill-detection-threshold", 0.7, "")
+
+	// idempotency config
+	pflag.Duration("idempotency-ttl", 24*time.Hour, "")
+
+	// notification config
+	pflag.String("notification-consumer-group", "q4-notification-group", "")
+	pflag.Duration("notification-dedup-ttl", 7*24*time.Hour, "")
+	pflag.Duration("notification-ending-soon-window", time.Hour, "")
+	pflag.Duration("notification-ending-soon-interval", time.Minute, "")
+	pflag.Duration("notification-webhook-timeout", 5*time.Second, "")
+	pflag.String("notification-smtp-addr", "", "")
+	pflag.String("notification-smtp-username", "", "")
+	pflag.String("notification-smtp-password", "", "")
+	pflag.String("notification-smtp-from", "q4 <noreply@q4.local>", "")
+
+	// webhook config
+	pflag.String("webhook-consumer-group", "q4-webhook-group", "")
+	pflag.Duration("webhook-interval", 5*time.Second, "")
+	pflag.Int("webhook-batch-size", 50, "")
+	pflag.Int("webhook-workers", 8, "")
+	pflag.Int("webhook-max-in-flight-per-endpoint", 2, "")
+	pflag.Duration("webhook-timeout", 10*time.Second, "")
+	pflag.Uint32("webhook-max-attempts", 8, "")
+	pflag.Duration("webhook-initial-backoff", 30*time.Second, "")
+	pflag.Duration("webhook-max-backoff", 6*time.Hour, "")
+	pflag.Bool("webhook-allow-private-address", false, "")
 
 	// bind pflag to viper
 	pflag.Parse()
@@ -78,6 +127,24 @@ func ParseArgs() (*Args, error) {
 	if len(authPrivateKey) != ed25519.PrivateKeySize {
 		return nil, fmt.Errorf("%s: invalid auth private key size", op)
 	}
+	buyNowWithdrawRatio := viper.GetFloat64("buy-now-withdraw-ratio")
+	if buyNowWithdrawRatio <= 0 || buyNowWithdrawRatio > 1 {
+		return nil, fmt.Errorf("%s: buy now withdraw ratio must be in (0, 1]", op)
+	}
+	defaultRoles := lo.Map(stringList("rbac-default-roles"), func(role string, _ int) api.Role {
+		return api.Role(role)
+	})
+	if lo.ContainsBy(defaultRoles, func(role api.Role) bool { return !role.Valid() }) {
+		return nil, fmt.Errorf("%s: invalid rbac default roles", op)
+	}
+	consumerGroups := []string{
+		viper.GetString("redis-consumer-group"),
+		viper.GetString("notification-consumer-group"),
+		viper.GetString("webhook-consumer-group"),
+	}
+	if len(lo.Uniq(consumerGroups)) != len(consumerGroups) {
+		return nil, fmt.Errorf("%s: redis, notification and webhook consumer groups must be different", op)
+	}
 
 	// initial arguments
 	return &Args{
@@ -90,6 +157,12 @@ func ParseArgs() (*Args, error) {
 				Audience:       viper.GetString("auth-audience"),
 				ExpireDuration: viper.GetDuration("auth-expire-duration"),
 			},
+			RBAC: api.RBACConfig{
+				Groups: lo.SliceToMap(api.Roles(), func(role api.Role) (api.Role, []string) {
+					return role, stringList(fmt.Sprintf("rbac-%s-groups", role))
+				}),
+				DefaultRoles: defaultRoles,
+			},
 			OIDC: api.OIDCConfig{
 				IssuerURL:    viper.GetString("oidc-issuer-url"),
 				ClientID:     viper.GetString("oidc-client-id"),
@@ -119,9 +192,53 @@ func ParseArgs() (*Args, error) {
 				KeyPrefix:     viper.GetString("redis-key-prefix"),
 				ConsumerGroup: viper.GetString("redis-consumer-group"),
 				StreamKeys: api.RedisStreamKeys{
-					BidStream: viper.GetString("redis-stream-key-for-bid"),
+					BidStream:   viper.GetString("redis-stream-key-for-bid"),
+					EventStream: viper.GetString("redis-stream-key-for-event"),
 				},
 			},
+			Settlement: api.SettlementConfig{
+				Interval:  viper.GetDuration("settlement-interval"),
+				BatchSize: viper.GetInt("settlement-batch-size"),
+				Timeout:   viper.GetDuration("settlement-timeout"),
+			},
+			BuyNow: api.BuyNowConfig{
+				WithdrawRatio: buyNowWithdrawRatio,
+			},
+			ShillDetection: api.ShillDetectionConfig{
+				Interval:    viper.GetDuration("shill-detection-interval"),
+				Lookback:    viper.GetDuration("shill-detection-lookback"),
+				MinAuctions: viper.GetInt("shill-detection-min-auctions"),
+				Threshold:   viper.GetFloat64("shill-detection-threshold"),
+			},
+			Idempotency: api.IdempotencyConfig{
+				TTL: viper.GetDuration("idempotency-ttl"),
+			},
+			Notification: api.NotificationConfig{
+				ConsumerGroup:      viper.GetString("notification-consumer-group"),
+				DedupTTL:           viper.GetDuration("notification-dedup-ttl"),
+				EndingSoonWindow:   viper.GetDuration("notification-ending-soon-window"),
+				EndingSoonInterval: viper.GetDuration("notification-ending-soon-interval"),
+				WebhookTimeout:     viper.GetDuration("notification-webhook-timeout"),
+				SMTP: api.SMTPConfig{
+					Addr:     viper.GetString("notification-smtp-addr"),
+					Username: viper.GetString("notification-smtp-username"),
+					Password: viper.GetString("notification-smtp-password"),
+					From:     viper.GetString("notification-smtp-from"),
+				},
+			},
+			Webhook: api.WebhookConfig{
+				ConsumerGroup:  viper.GetString("webhook-consumer-group"),
+				Interval:       viper.GetDuration("webhook-interval"),
+				BatchSize:      viper.GetInt("webhook-batch-size"),
+				Workers:        viper.GetInt("webhook-workers"),
+				Timeout:        viper.GetDuration("webhook-timeout"),
+				MaxAttempts:    viper.GetUint32("webhook-max-attempts"),
+				InitialBackoff: viper.GetDuration("webhook-initial-backoff"),
+				MaxBackoff:     viper.GetDuration("webhook-max-backoff"),
+
+				MaxInFlightPerEndpoint: viper.GetInt("webhook-max-in-flight-per-endpoint"),
+				AllowPrivateAddress:    viper.GetBool("webhook-allow-private-address"),
+			},
 		},
 	}, nil
 }
@@ -131,6 +248,16 @@ type Args struct {
 	ServerConfig api.ServerConfig
 }
 
+// stringList 讀取字串列表參數
+// 環境變數的值不會以逗號分隔，因此再以逗號分隔每個元素並移除空白
+func stringList(key string) []string {
+	return lo.Compact(lo.FlatMap(viper.GetStringSlice(key), func(value string, _ int) []string {
+		return lo.Map(strings.Split(value, ","), func(item string, _ int) string {
+			return strings.TrimSpace(item)
+		})
+	}))
+}
+
 func (args Args) Validate() bool {
 	return args.ServerURL != "" && args.ServerConfig.OIDC.IssuerURL != "" && args.ServerConfig.OIDC.ClientID != "" && args.ServerConfig.OIDC.ClientSecret != ""
 }
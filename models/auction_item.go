@@ -8,6 +8,39 @@ import (
 	"gorm.io/gorm"
 )
 
+// AuctionStatus 代表拍賣的結標狀態
+type AuctionStatus string
+
+const (
+	// AuctionStatusActive 拍賣尚未結標
+	AuctionStatusActive AuctionStatus = "active"
+	// AuctionStatusSold 拍賣已結標且有得標者
+	AuctionStatusSold AuctionStatus = "sold"
+	// AuctionStatusUnsold 拍賣已結標但沒有得標者
+	AuctionStatusUnsold AuctionStatus = "unsold"
+	// AuctionStatusCancelled 拍賣已被賣家取消
+	AuctionStatusCancelled AuctionStatus = "cancelled"
+)
+
+// AuctionType 代表拍賣的競價方式
+type AuctionType string
+
+const (
+	// AuctionTypeEnglish 英式拍賣，價格由買家出價逐步提高
+	AuctionTypeEnglish AuctionType = "english"
+	// AuctionTypeDutch 荷式拍賣，價格隨時間逐步降低，第一個接受的買家得標
+	AuctionTypeDutch AuctionType = "dutch"
+	// AuctionTypeSealedFirst 密封出價拍賣，出價不公開，結標時最高出價者以自己的出價得標
+	AuctionTypeSealedFirst AuctionType = "sealed_first"
+	// AuctionTypeSealedSecond 密封出價拍賣(Vickrey)，出價不公開，結標時最高出價者以第二高的出價得標
+	AuctionTypeSealedSecond AuctionType = "sealed_second"
+)
+
+// IsSealed 返回是否為密封出價拍賣
+func (t AuctionType) IsSealed() bool {
+	return t == AuctionTypeSealedFirst || t == AuctionTypeSealedSecond
+}
+
 // AuctionItem 代表拍賣系統中的商品
 // 包含商品資訊、起標價、目前最高出價、拍賣時間等資訊
 type AuctionItem struct {
@@ -17,14 +50,50 @@ type AuctionItem struct {
 	UserID        uuid.UUID      `gorm:"type:uuid;<-:create"`
 	Title         string         `gorm:"type:varchar(255);not null"`
 	Description   string         `gorm:"type:text;not null"`
-	StartingPrice uint32         `gorm:"type:integer;not null"`
+	StartingPrice Money          `gorm:"type:bigint;not null"`
 	CurrentBidID  *uuid.UUID     `gorm:"type:uuid;"`
 	StartTime     time.Time      `gorm:"type:timestamp with time zone;not null"`
-	EndTime       time.Time      `gorm:"type:timestamp with time zone;not null"`
+	EndTime       time.Time      `gorm:"type:timestamp with time zone;not null;index:idx_auction_items_status_end_time,priority:2"`
 	Carousels     pq.StringArray `gorm:"type:text[];default:'{}'"`
+	Status        AuctionStatus  `gorm:"type:varchar(16);not null;default:'active';index:idx_auction_items_status_end_time,priority:1"`
+	WinningBidID  *uuid.UUID     `gorm:"type:uuid;"`
+	SettledAt     *time.Time     `gorm:"type:timestamp with time zone;"`
+	FinalPrice    *Money         `gorm:"type:bigint;"`
+	Quantity      uint32         `gorm:"type:integer;not null;default:1;<-:create"`
+	Type          AuctionType    `gorm:"type:varchar(16);not null;default:'english';<-:create"`
+	CategoryID    *uuid.UUID     `gorm:"type:uuid;index"`
+	Tags          pq.StringArray `gorm:"type:text[];not null;default:'{}';index:idx_auction_items_tags,type:gin"`
+	Currency      Currency       `gorm:"type:char(3);not null;default:'TWD';<-:create"` // 所有金額欄位的貨幣
+
+	// 全文檢索：DescriptionText為去除HTML標籤後的描述，SearchVector由資料庫依標題和DescriptionText產生
+	DescriptionText string `gorm:"type:text;not null;default:''"`
+	SearchVector    string `gorm:"type:tsvector GENERATED ALWAYS AS (setweight(to_tsvector('simple', title), 'A') || setweight(to_tsvector('simple', description_text), 'B')) STORED;index:idx_auction_items_search_vector,type:gin;->:false;<-:false"`
+
+	// 軟結標設定(秒)：在結束前SoftCloseWindow秒內出價，結束時間會延後到出價後SoftCloseExtension秒
+	SoftCloseWindow    uint32 `gorm:"type:integer;not null;default:0"`
+	SoftCloseExtension uint32 `gorm:"type:integer;not null;default:0"`
+	// 最小加價規則
+	BidIncrement BidIncrementRule `gorm:"type:jsonb;serializer:json;not null;default:'{}'"`
+	// 底價：結標時最高出價未達底價則流標，不會公開給買家
+	ReservePrice *Money `gorm:"type:bigint;"`
+	// 直購價：最高出價超過直購價的一定比例後撤下
+	BuyNowPrice *Money `gorm:"type:bigint;"`
+	// 荷式拍賣設定：從起標價開始每DutchInterval秒降價DutchDecrement，最低降到DutchFloorPrice
+	DutchDecrement  Money  `gorm:"type:bigint;not null;default:0"`
+	DutchInterval   uint32 `gorm:"type:integer;not null;default:0"`
+	DutchFloorPrice Money  `gorm:"type:bigint;not null;default:0"`
+	// 被管理員隱藏的時間，隱藏的拍賣同時會被soft delete
+	HiddenAt *time.Time `gorm:"type:timestamp with time zone;"`
 
 	// 外鍵關聯
 	User       User
 	CurrentBid *Bid `gorm:"foreignKey:CurrentBidID"`
+	WinningBid *Bid `gorm:"foreignKey:WinningBidID"`
+	Category   *Category
 	BidRecords []Bid
 }
+
+// IsMultiUnit 返回是否為一次拍賣多個相同商品的拍賣，多數量拍賣結標時以最低得標出價統一成交
+func (a *AuctionItem) IsMultiUnit() bool {
+	return a.Quantity > 1
+}
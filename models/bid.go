@@ -11,9 +11,10 @@ type Bid struct {
 	gorm.Model
 
 	ID            uuid.UUID `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
-	Amount        uint32    `gorm:"type:integer;not null;<-:create"`
+	Amount        Money     `gorm:"type:bigint;not null;<-:create"`
 	UserID        uuid.UUID `gorm:"type:uuid;not null;<-:create"`
-	AuctionItemID uuid.UUID `gorm:"type:uuid;not null;<-:create"`
+	AuctionItemID uuid.UUID `gorm:"type:uuid;not null;index;<-:create"`
+	Quantity      uint32    `gorm:"type:integer;not null;default:1;<-:create"` // 多數量拍賣中想購買的數量
 
 	// 外鍵關聯
 	User        User
@@ -0,0 +1,46 @@
+package models
+
+// BidIncrementType 代表最小加價規則的類型
+type BidIncrementType string
+
+const (
+	// BidIncrementFixed 固定金額加價
+	BidIncrementFixed BidIncrementType = "fixed"
+	// BidIncrementPercent 依目前價格的百分比加價(無條件進位)
+	BidIncrementPercent BidIncrementType = "percent"
+	// BidIncrementTiered 依價格區間使用不同的固定加價金額
+	BidIncrementTiered BidIncrementType = "tiered"
+)
+
+// BidIncrementTier 代表價格區間的加價金額，價格大於等於From時使用Step
+type BidIncrementTier struct {
+	From Money `json:"from"`
+	Step Money `json:"step"`
+}
+
+// BidIncrementRule 代表拍賣商品的最小加價規則
+// 未設定Type時，新出價只需要高於目前價格
+type BidIncrementRule struct {
+	Type    BidIncrementType   `json:"type,omitempty"`
+	Step    Money              `json:"step,omitempty"`
+	Percent uint32             `json:"percent,omitempty"`
+	Tiers   []BidIncrementTier `json:"tiers,omitempty"`
+}
+
+// Increment 返回價格為price時的最小加價金額，計算方式和BidScript相同，最少為1
+func (r BidIncrementRule) Increment(price Money) Money {
+	step := Money(1)
+	switch r.Type {
+	case BidIncrementFixed:
+		step = r.Step
+	case BidIncrementPercent:
+		step = (price*Money(r.Percent) + 99) / 100
+	case BidIncrementTiered:
+		for _, tier := range r.Tiers {
+			if price >= tier.From {
+				step = tier.Step
+			}
+		}
+	}
+	return max(step, 1)
+}
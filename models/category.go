@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Category 代表拍賣商品的分類
+// 分類以ParentID組成樹狀結構，ParentID為nil時為最上層的分類
+type Category struct {
+	gorm.Model
+
+	ID       uuid.UUID  `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	ParentID *uuid.UUID `gorm:"type:uuid;index"`
+	Name     string     `gorm:"type:varchar(255);not null"`
+
+	// 外鍵關聯
+	Parent *Category `gorm:"foreignKey:ParentID"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// FeedbackRole 代表收到評價的使用者在交易中的角色
+type FeedbackRole string
+
+const (
+	// FeedbackRoleSeller 得標者對賣家的評價
+	FeedbackRoleSeller FeedbackRole = "seller"
+	// FeedbackRoleBuyer 賣家對得標者的評價
+	FeedbackRoleBuyer FeedbackRole = "buyer"
+)
+
+const (
+	// MinFeedbackRating 是評價分數的下限
+	MinFeedbackRating = 1
+	// MaxFeedbackRating 是評價分數的上限
+	MaxFeedbackRating = 5
+)
+
+// Feedback 代表拍賣成交後賣家和得標者之間的評價
+// 每筆成交的拍賣中，每個使用者對同一個交易對象只能評價一次
+type Feedback struct {
+	gorm.Model
+
+	ID            uuid.UUID    `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	AuctionItemID uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_feedbacks_auction_item_from_to,priority:1;<-:create"`
+	FromUserID    uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_feedbacks_auction_item_from_to,priority:2;<-:create"`
+	ToUserID      uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_feedbacks_auction_item_from_to,priority:3;index;<-:create"`
+	Role          FeedbackRole `gorm:"type:varchar(16);not null;<-:create"`
+	Rating        uint32       `gorm:"type:smallint;not null;<-:create"`
+	Comment       string       `gorm:"type:text;not null;default:'';<-:create"`
+
+	// 外鍵關聯
+	AuctionItem AuctionItem
+	FromUser    User `gorm:"foreignKey:FromUserID"`
+	ToUser      User `gorm:"foreignKey:ToUserID"`
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ReportReason 代表檢舉拍賣物品的原因
+type ReportReason string
+
+const (
+	// ReportReasonProhibited 禁止販售的物品
+	ReportReasonProhibited ReportReason = "prohibited"
+	// ReportReasonCounterfeit 仿冒品
+	ReportReasonCounterfeit ReportReason = "counterfeit"
+	// ReportReasonFraud 詐騙
+	ReportReasonFraud ReportReason = "fraud"
+	// ReportReasonOffensive 冒犯性的內容
+	ReportReasonOffensive ReportReason = "offensive"
+	// ReportReasonOther 其他原因，需要在說明中描述
+	ReportReasonOther ReportReason = "other"
+)
+
+// Valid 檢查是否為支援的檢舉原因
+func (r ReportReason) Valid() bool {
+	switch r {
+	case ReportReasonProhibited, ReportReasonCounterfeit, ReportReasonFraud, ReportReasonOffensive, ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModerationCaseStatus 代表審核案件的處理狀態
+type ModerationCaseStatus string
+
+const (
+	// ModerationCaseOpen 等待管理員審核
+	ModerationCaseOpen ModerationCaseStatus = "open"
+	// ModerationCaseActioned 拍賣物品已被隱藏
+	ModerationCaseActioned ModerationCaseStatus = "actioned"
+	// ModerationCaseDismissed 管理員認為沒有違規
+	ModerationCaseDismissed ModerationCaseStatus = "dismissed"
+)
+
+// ModerationCase 代表審核佇列中的一個拍賣物品，同一個拍賣物品的檢舉會合併到同一個案件
+// 被駁回的案件收到新的檢舉時會重新開啟
+type ModerationCase struct {
+	gorm.Model
+
+	ID             uuid.UUID            `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	AuctionItemID  uuid.UUID            `gorm:"type:uuid;not null;uniqueIndex;<-:create"`
+	Status         ModerationCaseStatus `gorm:"type:varchar(16);not null;default:'open';index"`
+	ReportCount    uint32               `gorm:"type:integer;not null;default:0"`
+	LastReportedAt time.Time            `gorm:"type:timestamp with time zone;not null"`
+	ResolvedByID   *uuid.UUID           `gorm:"type:uuid;"`
+	ResolvedAt     *time.Time           `gorm:"type:timestamp with time zone;"`
+	Note           string               `gorm:"type:text;not null;default:''"`
+
+	// 外鍵關聯
+	AuctionItem AuctionItem
+	ResolvedBy  *User `gorm:"foreignKey:ResolvedByID"`
+	Reports     []Report
+}
+
+// Report 代表使用者對拍賣物品的檢舉，每個使用者對同一個拍賣物品只能檢舉一次
+type Report struct {
+	gorm.Model
+
+	ID               uuid.UUID    `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	ModerationCaseID uuid.UUID    `gorm:"type:uuid;not null;index;<-:create"`
+	AuctionItemID    uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_reports_auction_item_reporter,priority:1;<-:create"`
+	ReporterID       uuid.UUID    `gorm:"type:uuid;not null;uniqueIndex:idx_reports_auction_item_reporter,priority:2;<-:create"`
+	Reason           ReportReason `gorm:"type:varchar(16);not null;<-:create"`
+	Details          string       `gorm:"type:text;not null;default:'';<-:create"`
+
+	// 外鍵關聯
+	AuctionItem AuctionItem
+	Reporter    User `gorm:"foreignKey:ReporterID"`
+}
@@ -0,0 +1,71 @@
+package models
+
+import "fmt"
+
+// Money 代表以貨幣最小單位表示的金額，例如TWD的1元為100
+// 金額只能搭配拍賣物品的Currency解讀，不同貨幣的金額不能直接比較
+type Money int64
+
+// MaxMoney 是金額的上限
+// Lua script以雙精度浮點數處理金額，並以最多14位有效數字轉換為字串，超過上限會失去精度
+const MaxMoney Money = 999_999_999_999
+
+// Valid 返回金額是否在允許的範圍內
+func (m Money) Valid() bool {
+	return m >= 0 && m <= MaxMoney
+}
+
+// Currency 代表ISO 4217貨幣代碼
+type Currency string
+
+const (
+	CurrencyTWD Currency = "TWD"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+	CurrencyJPY Currency = "JPY"
+)
+
+// DefaultCurrency 是建立拍賣物品時未指定貨幣使用的貨幣，也是加入多貨幣前所有金額的貨幣
+const DefaultCurrency = CurrencyTWD
+
+// 支援的貨幣和它們的最小單位位數
+var currencyExponents = map[Currency]int{
+	CurrencyTWD: 2,
+	CurrencyUSD: 2,
+	CurrencyEUR: 2,
+	CurrencyJPY: 0,
+}
+
+// Valid 返回是否為支援的貨幣
+func (c Currency) Valid() bool {
+	_, ok := currencyExponents[c]
+	return ok
+}
+
+// Exponent 返回貨幣最小單位的位數，例如TWD為2(1元=100分)
+func (c Currency) Exponent() int {
+	return currencyExponents[c]
+}
+
+// FromMajor 將以主要單位表示的整數金額轉換為最小單位
+func (c Currency) FromMajor(amount int64) Money {
+	for range c.Exponent() {
+		amount *= 10
+	}
+	return Money(amount)
+}
+
+// Format 將金額格式化為貨幣代碼和小數，例如TWD的12345格式化為"TWD 123.45"
+func (c Currency) Format(amount Money) string {
+	sign := ""
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	exponent := c.Exponent()
+	if exponent == 0 {
+		return fmt.Sprintf("%s %s%d", c, sign, amount)
+	}
+	digits := fmt.Sprintf("%0*d", exponent+1, amount)
+	split := len(digits) - exponent
+	return fmt.Sprintf("%s %s%s.%s", c, sign, digits[:split], digits[split:])
+}
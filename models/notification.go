@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// NotificationKind 代表通知的種類
+type NotificationKind string
+
+const (
+	// NotificationKindOutbid 使用者的領先出價被超過
+	NotificationKindOutbid NotificationKind = "outbid"
+	// NotificationKindWon 使用者得標
+	NotificationKindWon NotificationKind = "won"
+	// NotificationKindEndingSoon 使用者關注或出價過的拍賣即將結束
+	NotificationKindEndingSoon NotificationKind = "ending_soon"
+)
+
+// NotificationChannel 代表送出通知的管道
+type NotificationChannel string
+
+const (
+	// NotificationChannelInbox 站內通知，儲存在資料庫中
+	NotificationChannelInbox NotificationChannel = "inbox"
+	// NotificationChannelEmail 電子郵件
+	NotificationChannelEmail NotificationChannel = "email"
+	// NotificationChannelWebhook 使用者設定的webhook
+	NotificationChannelWebhook NotificationChannel = "webhook"
+)
+
+// Valid 檢查是否為支援的通知管道
+func (c NotificationChannel) Valid() bool {
+	switch c {
+	case NotificationChannelInbox, NotificationChannelEmail, NotificationChannelWebhook:
+		return true
+	default:
+		return false
+	}
+}
+
+// Notification 代表站內通知
+type Notification struct {
+	gorm.Model
+
+	ID            uuid.UUID        `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	UserID        uuid.UUID        `gorm:"type:uuid;not null;index;<-:create"`
+	Kind          NotificationKind `gorm:"type:varchar(32);not null;<-:create"`
+	AuctionItemID *uuid.UUID       `gorm:"type:uuid;<-:create"`
+	Title         string           `gorm:"type:varchar(255);not null;<-:create"`
+	Body          string           `gorm:"type:text;not null;default:'';<-:create"`
+	ReadAt        *time.Time       `gorm:"type:timestamptz"`
+
+	// 外鍵關聯
+	User        User
+	AuctionItem *AuctionItem
+}
+
+// NotificationPreference 代表使用者的通知設定，每個使用者只有一筆紀錄
+// 沒有設定的通知種類只會送出站內通知
+type NotificationPreference struct {
+	gorm.Model
+
+	ID         uuid.UUID                                  `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	UserID     uuid.UUID                                  `gorm:"type:uuid;not null;uniqueIndex;<-:create"`
+	Email      string                                     `gorm:"type:varchar(255);not null;default:''"`
+	WebhookURL string                                     `gorm:"type:varchar(2048);not null;default:''"`
+	Channels   map[NotificationKind][]NotificationChannel `gorm:"type:jsonb;serializer:json;not null;default:'{}'"`
+
+	// 外鍵關聯
+	User User
+}
+
+// ChannelsFor 返回指定通知種類要送出的管道
+func (p NotificationPreference) ChannelsFor(kind NotificationKind) []NotificationChannel {
+	if channels, ok := p.Channels[kind]; ok {
+		return channels
+	}
+	return []NotificationChannel{NotificationChannelInbox}
+}
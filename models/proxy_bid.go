@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProxyBid 代表使用者對拍賣商品設定的代理出價
+// 系統會在最高出價金額內，自動以最小增額替使用者出價，每個使用者在每個拍賣商品只有一筆紀錄
+type ProxyBid struct {
+	gorm.Model
+
+	ID            uuid.UUID `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	AuctionItemID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_proxy_bids_auction_item_user,priority:1;<-:create"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_proxy_bids_auction_item_user,priority:2;<-:create"`
+	MaxAmount     Money     `gorm:"type:bigint;not null"`
+
+	// 外鍵關聯
+	User        User
+	AuctionItem AuctionItem
+}
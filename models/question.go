@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Question 代表使用者對拍賣物品提出的問題和賣家的回答
+// 回答後的問題對所有人公開，未回答的問題只有賣家和提問者可以看到
+type Question struct {
+	gorm.Model
+
+	ID            uuid.UUID  `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	AuctionItemID uuid.UUID  `gorm:"type:uuid;not null;index;<-:create"`
+	UserID        uuid.UUID  `gorm:"type:uuid;not null;<-:create"`
+	Content       string     `gorm:"type:text;not null;<-:create"`
+	Answer        string     `gorm:"type:text;not null;default:''"`
+	AnsweredAt    *time.Time `gorm:"type:timestamptz"`
+
+	// 外鍵關聯
+	AuctionItem AuctionItem
+	User        User
+}
+
+// Answered 檢查問題是否已被回答
+func (q Question) Answered() bool {
+	return q.AnsweredAt != nil
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ShillFlag 代表疑似哄抬價格(shill bidding)而需要人工審核的使用者
+// 記錄最近一次偵測時的分數和各項訊號，每個使用者只有一筆紀錄
+type ShillFlag struct {
+	gorm.Model
+
+	ID              uuid.UUID  `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	UserID          uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex;<-:create"`
+	Score           float64    `gorm:"type:double precision;not null"`
+	Auctions        int        `gorm:"type:integer;not null"`
+	Wins            int        `gorm:"type:integer;not null"`
+	SameSellerRatio float64    `gorm:"type:double precision;not null"`
+	PushRatio       float64    `gorm:"type:double precision;not null"`
+	ReviewedAt      *time.Time `gorm:"type:timestamp with time zone;"`
+
+	// 外鍵關聯
+	User User
+}
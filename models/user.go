@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -10,6 +12,29 @@ import (
 type User struct {
 	gorm.Model
 
-	ID       uuid.UUID `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
-	Username string    `gorm:"type:varchar(255);uniqueIndex;not null;<-:create"`
+	ID         uuid.UUID  `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	Username   string     `gorm:"type:varchar(255);uniqueIndex;not null;<-:create"`
+	Reputation Reputation `gorm:"embedded"`
+	// 被管理員停權的時間，停權的使用者不能登入、刊登、出價或提問
+	BannedAt  *time.Time `gorm:"type:timestamp with time zone;"`
+	BanReason string     `gorm:"type:text;not null;default:''"`
+}
+
+// Banned 返回使用者是否已被停權
+func (u User) Banned() bool {
+	return u.BannedAt != nil
+}
+
+// Reputation 代表使用者在交易後收到的評價統計，於新增評價時累加
+type Reputation struct {
+	RatingCount uint32 `gorm:"type:integer;not null;default:0"`
+	RatingSum   uint32 `gorm:"type:integer;not null;default:0"`
+}
+
+// Score 返回評價的平均分數，沒有評價時為0
+func (r Reputation) Score() float64 {
+	if r.RatingCount == 0 {
+		return 0
+	}
+	return float64(r.RatingSum) / float64(r.RatingCount)
 }
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WatchlistItem 代表使用者關注的拍賣商品，每個使用者在每個拍賣商品只有一筆紀錄
+type WatchlistItem struct {
+	gorm.Model
+
+	ID            uuid.UUID `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	UserID        uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_watchlist_items_user_auction_item,priority:1;<-:create"`
+	AuctionItemID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_watchlist_items_user_auction_item,priority:2;<-:create"`
+
+	// 外鍵關聯
+	User        User
+	AuctionItem AuctionItem
+}
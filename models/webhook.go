@@ -0,0 +1,94 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// WebhookEventType 代表webhook可以訂閱的事件種類
+type WebhookEventType string
+
+const (
+	// WebhookEventBidPlaced 拍賣有新的出價，密封出價不會送出
+	WebhookEventBidPlaced WebhookEventType = "bid.placed"
+	// WebhookEventAuctionEnded 拍賣已結標
+	WebhookEventAuctionEnded WebhookEventType = "auction.ended"
+	// WebhookEventAuctionExtended 軟結標延後了拍賣的結束時間
+	WebhookEventAuctionExtended WebhookEventType = "auction.extended"
+)
+
+// Valid 檢查是否為支援的事件種類
+func (t WebhookEventType) Valid() bool {
+	switch t {
+	case WebhookEventBidPlaced, WebhookEventAuctionEnded, WebhookEventAuctionExtended:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookEndpoint 代表使用者註冊的webhook，會收到使用者刊登的拍賣中有訂閱的事件
+// Secret用於計算請求的HMAC-SHA256簽章
+type WebhookEndpoint struct {
+	gorm.Model
+
+	ID          uuid.UUID          `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	UserID      uuid.UUID          `gorm:"type:uuid;not null;index;<-:create"`
+	URL         string             `gorm:"type:varchar(2048);not null;<-:create"`
+	Description string             `gorm:"type:varchar(255);not null;default:'';<-:create"`
+	Secret      string             `gorm:"type:varchar(64);not null;<-:create"`
+	Events      []WebhookEventType `gorm:"type:jsonb;serializer:json;not null;default:'[]';<-:create"`
+
+	// 外鍵關聯
+	User User
+}
+
+// Subscribes 檢查是否訂閱了指定的事件種類
+func (e WebhookEndpoint) Subscribes(eventType WebhookEventType) bool {
+	for _, t := range e.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDeliveryStatus 代表webhook送出的狀態
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending 等待送出或重試
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliverySucceeded 對方回應2xx
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed 超過重試次數仍然失敗
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery 代表一次事件的送出紀錄
+// 同一個事件重新送出時會建立新的紀錄，EventID保持不變讓接收端可以去重
+type WebhookDelivery struct {
+	gorm.Model
+
+	ID                uuid.UUID             `gorm:"type:uuid;default:public.uuid_generate_v7();primaryKey;<-:false"`
+	WebhookEndpointID uuid.UUID             `gorm:"type:uuid;not null;index;<-:create"`
+	EventID           uuid.UUID             `gorm:"type:uuid;not null;<-:create"`
+	EventType         WebhookEventType      `gorm:"type:varchar(32);not null;<-:create"`
+	AuctionItemID     uuid.UUID             `gorm:"type:uuid;not null;<-:create"`
+	Payload           string                `gorm:"type:text;not null;<-:create"`
+	Status            WebhookDeliveryStatus `gorm:"type:varchar(16);not null;index:idx_webhook_deliveries_status_next_attempt_at,priority:1"`
+	Attempts          uint32                `gorm:"type:integer;not null;default:0"`
+	NextAttemptAt     *time.Time            `gorm:"type:timestamptz;index:idx_webhook_deliveries_status_next_attempt_at,priority:2"`
+	LastAttemptAt     *time.Time            `gorm:"type:timestamptz"`
+	ResponseStatus    *int                  `gorm:"type:integer"`
+	LastError         string                `gorm:"type:text;not null;default:''"`
+	// RedeliveryOfID 手動重新送出時，原本的送出紀錄
+	RedeliveryOfID *uuid.UUID `gorm:"type:uuid;<-:create"`
+
+	// 外鍵關聯
+	WebhookEndpoint WebhookEndpoint
+	AuctionItem     AuctionItem
+	RedeliveryOf    *WebhookDelivery
+}
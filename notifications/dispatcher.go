@@ -0,0 +1,101 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"q4/models"
+)
+
+// Dispatcher 將通知送到收件者選擇的管道，並依據Message.DedupKey避免重複送出
+type Dispatcher struct {
+	client    redis.Cmdable
+	keyPrefix string
+	dedupTTL  time.Duration
+	notifiers map[models.NotificationChannel]Notifier
+	logger    *slog.Logger
+}
+
+type DispatcherOption func(*Dispatcher)
+
+// WithNotifier 設定管道使用的Notifier，沒有設定的管道會被略過
+func WithNotifier(channel models.NotificationChannel, notifier Notifier) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.notifiers[channel] = notifier
+	}
+}
+
+// WithDispatcherLogger 設定logger
+func WithDispatcherLogger(logger *slog.Logger) DispatcherOption {
+	return func(d *Dispatcher) {
+		d.logger = logger
+	}
+}
+
+// NewDispatcher 建立Dispatcher
+//   - keyPrefix 去重鍵的前綴
+//   - dedupTTL 去重鍵保留的時間，超過後相同DedupKey的通知會再次送出
+func NewDispatcher(client redis.Cmdable, keyPrefix string, dedupTTL time.Duration, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{
+		client:    client,
+		keyPrefix: keyPrefix,
+		dedupTTL:  dedupTTL,
+		notifiers: make(map[models.NotificationChannel]Notifier),
+		logger:    slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Dispatch 將通知送到指定的管道，單一管道失敗不影響其他管道
+//
+// 流程:
+//   - 1. 略過沒有設定Notifier的管道
+//   - 2. 以SETNX寫入去重鍵，已存在代表已經送出過
+//   - 3. 送出通知，失敗時刪除去重鍵讓之後可以重試；收件者沒有此管道的地址時略過
+func (d *Dispatcher) Dispatch(ctx context.Context, recipient Recipient, channels []models.NotificationChannel, message Message) error {
+	const op = "Dispatcher.Dispatch"
+	var errs []error
+	for _, channel := range channels {
+		notifier, ok := d.notifiers[channel]
+		if !ok {
+			d.logger.Debug("Skip channel without notifier", slog.String("channel", string(channel)))
+			continue
+		}
+		dedupKey := ""
+		if message.DedupKey != "" {
+			dedupKey = fmt.Sprintf("%snotification:dedup:%s:%s", d.keyPrefix, channel, message.DedupKey)
+			ok, err := d.client.SetNX(ctx, dedupKey, 1, d.dedupTTL).Result()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("[%s] fail to set dedup key of %s, err=%w", op, channel, err))
+				continue
+			}
+			if !ok {
+				d.logger.Debug("Skip duplicated notification", slog.String("channel", string(channel)), slog.String("dedupKey", message.DedupKey))
+				continue
+			}
+		}
+		err := notifier.Notify(ctx, recipient, message)
+		if err == nil {
+			continue
+		}
+		if dedupKey != "" {
+			if err := d.client.Del(ctx, dedupKey).Err(); err != nil {
+				d.logger.Warn("Fail to delete dedup key", slog.String("key", dedupKey), slog.Any("error", err))
+			}
+		}
+		if errors.Is(err, ErrNoAddress) {
+			d.logger.Debug("Skip channel without address", slog.String("channel", string(channel)), slog.String("user", recipient.UserID.String()))
+			continue
+		}
+		errs = append(errs, fmt.Errorf("[%s] fail to notify via %s, err=%w", op, channel, err))
+	}
+	return errors.Join(errs...)
+}
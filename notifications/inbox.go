@@ -0,0 +1,35 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"q4/models"
+)
+
+// InboxNotifier 將通知寫入資料庫作為站內通知
+type InboxNotifier struct {
+	db *gorm.DB
+}
+
+// NewInboxNotifier 建立InboxNotifier
+func NewInboxNotifier(db *gorm.DB) *InboxNotifier {
+	return &InboxNotifier{db: db}
+}
+
+// Notify 新增一筆站內通知
+func (n *InboxNotifier) Notify(ctx context.Context, recipient Recipient, message Message) error {
+	notification := models.Notification{
+		UserID:        recipient.UserID,
+		Kind:          message.Kind,
+		AuctionItemID: message.AuctionItemID,
+		Title:         message.Title,
+		Body:          message.Body,
+	}
+	if result := n.db.WithContext(ctx).Create(&notification); result.Error != nil {
+		return fmt.Errorf("fail to save notification, err=%w", result.Error)
+	}
+	return nil
+}
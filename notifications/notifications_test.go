@@ -0,0 +1,226 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"q4/models"
+	"q4/webhooks"
+)
+
+// fakeNotifier 記錄收到的通知，err不為nil時返回err
+type fakeNotifier struct {
+	messages []Message
+	err      error
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, _ Recipient, message Message) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.messages = append(n.messages, message)
+	return nil
+}
+
+func TestDispatcher(t *testing.T) {
+	mr, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mr.Close()
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+	recipient := Recipient{UserID: uuid.New(), Username: "user"}
+
+	t.Run("相同的DedupKey只會送出一次", func(t *testing.T) {
+		mr.FlushAll()
+		inbox := &fakeNotifier{}
+		dispatcher := NewDispatcher(client, "test:", time.Hour, WithNotifier(models.NotificationChannelInbox, inbox))
+		message := Message{Kind: models.NotificationKindOutbid, Title: "Outbid", DedupKey: "outbid:1"}
+		channels := []models.NotificationChannel{models.NotificationChannelInbox}
+
+		assert.NoError(t, dispatcher.Dispatch(ctx, recipient, channels, message))
+		assert.NoError(t, dispatcher.Dispatch(ctx, recipient, channels, message))
+		assert.Len(t, inbox.messages, 1)
+		assert.True(t, mr.Exists("test:notification:dedup:inbox:outbid:1"))
+	})
+
+	t.Run("沒有DedupKey時每次都送出", func(t *testing.T) {
+		mr.FlushAll()
+		inbox := &fakeNotifier{}
+		dispatcher := NewDispatcher(client, "test:", time.Hour, WithNotifier(models.NotificationChannelInbox, inbox))
+		channels := []models.NotificationChannel{models.NotificationChannelInbox}
+
+		assert.NoError(t, dispatcher.Dispatch(ctx, recipient, channels, Message{Title: "A"}))
+		assert.NoError(t, dispatcher.Dispatch(ctx, recipient, channels, Message{Title: "A"}))
+		assert.Len(t, inbox.messages, 2)
+	})
+
+	t.Run("送出失敗時應刪除去重鍵且不影響其他管道", func(t *testing.T) {
+		mr.FlushAll()
+		inbox := &fakeNotifier{}
+		webhook := &fakeNotifier{err: errors.New("boom")}
+		dispatcher := NewDispatcher(client, "test:", time.Hour,
+			WithNotifier(models.NotificationChannelInbox, inbox),
+			WithNotifier(models.NotificationChannelWebhook, webhook),
+		)
+		channels := []models.NotificationChannel{models.NotificationChannelWebhook, models.NotificationChannelInbox}
+
+		err := dispatcher.Dispatch(ctx, recipient, channels, Message{DedupKey: "won:1"})
+		assert.Error(t, err)
+		assert.Len(t, inbox.messages, 1)
+		assert.False(t, mr.Exists("test:notification:dedup:webhook:won:1"))
+		assert.True(t, mr.Exists("test:notification:dedup:inbox:won:1"))
+	})
+
+	t.Run("收件者沒有地址或管道沒有Notifier時略過", func(t *testing.T) {
+		mr.FlushAll()
+		email := &fakeNotifier{err: ErrNoAddress}
+		dispatcher := NewDispatcher(client, "test:", time.Hour, WithNotifier(models.NotificationChannelEmail, email))
+		channels := []models.NotificationChannel{models.NotificationChannelEmail, models.NotificationChannelWebhook}
+
+		assert.NoError(t, dispatcher.Dispatch(ctx, recipient, channels, Message{DedupKey: "won:1"}))
+		assert.False(t, mr.Exists("test:notification:dedup:email:won:1"))
+	})
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	itemID := uuid.New()
+	message := Message{
+		Kind:          models.NotificationKindWon,
+		AuctionItemID: &itemID,
+		Title:         "You won",
+		Body:          "Congratulations",
+		Time:          time.UnixMilli(time.Now().UnixMilli()).UTC(),
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:   "回應2xx時成功",
+			status: http.StatusNoContent,
+		},
+		{
+			name:    "回應其他狀態碼時失敗",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+		{
+			name:    "不跟隨重新導向",
+			status:  http.StatusFound,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payload WebhookPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "/redirected")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			notifier := NewWebhookNotifier(webhooks.NewSender(time.Second, true))
+			err := notifier.Notify(context.Background(), Recipient{Username: "user", WebhookURL: server.URL}, message)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, WebhookPayload{
+				Kind:   message.Kind,
+				User:   "user",
+				ItemID: &itemID,
+				Title:  message.Title,
+				Body:   message.Body,
+				Time:   message.Time,
+			}, payload)
+		})
+	}
+
+	t.Run("沒有webhook URL時返回ErrNoAddress", func(t *testing.T) {
+		err := NewWebhookNotifier(webhooks.NewSender(time.Second, true)).Notify(context.Background(), Recipient{}, message)
+		assert.ErrorIs(t, err, ErrNoAddress)
+	})
+
+	t.Run("不允許送到私有網路位址", func(t *testing.T) {
+		var called bool
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		err := NewWebhookNotifier(webhooks.NewSender(time.Second, false)).Notify(context.Background(), Recipient{Username: "user", WebhookURL: server.URL}, message)
+		assert.ErrorIs(t, err, webhooks.ErrAddressNotAllowed)
+		assert.False(t, called)
+	})
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	notifier, err := NewSMTPNotifier("localhost:1025", "", "", "Auction <noreply@example.com>")
+	assert.NoError(t, err)
+	var sent struct {
+		addr string
+		auth smtp.Auth
+		from string
+		to   []string
+		msg  string
+	}
+	notifier.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sent.addr, sent.auth, sent.from, sent.to, sent.msg = addr, a, from, to, string(msg)
+		return nil
+	}
+
+	err = notifier.Notify(context.Background(), Recipient{Email: "user@example.com"}, Message{
+		Title: "出價已被超過",
+		Body:  "目前最高出價為 NT$1,500",
+		Time:  time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:1025", sent.addr)
+	assert.Nil(t, sent.auth)
+	assert.Equal(t, "noreply@example.com", sent.from)
+	assert.Equal(t, []string{"user@example.com"}, sent.to)
+
+	parsed, err := mail.ReadMessage(strings.NewReader(sent.msg))
+	assert.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "出價已被超過", subject)
+	assert.Equal(t, "Sun, 18 Oct 2026 12:00:00 +0000", parsed.Header.Get("Date"))
+	body, err := io.ReadAll(quotedprintable.NewReader(parsed.Body))
+	assert.NoError(t, err)
+	assert.Equal(t, "目前最高出價為 NT$1,500", string(body))
+
+	t.Run("沒有電子郵件地址時返回ErrNoAddress", func(t *testing.T) {
+		err := notifier.Notify(context.Background(), Recipient{}, Message{})
+		assert.ErrorIs(t, err, ErrNoAddress)
+	})
+}
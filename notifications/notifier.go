@@ -0,0 +1,46 @@
+// Package notifications 將拍賣相關的通知(出價被超過、得標、即將結束)透過使用者選擇的管道送出
+//
+// 支援的管道:
+//   - 站內通知(inbox)，儲存在資料庫中
+//   - 電子郵件(email)，透過SMTP送出
+//   - webhook，以JSON送到使用者設定的URL
+package notifications
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"q4/models"
+)
+
+var (
+	// ErrNoAddress 收件者沒有設定此管道需要的地址(電子郵件或webhook URL)
+	ErrNoAddress = errors.New("recipient has no address for the channel")
+)
+
+// Message 代表要送出的通知內容
+type Message struct {
+	Kind          models.NotificationKind
+	AuctionItemID *uuid.UUID
+	Title         string
+	Body          string
+	Time          time.Time
+	// DedupKey 相同的DedupKey在同一個管道只會送出一次，空字串代表不去重
+	DedupKey string
+}
+
+// Recipient 代表通知的收件者
+type Recipient struct {
+	UserID     uuid.UUID
+	Username   string
+	Email      string
+	WebhookURL string
+}
+
+// Notifier 透過單一管道送出通知
+type Notifier interface {
+	Notify(ctx context.Context, recipient Recipient, message Message) error
+}
@@ -0,0 +1,94 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// SMTPNotifier 透過SMTP以電子郵件送出通知
+// 沒有設定使用者名稱時不進行驗證，可以直接搭配本機的SMTP sink(例如MailHog)使用
+type SMTPNotifier struct {
+	addr     string
+	from     mail.Address
+	auth     smtp.Auth
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPNotifier 建立SMTPNotifier
+//   - addr SMTP伺服器的位址(host:port)
+//   - from 寄件者的電子郵件地址
+func NewSMTPNotifier(addr, username, password, from string) (*SMTPNotifier, error) {
+	const op = "NewSMTPNotifier"
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] Invalid from address, err=%w", op, err)
+	}
+	notifier := &SMTPNotifier{
+		addr:     addr,
+		from:     *sender,
+		sendMail: smtp.SendMail,
+	}
+	if username != "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] Invalid SMTP address, err=%w", op, err)
+		}
+		notifier.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return notifier, nil
+}
+
+// Notify 送出電子郵件，收件者沒有電子郵件地址時返回ErrNoAddress
+// NOTE: net/smtp不支援context，ctx只用於檢查是否已被取消
+func (n *SMTPNotifier) Notify(ctx context.Context, recipient Recipient, message Message) error {
+	if recipient.Email == "" {
+		return ErrNoAddress
+	}
+	to, err := mail.ParseAddress(recipient.Email)
+	if err != nil {
+		return fmt.Errorf("invalid recipient address, err=%w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	msg, err := buildMail(n.from, *to, message)
+	if err != nil {
+		return err
+	}
+	if err := n.sendMail(n.addr, n.auth, n.from.Address, []string{to.Address}, msg); err != nil {
+		return fmt.Errorf("fail to send mail, err=%w", err)
+	}
+	return nil
+}
+
+// buildMail 組成純文字的郵件內容，標題以RFC 2047編碼，內文以quoted-printable編碼
+func buildMail(from, to mail.Address, message Message) ([]byte, error) {
+	date := message.Time
+	if date.IsZero() {
+		date = time.Now()
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Title))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+	writer := quotedprintable.NewWriter(&buf)
+	if _, err := writer.Write([]byte(message.Body)); err != nil {
+		return nil, fmt.Errorf("fail to encode mail body, err=%w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("fail to encode mail body, err=%w", err)
+	}
+	return buf.Bytes(), nil
+}
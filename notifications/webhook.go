@@ -0,0 +1,62 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"q4/models"
+	"q4/webhooks"
+)
+
+// WebhookPayload 是送到使用者webhook的JSON內容
+type WebhookPayload struct {
+	Kind   models.NotificationKind `json:"kind"`
+	User   string                  `json:"user"`
+	ItemID *uuid.UUID              `json:"itemId,omitempty"`
+	Title  string                  `json:"title"`
+	Body   string                  `json:"body"`
+	Time   time.Time               `json:"time"`
+}
+
+// WebhookNotifier 將通知以JSON POST到使用者設定的URL，回應2xx以外的狀態碼視為失敗
+// NOTE: 透過webhooks.Sender送出，與拍賣事件的webhook有相同的位址和重新導向限制
+type WebhookNotifier struct {
+	sender *webhooks.Sender
+}
+
+// NewWebhookNotifier 建立WebhookNotifier
+func NewWebhookNotifier(sender *webhooks.Sender) *WebhookNotifier {
+	return &WebhookNotifier{
+		sender: sender,
+	}
+}
+
+// Notify 送出webhook請求，收件者沒有設定webhook URL時返回ErrNoAddress
+func (n *WebhookNotifier) Notify(ctx context.Context, recipient Recipient, message Message) error {
+	if recipient.WebhookURL == "" {
+		return ErrNoAddress
+	}
+	body, err := json.Marshal(WebhookPayload{
+		Kind:   message.Kind,
+		User:   recipient.Username,
+		ItemID: message.AuctionItemID,
+		Title:  message.Title,
+		Body:   message.Body,
+		Time:   message.Time,
+	})
+	if err != nil {
+		return fmt.Errorf("fail to marshal webhook payload, err=%w", err)
+	}
+	result, err := n.sender.Post(ctx, recipient.WebhookURL, body)
+	if err != nil {
+		return err
+	}
+	if !result.Success() {
+		return fmt.Errorf("webhook responded with status %d", result.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Package shill 依據使用者的出價歷史，計算疑似哄抬價格(shill bidding)的分數
+//
+// 使用的訊號:
+//   - 重複對同一個賣家的拍賣出價
+//   - 出價被其他人以一個最小加價內超過後繼續加價，推高得標者支付的價格，最後由其他人得標
+//   - 從未得標
+package shill
+
+import (
+	"github.com/google/uuid"
+)
+
+// 各訊號在分數中的權重，總和為1
+const (
+	weightSameSeller = 0.4
+	weightPush       = 0.3
+	weightNeverWon   = 0.3
+)
+
+// Participation 代表使用者參與一場已結標拍賣的紀錄
+type Participation struct {
+	AuctionID uuid.UUID
+	SellerID  uuid.UUID
+	// 拍賣是否有得標者
+	Sold bool
+	// 使用者是否為得標者
+	Won bool
+	// 使用者的出價被其他人以一個最小加價內超過後，是否又再次出價
+	// 代表使用者已經碰到領先者的上限，繼續出價只會推高領先者支付的價格
+	Pushed bool
+}
+
+// Signals 代表使用者出價歷史的統計結果
+type Signals struct {
+	// 參與的已結標拍賣數量
+	Auctions int
+	// 得標的拍賣數量
+	Wins int
+	// 參與次數最多的賣家佔所有參與拍賣的比例
+	SameSellerRatio float64
+	// 在由其他人得標的拍賣中推高價格(Participation.Pushed)的拍賣佔所有參與拍賣的比例
+	PushRatio float64
+}
+
+// Analyze 統計使用者參與的拍賣，同一場拍賣只會計算一次
+func Analyze(participations []Participation) Signals {
+	seen := make(map[uuid.UUID]struct{}, len(participations))
+	sellers := make(map[uuid.UUID]int)
+	signals := Signals{}
+	pushed, maxSameSeller := 0, 0
+	for _, p := range participations {
+		if _, ok := seen[p.AuctionID]; ok {
+			continue
+		}
+		seen[p.AuctionID] = struct{}{}
+		signals.Auctions++
+		sellers[p.SellerID]++
+		maxSameSeller = max(maxSameSeller, sellers[p.SellerID])
+		if p.Won {
+			signals.Wins++
+		}
+		if p.Sold && !p.Won && p.Pushed {
+			pushed++
+		}
+	}
+	if signals.Auctions > 0 {
+		signals.SameSellerRatio = float64(maxSameSeller) / float64(signals.Auctions)
+		signals.PushRatio = float64(pushed) / float64(signals.Auctions)
+	}
+	return signals
+}
+
+// Score 返回0到1之間的分數，分數越高越可疑
+func (s Signals) Score() float64 {
+	score := weightSameSeller*s.SameSellerRatio + weightPush*s.PushRatio
+	if s.Auctions > 0 && s.Wins == 0 {
+		score += weightNeverWon
+	}
+	return score
+}
+
+// Config 代表判定可疑帳號的條件
+type Config struct {
+	// 參與的拍賣數量低於此值時不進行判定，避免新使用者被誤判
+	MinAuctions int
+	// 分數達到此值時判定為可疑
+	Threshold float64
+}
+
+// Result 代表被判定為可疑的使用者
+type Result struct {
+	UserID  uuid.UUID
+	Signals Signals
+	Score   float64
+}
+
+// Detect 對每個使用者的參與紀錄進行判定，返回可疑的使用者
+func Detect(config Config, participations map[uuid.UUID][]Participation) []Result {
+	results := []Result{}
+	for userID, ps := range participations {
+		signals := Analyze(ps)
+		if signals.Auctions < config.MinAuctions {
+			continue
+		}
+		if score := signals.Score(); score >= config.Threshold {
+			results = append(results, Result{UserID: userID, Signals: signals, Score: score})
+		}
+	}
+	return results
+}
@@ -0,0 +1,93 @@
+package shill
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	sellerA, sellerB := uuid.New(), uuid.New()
+	auction1, auction2, auction3, auction4 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	signals := Analyze([]Participation{
+		{AuctionID: auction1, SellerID: sellerA, Sold: true, Pushed: true},
+		// 同一場拍賣多次出價只計算一次
+		{AuctionID: auction1, SellerID: sellerA, Sold: true, Pushed: true},
+		{AuctionID: auction2, SellerID: sellerA, Sold: true},
+		// 流標的拍賣沒有推高任何人支付的價格
+		{AuctionID: auction3, SellerID: sellerA, Sold: false, Pushed: true},
+		{AuctionID: auction4, SellerID: sellerB, Sold: true, Won: true},
+	})
+
+	assert.Equal(t, 4, signals.Auctions)
+	assert.Equal(t, 1, signals.Wins)
+	assert.InDelta(t, 0.75, signals.SameSellerRatio, 1e-9)
+	assert.InDelta(t, 0.25, signals.PushRatio, 1e-9)
+}
+
+func TestSignalsScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		signals Signals
+		want    float64
+	}{
+		{
+			name:    "沒有參與紀錄",
+			signals: Signals{},
+			want:    0,
+		},
+		{
+			name:    "所有訊號都成立",
+			signals: Signals{Auctions: 5, Wins: 0, SameSellerRatio: 1, PushRatio: 1},
+			want:    1,
+		},
+		{
+			name:    "有得標紀錄且分散出價",
+			signals: Signals{Auctions: 10, Wins: 5, SameSellerRatio: 0.2, PushRatio: 0.5},
+			want:    0.4*0.2 + 0.3*0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.signals.Score(), 1e-9)
+		})
+	}
+}
+
+func TestDetect(t *testing.T) {
+	seller := uuid.New()
+	shill, newcomer, buyer := uuid.New(), uuid.New(), uuid.New()
+	participations := map[uuid.UUID][]Participation{}
+	for i := 0; i < 5; i++ {
+		participations[shill] = append(participations[shill], Participation{AuctionID: uuid.New(), SellerID: seller, Sold: true, Pushed: true})
+		participations[buyer] = append(participations[buyer], Participation{AuctionID: uuid.New(), SellerID: uuid.New(), Sold: true, Won: i%2 == 0})
+	}
+	participations[newcomer] = []Participation{{AuctionID: uuid.New(), SellerID: seller, Sold: true}}
+
+	results := Detect(Config{MinAuctions: 5, Threshold: 0.7}, participations)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, shill, results[0].UserID)
+		assert.InDelta(t, 1, results[0].Score, 1e-9)
+	}
+}
+
+func TestDetectOrdinaryLosingBidder(t *testing.T) {
+	seller := uuid.New()
+	loser := uuid.New()
+	participations := map[uuid.UUID][]Participation{}
+	// 輸掉了5場拍賣，其中2場來自同一個賣家，出價沒有推高價格
+	for i := 0; i < 5; i++ {
+		sellerID := uuid.New()
+		if i < 2 {
+			sellerID = seller
+		}
+		participations[loser] = append(participations[loser], Participation{AuctionID: uuid.New(), SellerID: sellerID, Sold: true})
+	}
+
+	signals := Analyze(participations[loser])
+	assert.InDelta(t, 0.4*0.4+0.3, signals.Score(), 1e-9)
+	assert.Empty(t, Detect(Config{MinAuctions: 5, Threshold: 0.7}, participations))
+}
@@ -1,5 +1,6 @@
 import { Card, CardContent } from "@/components/ui/card"
 import type { paths, Defined } from "@/app/openapi"
+import { formatMoney } from "@/app/utils"
 
 type ItemInfo = Defined<paths["/auction/item/{itemID}"]["get"]["responses"]["200"]["content"]["application/json"]>
 
@@ -11,7 +12,7 @@ export function AuctionDetails({ info }: { info: ItemInfo }) {
                 <div className="grid grid-cols-2 gap-4">
                     <div>
                         <p className="text-sm text-muted-foreground">起拍價</p>
-                        <p className="font-semibold">${info.startPrice ?? 'N/A'}</p>
+                        <p className="font-semibold">{formatMoney(info.startPrice, info.currency)}</p>
                     </div>
                     <div>
                         <p className="text-sm text-muted-foreground">起拍時間</p>
@@ -2,10 +2,11 @@ import { Dialog, DialogContent, DialogHeader, DialogTitle, DialogTrigger } from
 import { Button } from "@/components/ui/button"
 import { ScrollArea } from "@/components/ui/scroll-area"
 import type { components, Defined } from "@/app/openapi"
+import { formatMoney, type Currency } from "@/app/utils"
 
 type BidEvent = Defined<components["schemas"]["BidEvent"]>
 
-export function BidHistory({ bidRecords }: { bidRecords: BidEvent[] }) {
+export function BidHistory({ bidRecords, currency }: { bidRecords: BidEvent[], currency: Currency }) {
     return (
         <Dialog>
             <DialogTrigger asChild>
@@ -20,7 +21,7 @@ export function BidHistory({ bidRecords }: { bidRecords: BidEvent[] }) {
                         {bidRecords.length > 0 ? (
                             bidRecords.map((bid, index) => (
                                 <li key={index} className="text-sm border-b pb-2">
-                                    <span className="font-semibold">{bid.user || 'Unknown'}</span> - ${bid.bid || 0}
+                                    <span className="font-semibold">{bid.user || 'Unknown'}</span> - {formatMoney(bid.bid || 0, currency)}
                                     <br />
                                     <span className="text-xs text-muted-foreground">{bid.time.toLocaleString()}</span>
                                 </li>
@@ -18,7 +18,7 @@ import { useCallback, useEffect, useState } from "react"
 import { BidHistory } from "@/app/auction/[id]/history"
 import { AuctionDetails } from "@/app/auction/[id]/details"
 import { BACKEND_API_BASE_URL } from '@/app/constants'
-import { dateReviver } from "@/app/utils"
+import { amountStep, dateReviver, formatMoney, toMinorUnits } from "@/app/utils"
 import createClient from "openapi-fetch"
 import { useToast } from "@/hooks/use-toast"
 import { LoginButton } from "@/app/components/context/nav-user-context"
@@ -108,7 +108,7 @@ export function AuctionItemInfo({ id, info }: { id: string, info: ItemInfo }) {
                 },
             },
             body: {
-                bid: userBid,
+                bid: toMinorUnits(userBid, info.currency),
             },
         })
         switch (response.status) {
@@ -200,12 +200,12 @@ export function AuctionItemInfo({ id, info }: { id: string, info: ItemInfo }) {
                     <Card className="bg-gradient-to-br from-primary/10 to-secondary/10 shadow-lg">
                         <CardContent className="p-6">
                             <h2 className="text-3xl font-bold mb-4">{info.title || 'Unnamed Item'}</h2>
-                            <div className="text-2xl font-semibold mb-2">當前出價：${currentBid?.bid || info.startPrice}</div>
+                            <div className="text-2xl font-semibold mb-2">當前出價：{formatMoney(currentBid?.bid || info.startPrice, info.currency)}</div>
                             <div className="flex items-center justify-between mb-4">
                                 <span className="text-lg">
                                     {currentBid ? `出價人：${currentBid.user}` : "暫無出價"}
                                 </span>
-                                <BidHistory bidRecords={bidRecords} />
+                                <BidHistory bidRecords={bidRecords} currency={info.currency} />
                             </div>
                             {auctionState !== AuctionState.ENDED && (
                                 <AuctionTimer 
@@ -219,7 +219,8 @@ export function AuctionItemInfo({ id, info }: { id: string, info: ItemInfo }) {
                             <div className="flex space-x-2 mt-4">
                                 <Input
                                     type="number"
-                                    placeholder="您的出價"
+                                    placeholder={`您的出價(${info.currency})`}
+                                    step={amountStep(info.currency)}
                                     className="flex-grow"
                                     value={userBid || ''}
                                     onChange={(e) => setUserBid(Number(e.target.value))}
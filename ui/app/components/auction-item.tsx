@@ -2,6 +2,7 @@ import Link from 'next/link';
 import Image from 'next/image';
 import { Card, CardContent, CardHeader, CardTitle } from "@/components/ui/card";
 import type { Defined, paths } from "@/app/openapi";
+import { formatMoney } from "@/app/utils";
 
 type AuctionItemData = Defined<
 	paths["/auction/items"]["get"]["responses"]["200"]["content"]["application/json"]["items"]
@@ -22,7 +23,7 @@ export function AuctionItem({ item }: { item: AuctionItemData }) {
 							已結標
 						</div>
 						<div className="mt-2 bg-white/90 dark:bg-gray-800/90 px-3 py-1 rounded-lg text-sm">
-							結標價格：${item.currentBid}
+							結標價格：{formatMoney(item.currentBid, item.currency)}
 						</div>
 					</div>
 				)}
@@ -30,7 +31,7 @@ export function AuctionItem({ item }: { item: AuctionItemData }) {
 					<div className="relative w-full aspect-square">
 						<Image src="/placeholder-200x150.webp" alt={item.title || ""} className="rounded-lg mb-2 object-contain" fill />
 					</div>
-					<p className="font-semibold">當前出價：${item.currentBid}</p>
+					<p className="font-semibold">當前出價：{formatMoney(item.currentBid, item.currency)}</p>
 					<p className="text-sm text-gray-600">
 						開始時間：{item.startTime.toLocaleString()}
 					</p>
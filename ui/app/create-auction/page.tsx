@@ -18,6 +18,7 @@ import createClient from "openapi-fetch";
 import type { paths } from "@/app/openapi/openapi"
 import { FileHandler } from '@/app/create-auction/file-handler'
 import { DateTimePicker } from "@/app/components/date-time-picker"
+import { amountStep, DEFAULT_CURRENCY, toMinorUnits } from "@/app/utils"
 import { LoginButton } from "@/app/components/context/nav-user-context"
 
 export default function CreateAuctionPage() {
@@ -107,7 +108,8 @@ export default function CreateAuctionPage() {
     const { error, response } = await client.POST("/auction/item", {
       body: {
         title: formData.get('title') as string,
-        startingPrice: parseInt(formData.get('startingPrice') as string, 10),
+        startingPrice: toMinorUnits(parseFloat(formData.get('startingPrice') as string), DEFAULT_CURRENCY),
+        currency: DEFAULT_CURRENCY,
         startTime: new Date(formData.get('startTime') as string),
         endTime: new Date(formData.get('endTime') as string),
         description: editor?.getHTML(),
@@ -162,12 +164,14 @@ export default function CreateAuctionPage() {
               <Input id="title" name="title" placeholder="輸入拍賣項目的標題" required />
             </div>
             <div className="space-y-2">
-              <Label htmlFor="startingPrice">起拍價格</Label>
+              <Label htmlFor="startingPrice">起拍價格({DEFAULT_CURRENCY})</Label>
               <Input
                 id="startingPrice"
                 name="startingPrice"
                 type="number"
-                inputMode="numeric"
+                inputMode="decimal"
+                min={0}
+                step={amountStep(DEFAULT_CURRENCY)}
                 placeholder="0"
                 required
               />
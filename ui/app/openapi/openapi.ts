@@ -32,8 +32,9 @@ export interface paths {
                     "application/json": {
                         title: string;
                         description?: string;
-                        /** Format: int64 */
-                        startingPrice?: number;
+                        startingPrice?: components["schemas"]["Money"];
+                        /** @description Currency of all amounts of the auction. Defaults to TWD and cannot be changed afterwards. */
+                        currency?: components["schemas"]["Currency"];
                         /** Format: date-time */
                         startTime?: Date;
                         /** Format: date-time */
@@ -94,13 +95,13 @@ export interface paths {
                     title?: string;
                     /** @description Starting price range for filtering items. */
                     startPrice?: {
-                        from?: number;
-                        to?: number;
+                        from?: components["schemas"]["Money"];
+                        to?: components["schemas"]["Money"];
                     };
                     /** @description Current bid range for filtering items. */
                     currentBid?: {
-                        from?: number;
-                        to?: number;
+                        from?: components["schemas"]["Money"];
+                        to?: components["schemas"]["Money"];
                     };
                     /** @description The auction start time range for filtering items. */
                     startTime?: {
@@ -154,8 +155,8 @@ export interface paths {
                                 /** Format: uuid */
                                 id: string;
                                 title: string;
-                                /** Format: uint32 */
-                                currentBid: number;
+                                currentBid: components["schemas"]["Money"];
+                                currency: components["schemas"]["Currency"];
                                 /** Format: date-time */
                                 startTime: Date;
                                 /** Format: date-time */
@@ -222,8 +223,8 @@ export interface paths {
                         "application/json": {
                             title: string;
                             description: string;
-                            /** Format: int64 */
-                            startPrice: number;
+                            startPrice: components["schemas"]["Money"];
+                            currency: components["schemas"]["Currency"];
                             bidRecords: components["schemas"]["BidEvent"][];
                             /** Format: date-time */
                             startTime: Date;
@@ -631,6 +632,16 @@ export interface paths {
 export type webhooks = Record<string, never>;
 export interface components {
     schemas: {
+        /**
+         * Format: int64
+         * @description Amount in the minor unit of the auction's currency, e.g. `12345` is 123.45 TWD and 12345 JPY.
+         */
+        Money: number;
+        /**
+         * @description ISO 4217 currency code of all amounts of an auction.
+         * @enum {string}
+         */
+        Currency: "TWD" | "USD" | "EUR" | "JPY";
         ApiResponse: {
             /** Format: int32 */
             code?: number;
@@ -638,8 +649,7 @@ export interface components {
         };
         BidEvent: {
             user: string;
-            /** Format: uint32 */
-            bid: number;
+            bid: components["schemas"]["Money"];
             /** Format: date-time */
             time: Date;
         };
@@ -12,7 +12,7 @@ import {
 } from "@/components/ui/pagination"
 import createClient from "openapi-fetch";
 import { BACKEND_API_BASE_URL } from '@/app/constants'
-import { reParseJSON, dateReviver, serializeDeepObject } from '@/app/utils';
+import { reParseJSON, dateReviver, serializeDeepObject, toMinorUnits } from '@/app/utils';
 import { EnhancedGridContainer } from '@/app/components/enhanced-grid';
 import { AuctionItem } from '@/app/components/auction-item';
 import { useToast } from '@/hooks/use-toast';
@@ -20,6 +20,16 @@ import { useToast } from '@/hooks/use-toast';
 type searchRequestType = Defined<paths["/auction/items"]["get"]["parameters"]["query"]>
 type searchResultType = Defined<paths["/auction/items"]["get"]["responses"]["200"]["content"]["application/json"]["items"]>
 
+type MoneyRange = searchRequestType["startPrice"]
+
+// 搜尋條件沒有指定貨幣，以預設貨幣換算為最小單位
+function rangeToMinorUnits(range: MoneyRange): MoneyRange {
+    return range && {
+        from: range.from === undefined ? undefined : toMinorUnits(range.from),
+        to: range.to === undefined ? undefined : toMinorUnits(range.to),
+    }
+}
+
 type PageData = {
     items: searchResultType;
     nextCursor?: string;
@@ -62,6 +72,9 @@ export function ProductList({ searchRequest }: { searchRequest: searchRequestTyp
             params: {
                 query: {
                     ...searchRequest,
+                    // 搜尋條件的金額以元輸入，API需要以最小單位表示的金額
+                    startPrice: rangeToMinorUnits(searchRequest.startPrice),
+                    currentBid: rangeToMinorUnits(searchRequest.currentBid),
                     lastItemID: cursor,
                     size: 21,
                 } as searchRequestType,
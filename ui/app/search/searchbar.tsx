@@ -17,6 +17,7 @@ import { Switch } from "@/components/ui/switch"
 import type { paths, Defined } from "@/app/openapi"
 import { useSearchBar } from './searchbar-context';
 import { DateTimePicker } from '@/app/components/date-time-picker'
+import { amountStep } from '@/app/utils'
 
 type searchRequestType = Defined<paths["/auction/items"]["get"]["parameters"]["query"]>
 
@@ -77,8 +78,8 @@ export function SearchBar({ searchRequest }: { searchRequest: searchRequestType
                                 起拍價格範圍
                             </label>
                             <div className="flex space-x-2">
-                                <Input id="startPrice[from]" name="startPrice[from]" type="number" placeholder="最低" className="w-1/2" defaultValue={searchRequest.startPrice?.from} />
-                                <Input name="startPrice[to]" type="number" placeholder="最高" className="w-1/2" defaultValue={searchRequest.startPrice?.to} />
+                                <Input id="startPrice[from]" name="startPrice[from]" type="number" step={amountStep()} placeholder="最低" className="w-1/2" defaultValue={searchRequest.startPrice?.from} />
+                                <Input name="startPrice[to]" type="number" step={amountStep()} placeholder="最高" className="w-1/2" defaultValue={searchRequest.startPrice?.to} />
                             </div>
                         </div>
                         <div>
@@ -86,8 +87,8 @@ export function SearchBar({ searchRequest }: { searchRequest: searchRequestType
                                 當前出價範圍
                             </label>
                             <div className="flex space-x-2">
-                                <Input id="currentBid[from]" name="currentBid[from]" type="number" placeholder="最低" className="w-1/2" defaultValue={searchRequest.currentBid?.from} />
-                                <Input name="currentBid[to]" type="number" placeholder="最高" className="w-1/2" defaultValue={searchRequest.currentBid?.to} />
+                                <Input id="currentBid[from]" name="currentBid[from]" type="number" step={amountStep()} placeholder="最低" className="w-1/2" defaultValue={searchRequest.currentBid?.from} />
+                                <Input name="currentBid[to]" type="number" step={amountStep()} placeholder="最高" className="w-1/2" defaultValue={searchRequest.currentBid?.to} />
                             </div>
                         </div>
                         <div>
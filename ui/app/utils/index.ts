@@ -1,3 +1,4 @@
 export * from './json'
 export * from './search-params'
-export * from './deep-object'
\ No newline at end of file
+export * from './deep-object'
+export * from './money'
\ No newline at end of file
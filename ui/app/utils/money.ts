@@ -0,0 +1,38 @@
+import type { components } from "@/app/openapi"
+
+export type Currency = components["schemas"]["Currency"]
+
+// 建立拍賣時未指定貨幣使用的貨幣，與後端的models.DefaultCurrency相同
+export const DEFAULT_CURRENCY: Currency = "TWD"
+
+// 各貨幣最小單位的小數位數，需要與後端的models/money.go一致
+const CURRENCY_EXPONENTS: Record<Currency, number> = {
+    TWD: 2,
+    USD: 2,
+    EUR: 2,
+    JPY: 0,
+}
+
+// API的金額皆以貨幣的最小單位表示，例如12345代表123.45 TWD或12345 JPY
+export function toMinorUnits(amount: number, currency: Currency = DEFAULT_CURRENCY): number {
+    return Math.round(amount * 10 ** CURRENCY_EXPONENTS[currency])
+}
+
+export function fromMinorUnits(amount: number, currency: Currency = DEFAULT_CURRENCY): number {
+    return amount / 10 ** CURRENCY_EXPONENTS[currency]
+}
+
+// 金額輸入框每次增減的數值，也就是貨幣的最小單位
+export function amountStep(currency: Currency = DEFAULT_CURRENCY): number {
+    return 1 / 10 ** CURRENCY_EXPONENTS[currency]
+}
+
+export function formatMoney(amount: number, currency: Currency = DEFAULT_CURRENCY): string {
+    const digits = CURRENCY_EXPONENTS[currency]
+    return new Intl.NumberFormat('zh-TW', {
+        style: 'currency',
+        currency,
+        minimumFractionDigits: digits,
+        maximumFractionDigits: digits,
+    }).format(fromMinorUnits(amount, currency))
+}
@@ -0,0 +1,234 @@
+// Package webhooks 送出帶有簽章的webhook請求
+//
+// 每個請求帶有以下header:
+//   - X-Q4-Webhook-Id: 送出紀錄的ID
+//   - X-Q4-Webhook-Event: 事件種類
+//   - X-Q4-Webhook-Timestamp: 送出時的Unix時間(秒)
+//   - X-Q4-Webhook-Signature: "sha256=" + hex(HMAC-SHA256(secret, timestamp + "." + body))
+//
+// 接收端應該以相同的方式計算簽章並比對，同時拒絕時間差距過大的請求以避免重送攻擊
+//
+// 為了避免使用者透過webhook存取內部服務，連線時會拒絕loopback、私有網路、link-local等位址，
+// 也不會跟隨重新導向(3xx視為送出失敗)
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	HeaderID        = "X-Q4-Webhook-Id"
+	HeaderEvent     = "X-Q4-Webhook-Event"
+	HeaderTimestamp = "X-Q4-Webhook-Timestamp"
+	HeaderSignature = "X-Q4-Webhook-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+// ErrAddressNotAllowed 代表webhook的目標位址不允許連線
+var ErrAddressNotAllowed = errors.New("webhook address is not allowed")
+
+// 不允許連線的位址範圍，補充netip.Addr沒有提供判斷的範圍
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // this network
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+}
+
+// NewSecret 產生簽章使用的隨機密鑰
+func NewSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("fail to generate secret, err=%w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
+// Sign 計算請求內容的簽章
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 檢查簽章是否正確，且timestamp與now的差距不超過tolerance
+func Verify(secret, timestamp, signature string, body []byte, now time.Time, tolerance time.Duration) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	sentAt := time.Unix(seconds, 0)
+	if now.Sub(sentAt).Abs() > tolerance {
+		return false
+	}
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, sentAt, body)))
+}
+
+// Backoff 返回第attempt次(從1開始)失敗後到下次重試的等待時間，每次加倍且不超過max
+func Backoff(attempt uint32, initial, max time.Duration) time.Duration {
+	if attempt == 0 {
+		return 0
+	}
+	backoff := float64(initial) * math.Pow(2, float64(attempt-1))
+	if backoff >= float64(max) {
+		return max
+	}
+	return time.Duration(backoff)
+}
+
+// Request 代表一次要送出的webhook
+type Request struct {
+	ID     string
+	Event  string
+	URL    string
+	Secret string
+	Body   []byte
+}
+
+// Result 代表webhook送出的結果，StatusCode為0代表沒有收到回應
+// NOTE: 不保留回應內容，避免將內部服務的回應透露給使用者
+type Result struct {
+	StatusCode int
+}
+
+// Success 檢查對方是否回應2xx
+func (r Result) Success() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+// AddressAllowed 檢查是否允許連線到addr，拒絕loopback、私有網路、link-local、multicast和未指定的位址
+func AddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() ||
+		addr.IsLoopback() ||
+		addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() ||
+		addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() ||
+		addr.IsMulticast() ||
+		addr.IsUnspecified() {
+		return false
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sender 送出webhook請求
+type Sender struct {
+	client              *http.Client
+	allowPrivateAddress bool
+	now                 func() time.Time
+}
+
+// NewSender 建立Sender
+//   - timeout 單次請求的最長時間
+//   - allowPrivateAddress 是否允許連線到私有網路等位址，只應該在本機開發時開啟
+func NewSender(timeout time.Duration, allowPrivateAddress bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateAddress {
+		// 在DNS解析後檢查實際連線的位址，避免DNS rebinding繞過檢查
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !AddressAllowed(addrPort.Addr()) {
+				return fmt.Errorf("%w: %s", ErrAddressNotAllowed, address)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// 不經過proxy，確保檢查的是webhook的目標位址
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &Sender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			// 不跟隨重新導向，避免公開的URL導向內部服務
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		allowPrivateAddress: allowPrivateAddress,
+		now:                 time.Now,
+	}
+}
+
+// ValidURL 檢查是否為可以送出webhook的http或https URL
+// NOTE: 只能擋下明顯不允許的位址，實際連線的位址在連線時檢查
+func (s *Sender) ValidURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return false
+	}
+	if s.allowPrivateAddress {
+		return true
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return AddressAllowed(addr)
+	}
+	return true
+}
+
+// Send 送出帶有簽章的webhook請求，只有在沒有收到回應時返回錯誤
+func (s *Sender) Send(ctx context.Context, request Request) (Result, error) {
+	timestamp := s.now()
+	return s.post(ctx, request.URL, request.Body, map[string]string{
+		HeaderID:        request.ID,
+		HeaderEvent:     request.Event,
+		HeaderTimestamp: strconv.FormatInt(timestamp.Unix(), 10),
+		HeaderSignature: Sign(request.Secret, timestamp, request.Body),
+	})
+}
+
+// Post 送出不帶簽章的JSON請求，只有在沒有收到回應時返回錯誤
+func (s *Sender) Post(ctx context.Context, target string, body []byte) (Result, error) {
+	return s.post(ctx, target, body, nil)
+}
+
+// post 以JSON格式送出body到target，並加上額外的headers
+func (s *Sender) post(ctx context.Context, target string, body []byte, headers map[string]string) (Result, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return Result{}, fmt.Errorf("fail to create webhook request, err=%w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return Result{}, fmt.Errorf("fail to send webhook request, err=%w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return Result{StatusCode: resp.StatusCode}, nil
+}
@@ -0,0 +1,202 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	body := []byte(`{"type":"bid.placed"}`)
+	signature := Sign("secret", now, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		want      bool
+	}{
+		{
+			name:      "簽章正確",
+			secret:    "secret",
+			timestamp: "1800000000",
+			signature: signature,
+			body:      body,
+			want:      true,
+		},
+		{
+			name:      "密鑰不同",
+			secret:    "other",
+			timestamp: "1800000000",
+			signature: signature,
+			body:      body,
+		},
+		{
+			name:      "內容被修改",
+			secret:    "secret",
+			timestamp: "1800000000",
+			signature: signature,
+			body:      []byte(`{"type":"auction.ended"}`),
+		},
+		{
+			name:      "時間被修改",
+			secret:    "secret",
+			timestamp: "1800000001",
+			signature: signature,
+			body:      body,
+		},
+		{
+			name:      "超過允許的時間差距",
+			secret:    "secret",
+			timestamp: "1799999000",
+			signature: Sign("secret", time.Unix(1_799_999_000, 0), body),
+			body:      body,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Verify(tt.secret, tt.timestamp, tt.signature, tt.body, now, 5*time.Minute))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	initial, max := 30*time.Second, 10*time.Minute
+	assert.Equal(t, time.Duration(0), Backoff(0, initial, max))
+	assert.Equal(t, 30*time.Second, Backoff(1, initial, max))
+	assert.Equal(t, time.Minute, Backoff(2, initial, max))
+	assert.Equal(t, 8*time.Minute, Backoff(5, initial, max))
+	assert.Equal(t, max, Backoff(6, initial, max))
+	assert.Equal(t, max, Backoff(100, initial, max))
+}
+
+func TestSenderSend(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "delivery-1", r.Header.Get(HeaderID))
+		assert.Equal(t, "bid.placed", r.Header.Get(HeaderEvent))
+		if !Verify("secret", r.Header.Get(HeaderTimestamp), r.Header.Get(HeaderSignature), body, now, time.Minute) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	sender := NewSender(time.Second, true)
+	sender.now = func() time.Time { return now }
+
+	result, err := sender.Send(context.Background(), Request{ID: "delivery-1", Event: "bid.placed", URL: server.URL, Secret: "secret", Body: []byte(`{}`)})
+	assert.NoError(t, err)
+	assert.True(t, result.Success())
+	assert.Equal(t, http.StatusAccepted, result.StatusCode)
+
+	result, err = sender.Send(context.Background(), Request{ID: "delivery-1", Event: "bid.placed", URL: server.URL, Secret: "wrong", Body: []byte(`{}`)})
+	assert.NoError(t, err)
+	assert.False(t, result.Success())
+	assert.Equal(t, http.StatusUnauthorized, result.StatusCode)
+}
+
+func TestSenderSendRedirect(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusFound)
+	}))
+	defer server.Close()
+
+	result, err := NewSender(time.Second, true).Send(context.Background(), Request{URL: server.URL, Secret: "secret", Body: []byte(`{}`)})
+	assert.NoError(t, err)
+	assert.False(t, result.Success())
+	assert.Equal(t, http.StatusFound, result.StatusCode)
+	assert.False(t, redirected)
+}
+
+func TestSenderSendPrivateAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := NewSender(time.Second, false).Send(context.Background(), Request{URL: server.URL, Secret: "secret", Body: []byte(`{}`)})
+	assert.ErrorIs(t, err, ErrAddressNotAllowed)
+	assert.False(t, called)
+}
+
+func TestAddressAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want bool
+	}{
+		{name: "公開的IPv4", addr: "93.184.216.34", want: true},
+		{name: "公開的IPv6", addr: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{name: "loopback", addr: "127.0.0.1"},
+		{name: "IPv6 loopback", addr: "::1"},
+		{name: "私有網路", addr: "10.0.0.1"},
+		{name: "私有網路172", addr: "172.16.5.4"},
+		{name: "私有網路192", addr: "192.168.1.1"},
+		{name: "IPv6私有網路", addr: "fd00::1"},
+		{name: "雲端metadata", addr: "169.254.169.254"},
+		{name: "IPv6 link-local", addr: "fe80::1"},
+		{name: "未指定的位址", addr: "0.0.0.0"},
+		{name: "IPv6未指定的位址", addr: "::"},
+		{name: "carrier-grade NAT", addr: "100.64.0.1"},
+		{name: "multicast", addr: "224.0.0.1"},
+		{name: "IPv4-mapped loopback", addr: "::ffff:127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AddressAllowed(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestSenderValidURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		want         bool
+	}{
+		{name: "https", url: "https://example.com/hook", want: true},
+		{name: "http", url: "http://example.com:8080/hook", want: true},
+		{name: "公開的IP", url: "https://93.184.216.34/hook", want: true},
+		{name: "不支援的scheme", url: "ftp://example.com/hook"},
+		{name: "沒有host", url: "https:///hook"},
+		{name: "相對路徑", url: "/hook"},
+		{name: "localhost", url: "http://localhost:8080/hook"},
+		{name: "localhost子網域", url: "http://api.localhost/hook"},
+		{name: "loopback", url: "http://127.0.0.1:6379"},
+		{name: "IPv6 loopback", url: "http://[::1]/hook"},
+		{name: "雲端metadata", url: "http://169.254.169.254/latest/meta-data"},
+		{name: "私有網路", url: "http://10.0.0.1/hook"},
+		{name: "允許私有網路", url: "http://localhost:8080/hook", allowPrivate: true, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewSender(time.Second, tt.allowPrivate).ValidURL(tt.url))
+		})
+	}
+}